@@ -186,6 +186,12 @@ func Walk(visit Visit, nodes ...SQLNode) error {
 // generated SQL makes a best effort at adding indentation and line breaks while
 // still reusing the existing formatting logic.
 func String(node SQLNode, pretty bool) string {
+	return StringWithQuoteMode(node, pretty, SingleQuote)
+}
+
+// StringWithQuoteMode is like String, but quotes the string literals
+// according to mode.
+func StringWithQuoteMode(node SQLNode, pretty bool, mode QuoteMode) string {
 	if node == nil {
 		return "<nil>"
 	}
@@ -194,7 +200,7 @@ func String(node SQLNode, pretty bool) string {
 	if pretty {
 		formatter = PrettyFormatter
 	}
-	buf := NewTrackedBuffer(formatter)
+	buf := NewTrackedBuffer(formatter).SetQuoteMode(mode)
 	buf.Myprintf("%v", node)
 	return buf.String()
 }
@@ -294,6 +300,7 @@ type Select struct {
 	Where       *Where
 	GroupBy     GroupBy
 	Having      *Where
+	Windows     NamedWindows
 	OrderBy     OrderBy
 	Limit       *Limit
 	Lock        string
@@ -329,10 +336,10 @@ func (node *Select) SetLimit(limit *Limit) {
 
 // Format formats the node.
 func (node *Select) Format(buf *TrackedBuffer) {
-	buf.Myprintf("select %v%s%s%s%v from %v%v%v%v%v%v%s",
+	buf.Myprintf("select %v%s%s%s%v from %v%v%v%v%v%v%v%s",
 		node.Comments, node.Cache, node.Distinct, node.Hints, node.SelectExprs,
 		node.From, node.Where,
-		node.GroupBy, node.Having, node.OrderBy,
+		node.GroupBy, node.Having, node.Windows, node.OrderBy,
 		node.Limit, node.Lock)
 }
 
@@ -348,6 +355,7 @@ func (node *Select) walkSubtree(visit Visit) error {
 		node.Where,
 		node.GroupBy,
 		node.Having,
+		node.Windows,
 		node.OrderBy,
 		node.Limit,
 	)
@@ -504,16 +512,21 @@ func (node *Stream) walkSubtree(visit Visit) error {
 // the row and re-inserts with new values. For that reason we keep it as an Insert struct.
 // Replaces are currently disallowed in sharded schemas because
 // of the implications the deletion part may have on vindexes.
+// Overwrite, PartitionValues and IfNotExists are only set for the Hive
+// INSERT OVERWRITE TABLE and INSERT INTO TABLE ... PARTITION forms.
 // If you add fields here, consider adding them to calls to validateSubquerySamePlan.
 type Insert struct {
-	Action     string
-	Comments   Comments
-	Ignore     string
-	Table      TableName
-	Partitions Partitions
-	Columns    Columns
-	Rows       InsertRows
-	OnDup      OnDup
+	Action          string
+	Comments        Comments
+	Ignore          string
+	Overwrite       bool
+	Table           TableName
+	Partitions      Partitions
+	PartitionValues PartitionValues
+	IfNotExists     bool
+	Columns         Columns
+	Rows            InsertRows
+	OnDup           OnDup
 }
 
 // DDL strings.
@@ -524,10 +537,24 @@ const (
 
 // Format formats the node.
 func (node *Insert) Format(buf *TrackedBuffer) {
-	buf.Myprintf("%s %v%sinto %v%v%v %v%v",
+	if node.Overwrite {
+		var ifNotExists string
+		if node.IfNotExists {
+			ifNotExists = " if not exists"
+		}
+		buf.Myprintf("%s %voverwrite table %v%v%s %v",
+			node.Action, node.Comments,
+			node.Table, node.PartitionValues, ifNotExists, node.Rows)
+		return
+	}
+	into := "into "
+	if node.PartitionValues != nil {
+		into = "into table "
+	}
+	buf.Myprintf("%s %v%s%s%v%v%v%v %v%v",
 		node.Action,
-		node.Comments, node.Ignore,
-		node.Table, node.Partitions, node.Columns, node.Rows, node.OnDup)
+		node.Comments, node.Ignore, into,
+		node.Table, node.Partitions, node.PartitionValues, node.Columns, node.Rows, node.OnDup)
 }
 
 func (node *Insert) walkSubtree(visit Visit) error {
@@ -538,6 +565,7 @@ func (node *Insert) walkSubtree(visit Visit) error {
 		visit,
 		node.Comments,
 		node.Table,
+		node.PartitionValues,
 		node.Columns,
 		node.Rows,
 		node.OnDup,
@@ -691,6 +719,7 @@ func (node *DBDDL) walkSubtree(visit Visit) error {
 // NewName is set for AlterStr, CreateStr, RenameStr.
 // VindexSpec is set for CreateVindexStr, DropVindexStr, AddColVindexStr, DropColVindexStr
 // VindexCols is set for AddColVindexStr
+// Select is set for CreateStr when the table is created from a query.
 type DDL struct {
 	Action        string
 	Table         TableName
@@ -700,6 +729,7 @@ type DDL struct {
 	PartitionSpec *PartitionSpec
 	VindexSpec    *VindexSpec
 	VindexCols    []ColIdent
+	Select        SelectStatement
 }
 
 // DDL strings.
@@ -721,11 +751,21 @@ const (
 func (node *DDL) Format(buf *TrackedBuffer) {
 	switch node.Action {
 	case CreateStr:
-		if node.TableSpec == nil {
+		switch {
+		case node.TableSpec == nil:
 			buf.Myprintf("%s table %v", node.Action, node.NewName)
-		} else {
+		case node.TableSpec.Columns == nil:
+			buf.Myprintf("%s table %v%v", node.Action, node.NewName, node.TableSpec)
+		default:
 			buf.Myprintf("%s table %v %v", node.Action, node.NewName, node.TableSpec)
 		}
+		if node.Select != nil {
+			if node.TableSpec == nil {
+				buf.Myprintf(" as %v", node.Select)
+			} else {
+				buf.Myprintf("\nas %v", node.Select)
+			}
+		}
 	case DropStr:
 		exists := ""
 		if node.IfExists {
@@ -770,6 +810,8 @@ func (node *DDL) walkSubtree(visit Visit) error {
 		visit,
 		node.Table,
 		node.NewName,
+		node.TableSpec,
+		node.Select,
 	)
 }
 
@@ -843,28 +885,70 @@ func (node *PartitionDefinition) walkSubtree(visit Visit) error {
 	)
 }
 
-// TableSpec describes the structure of a table from a CREATE TABLE statement
+// TableSpec describes the structure of a table from a CREATE TABLE statement.
+// Columns is nil for a Hive CREATE TABLE ... AS SELECT. The remaining
+// fields after Options are only set by the Hive table clauses.
 type TableSpec struct {
 	Columns []*ColumnDefinition
 	Indexes []*IndexDefinition
 	Options string
+
+	Comment       *SQLVal
+	PartitionedBy []*ColumnDefinition
+	ClusteredBy   *ClusteredBy
+	RowFormat     *RowFormat
+	StoredAs      *StoredAs
+	Location      *SQLVal
+	TblProperties TableProperties
 }
 
 // Format formats the node.
 func (ts *TableSpec) Format(buf *TrackedBuffer) {
-	buf.Myprintf("(\n")
-	for i, col := range ts.Columns {
-		if i == 0 {
-			buf.Myprintf("\t%v", col)
-		} else {
-			buf.Myprintf(",\n\t%v", col)
+	if ts.Columns != nil {
+		buf.Myprintf("(\n")
+		for i, col := range ts.Columns {
+			if i == 0 {
+				buf.Myprintf("\t%v", col)
+			} else {
+				buf.Myprintf(",\n\t%v", col)
+			}
 		}
-	}
-	for _, idx := range ts.Indexes {
-		buf.Myprintf(",\n\t%v", idx)
+		for _, idx := range ts.Indexes {
+			buf.Myprintf(",\n\t%v", idx)
+		}
+
+		buf.Myprintf("\n)%s", strings.Replace(ts.Options, ", ", ",\n  ", -1))
 	}
 
-	buf.Myprintf("\n)%s", strings.Replace(ts.Options, ", ", ",\n  ", -1))
+	if ts.Comment != nil {
+		buf.Myprintf(" comment %v", ts.Comment)
+	}
+	if ts.PartitionedBy != nil {
+		buf.Myprintf("\npartitioned by (")
+		for i, col := range ts.PartitionedBy {
+			if i == 0 {
+				buf.Myprintf("%v", col)
+			} else {
+				buf.Myprintf(", %v", col)
+			}
+		}
+		buf.Myprintf(")")
+	}
+	if ts.ClusteredBy != nil {
+		buf.Myprintf("\n%v", ts.ClusteredBy)
+	}
+	if ts.RowFormat != nil {
+		buf.Myprintf("\n%v", ts.RowFormat)
+	}
+	if ts.StoredAs != nil {
+		buf.Myprintf("\n%v", ts.StoredAs)
+	}
+	if ts.Location != nil {
+		buf.Myprintf("\nlocation %v", ts.Location)
+	}
+	if ts.TblProperties != nil {
+		buf.Myprintf("\ntblproperties %v", ts.TblProperties)
+	}
 }
 
 // AddColumn appends the given column to the list in the spec
@@ -894,6 +978,159 @@ func (ts *TableSpec) walkSubtree(visit Visit) error {
 		}
 	}
 
+	for _, n := range ts.PartitionedBy {
+		if err := Walk(visit, n); err != nil {
+			return err
+		}
+	}
+
+	return Walk(visit, ts.ClusteredBy)
+}
+
+// ClusteredBy represents the Hive CLUSTERED BY ... INTO n BUCKETS clause.
+type ClusteredBy struct {
+	Columns  Columns
+	SortedBy OrderBy
+	Buckets  *SQLVal
+}
+
+// Format formats the node.
+func (node *ClusteredBy) Format(buf *TrackedBuffer) {
+	buf.Myprintf("clustered by %v", node.Columns)
+	if node.SortedBy != nil {
+		prefix := " sorted by ("
+		for _, order := range node.SortedBy {
+			buf.Myprintf("%s%v", prefix, order)
+			prefix = ", "
+		}
+		buf.Myprintf(")")
+	}
+	buf.Myprintf(" into %v buckets", node.Buckets)
+}
+
+func (node *ClusteredBy) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Columns,
+		node.SortedBy,
+	)
+}
+
+// RowFormat represents the Hive ROW FORMAT clause. Serde is set for
+// ROW FORMAT SERDE, the delimiters for ROW FORMAT DELIMITED.
+type RowFormat struct {
+	Serde           *SQLVal
+	SerdeProperties TableProperties
+
+	FieldsTerminatedBy          *SQLVal
+	EscapedBy                   *SQLVal
+	CollectionItemsTerminatedBy *SQLVal
+	MapKeysTerminatedBy         *SQLVal
+	LinesTerminatedBy           *SQLVal
+	NullDefinedAs               *SQLVal
+}
+
+// Format formats the node.
+func (node *RowFormat) Format(buf *TrackedBuffer) {
+	if node.Serde != nil {
+		buf.Myprintf("row format serde %v", node.Serde)
+		if node.SerdeProperties != nil {
+			buf.Myprintf(" with serdeproperties %v", node.SerdeProperties)
+		}
+		return
+	}
+	buf.Myprintf("row format delimited")
+	if node.FieldsTerminatedBy != nil {
+		buf.Myprintf(" fields terminated by %v", node.FieldsTerminatedBy)
+		if node.EscapedBy != nil {
+			buf.Myprintf(" escaped by %v", node.EscapedBy)
+		}
+	}
+	if node.CollectionItemsTerminatedBy != nil {
+		buf.Myprintf(" collection items terminated by %v", node.CollectionItemsTerminatedBy)
+	}
+	if node.MapKeysTerminatedBy != nil {
+		buf.Myprintf(" map keys terminated by %v", node.MapKeysTerminatedBy)
+	}
+	if node.LinesTerminatedBy != nil {
+		buf.Myprintf(" lines terminated by %v", node.LinesTerminatedBy)
+	}
+	if node.NullDefinedAs != nil {
+		buf.Myprintf(" null defined as %v", node.NullDefinedAs)
+	}
+}
+
+func (node *RowFormat) walkSubtree(visit Visit) error {
+	return nil
+}
+
+// StoredAs represents the Hive STORED AS clause. FileFormat is empty
+// when explicit input and output formats are given.
+type StoredAs struct {
+	FileFormat   ColIdent
+	InputFormat  *SQLVal
+	OutputFormat *SQLVal
+}
+
+// Format formats the node.
+func (node *StoredAs) Format(buf *TrackedBuffer) {
+	if node.InputFormat != nil {
+		buf.Myprintf("stored as inputformat %v outputformat %v", node.InputFormat, node.OutputFormat)
+		return
+	}
+	buf.Myprintf("stored as %v", node.FileFormat)
+}
+
+func (node *StoredAs) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.FileFormat)
+}
+
+// TableProperties represents the key/value list of TBLPROPERTIES
+// and SERDEPROPERTIES.
+type TableProperties []*TableProperty
+
+// Format formats the node.
+func (node TableProperties) Format(buf *TrackedBuffer) {
+	prefix := "("
+	for _, n := range node {
+		buf.Myprintf("%s%v", prefix, n)
+		prefix = ", "
+	}
+	buf.WriteString(")")
+}
+
+func (node TableProperties) walkSubtree(visit Visit) error {
+	return nil
+}
+
+// Find returns the value of the given property, or nil if it is not set.
+func (node TableProperties) Find(key string) *SQLVal {
+	for _, n := range node {
+		if string(n.Key.Val) == key {
+			return n.Value
+		}
+	}
+	return nil
+}
+
+// TableProperty represents a single 'key' = 'value' table property.
+type TableProperty struct {
+	Key   *SQLVal
+	Value *SQLVal
+}
+
+// Format formats the node.
+func (node *TableProperty) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v = %v", node.Key, node.Value)
+}
+
+func (node *TableProperty) walkSubtree(visit Visit) error {
 	return nil
 }
 
@@ -947,6 +1184,9 @@ type ColumnType struct {
 
 	// Key specification
 	KeyOpt ColumnKeyOption
+
+	// Element types of array, map and struct
+	Complex *ComplexType
 }
 
 // Format returns a canonical string representation of the type and all relevant options
@@ -964,52 +1204,50 @@ func (ct *ColumnType) Format(buf *TrackedBuffer) {
 		buf.Myprintf("(%s)", strings.Join(ct.EnumValues, ", "))
 	}
 
-	opts := make([]string, 0, 16)
+	if ct.Complex != nil {
+		buf.Myprintf("%v", ct.Complex)
+	}
+
+	// The options are written through buf so that the default, on update
+	// and comment values are quoted with its quote mode.
 	if ct.Unsigned {
-		opts = append(opts, keywordStrings[UNSIGNED])
+		buf.Myprintf(" %s", keywordStrings[UNSIGNED])
 	}
 	if ct.Zerofill {
-		opts = append(opts, keywordStrings[ZEROFILL])
+		buf.Myprintf(" %s", keywordStrings[ZEROFILL])
 	}
 	if ct.Charset != "" {
-		opts = append(opts, keywordStrings[CHARACTER], keywordStrings[SET], ct.Charset)
+		buf.Myprintf(" %s %s %s", keywordStrings[CHARACTER], keywordStrings[SET], ct.Charset)
 	}
 	if ct.Collate != "" {
-		opts = append(opts, keywordStrings[COLLATE], ct.Collate)
+		buf.Myprintf(" %s %s", keywordStrings[COLLATE], ct.Collate)
 	}
 	if ct.NotNull {
-		opts = append(opts, keywordStrings[NOT], keywordStrings[NULL])
+		buf.Myprintf(" %s %s", keywordStrings[NOT], keywordStrings[NULL])
 	}
 	if ct.Default != nil {
-		opts = append(opts, keywordStrings[DEFAULT], String(ct.Default, false))
+		buf.Myprintf(" %s %v", keywordStrings[DEFAULT], ct.Default)
 	}
 	if ct.OnUpdate != nil {
-		opts = append(opts, keywordStrings[ON], keywordStrings[UPDATE], String(ct.OnUpdate, false))
+		buf.Myprintf(" %s %s %v", keywordStrings[ON], keywordStrings[UPDATE], ct.OnUpdate)
 	}
 	if ct.Autoincrement {
-		opts = append(opts, keywordStrings[AUTO_INCREMENT])
+		buf.Myprintf(" %s", keywordStrings[AUTO_INCREMENT])
 	}
 	if ct.Comment != nil {
-		opts = append(opts, keywordStrings[COMMENT_KEYWORD], String(ct.Comment, false))
-	}
-	if ct.KeyOpt == colKeyPrimary {
-		opts = append(opts, keywordStrings[PRIMARY], keywordStrings[KEY])
+		buf.Myprintf(" %s %v", keywordStrings[COMMENT_KEYWORD], ct.Comment)
 	}
-	if ct.KeyOpt == colKeyUnique {
-		opts = append(opts, keywordStrings[UNIQUE])
-	}
-	if ct.KeyOpt == colKeyUniqueKey {
-		opts = append(opts, keywordStrings[UNIQUE], keywordStrings[KEY])
-	}
-	if ct.KeyOpt == colKeySpatialKey {
-		opts = append(opts, keywordStrings[SPATIAL], keywordStrings[KEY])
-	}
-	if ct.KeyOpt == colKey {
-		opts = append(opts, keywordStrings[KEY])
-	}
-
-	if len(opts) != 0 {
-		buf.Myprintf(" %s", strings.Join(opts, " "))
+	switch ct.KeyOpt {
+	case colKeyPrimary:
+		buf.Myprintf(" %s %s", keywordStrings[PRIMARY], keywordStrings[KEY])
+	case colKeyUnique:
+		buf.Myprintf(" %s", keywordStrings[UNIQUE])
+	case colKeyUniqueKey:
+		buf.Myprintf(" %s %s", keywordStrings[UNIQUE], keywordStrings[KEY])
+	case colKeySpatialKey:
+		buf.Myprintf(" %s %s", keywordStrings[SPATIAL], keywordStrings[KEY])
+	case colKey:
+		buf.Myprintf(" %s", keywordStrings[KEY])
 	}
 }
 
@@ -1023,6 +1261,9 @@ func (ct *ColumnType) DescribeType() string {
 	} else if ct.Length != nil {
 		buf.Myprintf("(%v)", ct.Length)
 	}
+	if ct.Complex != nil {
+		buf.Myprintf("%v", ct.Complex)
+	}
 
 	opts := make([]string, 0, 16)
 	if ct.Unsigned {
@@ -1115,6 +1356,12 @@ func (ct *ColumnType) SQLType() querypb.Type {
 		return sqltypes.Set
 	case keywordStrings[JSON]:
 		return sqltypes.TypeJSON
+	case keywordStrings[STRINGKW]:
+		return sqltypes.Text
+	case keywordStrings[BOOLEAN]:
+		return sqltypes.Int8
+	case ArrayStr, MapStr, StructStr:
+		return sqltypes.TypeJSON
 	case keywordStrings[GEOMETRY]:
 		return sqltypes.Geometry
 	case keywordStrings[POINT]:
@@ -1688,6 +1935,69 @@ func (node Partitions) walkSubtree(visit Visit) error {
 	return nil
 }
 
+// PartitionValues represents the Hive PARTITION clause of an INSERT.
+type PartitionValues []*PartitionValue
+
+// Format formats the node.
+func (node PartitionValues) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	prefix := " partition ("
+	for _, n := range node {
+		buf.Myprintf("%s%v", prefix, n)
+		prefix = ", "
+	}
+	buf.WriteString(")")
+}
+
+func (node PartitionValues) walkSubtree(visit Visit) error {
+	for _, n := range node {
+		if err := Walk(visit, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsDynamic returns true if any of the partition columns is
+// not given a static value.
+func (node PartitionValues) IsDynamic() bool {
+	for _, n := range node {
+		if n.Value == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// PartitionValue represents a single partition column of a Hive
+// PARTITION clause. Value is nil for a dynamic partition column.
+type PartitionValue struct {
+	Name  ColIdent
+	Value Expr
+}
+
+// Format formats the node.
+func (node *PartitionValue) Format(buf *TrackedBuffer) {
+	if node.Value == nil {
+		buf.Myprintf("%v", node.Name)
+		return
+	}
+	buf.Myprintf("%v = %v", node.Name, node.Value)
+}
+
+func (node *PartitionValue) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Name,
+		node.Value,
+	)
+}
+
 // TableExprs represents a list of table expressions.
 type TableExprs []TableExpr
 
@@ -1723,10 +2033,11 @@ func (*JoinTableExpr) iTableExpr()    {}
 // coupled with an optional alias or index hint.
 // If As is empty, no alias was used.
 type AliasedTableExpr struct {
-	Expr       SimpleTableExpr
-	Partitions Partitions
-	As         TableIdent
-	Hints      *IndexHints
+	Expr         SimpleTableExpr
+	Partitions   Partitions
+	As           TableIdent
+	Hints        *IndexHints
+	LateralViews LateralViews
 }
 
 // Format formats the node.
@@ -1739,6 +2050,9 @@ func (node *AliasedTableExpr) Format(buf *TrackedBuffer) {
 		// Hint node provides the space padding.
 		buf.Myprintf("%v", node.Hints)
 	}
+	if node.LateralViews != nil {
+		buf.Myprintf("%v", node.LateralViews)
+	}
 }
 
 func (node *AliasedTableExpr) walkSubtree(visit Visit) error {
@@ -1750,6 +2064,7 @@ func (node *AliasedTableExpr) walkSubtree(visit Visit) error {
 		node.Expr,
 		node.As,
 		node.Hints,
+		node.LateralViews,
 	)
 }
 
@@ -1760,6 +2075,62 @@ func (node *AliasedTableExpr) RemoveHints() *AliasedTableExpr {
 	return &noHints
 }
 
+// LateralViews represents the list of Hive LATERAL VIEW clauses
+// applied to a table expression.
+type LateralViews []*LateralView
+
+// Format formats the node.
+func (node LateralViews) Format(buf *TrackedBuffer) {
+	for _, n := range node {
+		buf.Myprintf(" %v", n)
+	}
+}
+
+func (node LateralViews) walkSubtree(visit Visit) error {
+	for _, n := range node {
+		if err := Walk(visit, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LateralView represents a Hive LATERAL VIEW [OUTER] clause.
+// Func is the table generating function, As the alias of the
+// generated table and Columns the aliases of its columns.
+type LateralView struct {
+	Outer   bool
+	Func    *FuncExpr
+	As      TableIdent
+	Columns Columns
+}
+
+// Format formats the node.
+func (node *LateralView) Format(buf *TrackedBuffer) {
+	var outer string
+	if node.Outer {
+		outer = "outer "
+	}
+	buf.Myprintf("lateral view %s%v %v", outer, node.Func, node.As)
+	prefix := " as "
+	for _, n := range node.Columns {
+		buf.Myprintf("%s%v", prefix, n)
+		prefix = ", "
+	}
+}
+
+func (node *LateralView) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Func,
+		node.As,
+		node.Columns,
+	)
+}
+
 // SimpleTableExpr represents a simple table expression.
 type SimpleTableExpr interface {
 	iSimpleTableExpr()
@@ -2011,6 +2382,7 @@ func (*IsExpr) iExpr()           {}
 func (*ExistsExpr) iExpr()       {}
 func (*SQLVal) iExpr()           {}
 func (*NullVal) iExpr()          {}
+func (*TemplateVar) iExpr()      {}
 func (BoolVal) iExpr()           {}
 func (*ColName) iExpr()          {}
 func (ValTuple) iExpr()          {}
@@ -2025,6 +2397,7 @@ func (*CaseExpr) iExpr()         {}
 func (*ValuesFuncExpr) iExpr()   {}
 func (*ConvertExpr) iExpr()      {}
 func (*BracketExpr) iExpr()      {}
+func (*FieldAccessExpr) iExpr()  {}
 func (*SubstrExpr) iExpr()       {}
 func (*ConvertUsingExpr) iExpr() {}
 func (*MatchExpr) iExpr()        {}
@@ -2404,7 +2777,7 @@ func NewValArg(in []byte) *SQLVal {
 func (node *SQLVal) Format(buf *TrackedBuffer) {
 	switch node.Type {
 	case StrVal:
-		sqltypes.MakeTrusted(sqltypes.VarBinary, node.Val).EncodeSQL(buf)
+		buf.WriteQuotedString(node.Val)
 	case IntVal, FloatVal, HexNum:
 		buf.Myprintf("%s", []byte(node.Val))
 	case HexVal:
@@ -2452,6 +2825,31 @@ func (node *NullVal) replace(from, to Expr) bool {
 	return false
 }
 
+// TemplateVar represents an unquoted template variable such as ${date}
+// or ${hivevar:x}, which is substituted in the script text before it runs.
+// Name doesn't include the enclosing ${}.
+type TemplateVar struct {
+	Name string
+}
+
+// NewTemplateVar builds a new TemplateVar from the scanned ${name}.
+func NewTemplateVar(in []byte) *TemplateVar {
+	return &TemplateVar{Name: string(in[2 : len(in)-1])}
+}
+
+// Format formats the node.
+func (node *TemplateVar) Format(buf *TrackedBuffer) {
+	buf.Myprintf("${%s}", node.Name)
+}
+
+func (node *TemplateVar) walkSubtree(visit Visit) error {
+	return nil
+}
+
+func (node *TemplateVar) replace(from, to Expr) bool {
+	return false
+}
+
 // BoolVal is true or false.
 type BoolVal bool
 
@@ -2740,6 +3138,10 @@ func (node *FuncExpr) Format(buf *TrackedBuffer) {
 	// name as is.
 	buf.Myprintf("%s(%s%v)", node.Name.String(), distinct, node.Exprs)
 	if node.Over != nil {
+		if node.Over.IsReference() {
+			buf.Myprintf(" over %v", node.Over.Name)
+			return
+		}
 		buf.Myprintf(" over (%v)", node.Over)
 	}
 }
@@ -2775,24 +3177,40 @@ func (node *FuncExpr) replace(from, to Expr) bool {
 	return false
 }
 
+// WindowSpecification represents the contents of an OVER clause
+// or of a named window definition. Name is set if the specification
+// refers to a window defined in the WINDOW clause of the query.
 type WindowSpecification struct {
+	Name        ColIdent
 	PartitionBy Exprs
 	OrderBy     OrderBy
+	Frame       *WindowFrame
 }
 
+// Format formats the node.
 func (node *WindowSpecification) Format(buf *TrackedBuffer) {
 	if node == nil {
 		return
 	}
+	var sep string
+	if !node.Name.IsEmpty() {
+		buf.Myprintf("%v", node.Name)
+		sep = " "
+	}
 	if len(node.PartitionBy) > 0 {
 		buf.Myprintf("partition by %v", node.PartitionBy)
+		sep = " "
 	}
 	if len(node.OrderBy) > 0 {
-		prefix := " order by "
+		prefix := sep + "order by "
 		for _, n := range node.OrderBy {
 			buf.Myprintf("%s%v", prefix, n)
 			prefix = ", "
 		}
+		sep = " "
+	}
+	if node.Frame != nil {
+		buf.Myprintf("%s%v", sep, node.Frame)
 	}
 }
 
@@ -2802,11 +3220,19 @@ func (node *WindowSpecification) walkSubtree(visit Visit) error {
 	}
 	return Walk(
 		visit,
+		node.Name,
 		node.PartitionBy,
 		node.OrderBy,
+		node.Frame,
 	)
 }
 
+// IsReference returns true if the specification only names
+// another window, as in OVER w.
+func (node *WindowSpecification) IsReference() bool {
+	return !node.Name.IsEmpty() && len(node.PartitionBy) == 0 && len(node.OrderBy) == 0 && node.Frame == nil
+}
+
 func (node *WindowSpecification) replace(from, to Expr) bool {
 	if node == nil {
 		return false
@@ -2825,7 +3251,191 @@ func (node *WindowSpecification) replace(from, to Expr) bool {
 			return true
 		}
 	}
-	return false
+	return node.Frame.replace(from, to)
+}
+
+// NamedWindows represents a WINDOW clause.
+type NamedWindows []*NamedWindow
+
+// Format formats the node.
+func (node NamedWindows) Format(buf *TrackedBuffer) {
+	prefix := " window "
+	for _, n := range node {
+		buf.Myprintf("%s%v", prefix, n)
+		prefix = ", "
+	}
+}
+
+func (node NamedWindows) walkSubtree(visit Visit) error {
+	for _, n := range node {
+		if err := Walk(visit, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Find returns the window definition with the given name, or nil.
+func (node NamedWindows) Find(name ColIdent) *NamedWindow {
+	for _, n := range node {
+		if n.Name.Equal(name) {
+			return n
+		}
+	}
+	return nil
+}
+
+// Resolve returns the effective window specification of spec by
+// following its chain of named window references. The returned
+// specification never has a Name. The rules follow MySQL: a referencing
+// window may not redefine the partitioning, may only add an ordering if
+// the referenced window has none, and may not refer to a window that
+// defines a frame.
+func (node NamedWindows) Resolve(spec *WindowSpecification) (*WindowSpecification, error) {
+	if spec == nil {
+		return nil, nil
+	}
+	resolved := &WindowSpecification{
+		PartitionBy: spec.PartitionBy,
+		OrderBy:     spec.OrderBy,
+		Frame:       spec.Frame,
+	}
+	seen := make(map[string]bool)
+	for name := spec.Name; !name.IsEmpty(); {
+		if seen[name.Lowered()] {
+			return nil, fmt.Errorf("window %s is defined in terms of itself", name.String())
+		}
+		seen[name.Lowered()] = true
+		def := node.Find(name)
+		if def == nil || def.Spec == nil {
+			return nil, fmt.Errorf("window %s is not defined", name.String())
+		}
+		base := def.Spec
+		if base.Frame != nil && !spec.IsReference() {
+			return nil, fmt.Errorf("window %s has a frame definition, so cannot be referenced by another window", name.String())
+		}
+		if len(resolved.PartitionBy) > 0 {
+			return nil, fmt.Errorf("window referencing %s cannot have its own partition by", name.String())
+		}
+		if len(resolved.OrderBy) > 0 && len(base.OrderBy) > 0 {
+			return nil, fmt.Errorf("window referencing %s cannot override its order by", name.String())
+		}
+		resolved.PartitionBy = base.PartitionBy
+		if len(resolved.OrderBy) == 0 {
+			resolved.OrderBy = base.OrderBy
+		}
+		if resolved.Frame == nil {
+			resolved.Frame = base.Frame
+		}
+		spec = base
+		name = base.Name
+	}
+	return resolved, nil
+}
+
+// NamedWindow represents a single window definition of a WINDOW clause.
+type NamedWindow struct {
+	Name ColIdent
+	Spec *WindowSpecification
+}
+
+// Format formats the node.
+func (node *NamedWindow) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v as (%v)", node.Name, node.Spec)
+}
+
+func (node *NamedWindow) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Name,
+		node.Spec,
+	)
+}
+
+// WindowFrame represents the ROWS or RANGE frame of a window specification.
+// End is nil if the frame was specified with a single bound.
+type WindowFrame struct {
+	Unit  string
+	Start *FrameBound
+	End   *FrameBound
+}
+
+// WindowFrame.Unit
+const (
+	RowsStr  = "rows"
+	RangeStr = "range"
+)
+
+// Format formats the node.
+func (node *WindowFrame) Format(buf *TrackedBuffer) {
+	if node.End == nil {
+		buf.Myprintf("%s %v", node.Unit, node.Start)
+		return
+	}
+	buf.Myprintf("%s between %v and %v", node.Unit, node.Start, node.End)
+}
+
+func (node *WindowFrame) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Start,
+		node.End,
+	)
+}
+
+func (node *WindowFrame) replace(from, to Expr) bool {
+	if node == nil {
+		return false
+	}
+	return node.Start.replace(from, to) || node.End.replace(from, to)
+}
+
+// FrameBound represents one bound of a window frame.
+// Expr is set only for the PrecedingStr and FollowingStr types.
+type FrameBound struct {
+	Type string
+	Expr Expr
+}
+
+// FrameBound.Type
+const (
+	UnboundedPrecedingStr = "unbounded preceding"
+	UnboundedFollowingStr = "unbounded following"
+	CurrentRowStr         = "current row"
+	PrecedingStr          = "preceding"
+	FollowingStr          = "following"
+)
+
+// Format formats the node.
+func (node *FrameBound) Format(buf *TrackedBuffer) {
+	if node.Expr == nil {
+		buf.Myprintf("%s", node.Type)
+		return
+	}
+	buf.Myprintf("%v %s", node.Expr, node.Type)
+}
+
+func (node *FrameBound) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Expr,
+	)
+}
+
+func (node *FrameBound) replace(from, to Expr) bool {
+	if node == nil {
+		return false
+	}
+	return replaceExprs(from, to, &node.Expr)
 }
 
 // Aggregates is a map of all aggregate functions.
@@ -2952,6 +3562,35 @@ func (node *BracketExpr) replace(from, to Expr) bool {
 	return replaceExprs(from, to, &node.Expr, &node.Index)
 }
 
+// FieldAccessExpr represents the access of a struct field, as in
+// t.profile.address or props['k'].value. Column names are only turned
+// into field accesses when the qualifier doesn't name a table in scope,
+// see ResolveFieldAccess.
+type FieldAccessExpr struct {
+	Expr  Expr
+	Field ColIdent
+}
+
+// Format formats the node.
+func (node *FieldAccessExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v.%v", node.Expr, node.Field)
+}
+
+func (node *FieldAccessExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Expr,
+		node.Field,
+	)
+}
+
+func (node *FieldAccessExpr) replace(from, to Expr) bool {
+	return replaceExprs(from, to, &node.Expr)
+}
+
 // Format formats the node.
 func (node *SubstrExpr) Format(buf *TrackedBuffer) {
 
@@ -3036,12 +3675,14 @@ func (node *ConvertUsingExpr) replace(from, to Expr) bool {
 }
 
 // ConvertType represents the type in call to CONVERT(expr, type)
+// Complex is set for the Hive array, map and struct types.
 type ConvertType struct {
 	Type     string
 	Length   *SQLVal
 	Scale    *SQLVal
 	Operator string
 	Charset  string
+	Complex  *ComplexType
 }
 
 // this string is "character set" and this comment is required
@@ -3062,12 +3703,96 @@ func (node *ConvertType) Format(buf *TrackedBuffer) {
 	if node.Charset != "" {
 		buf.Myprintf("%s %s", node.Operator, node.Charset)
 	}
+	if node.Complex != nil {
+		buf.Myprintf("%v", node.Complex)
+	}
 }
 
 func (node *ConvertType) walkSubtree(visit Visit) error {
 	return nil
 }
 
+// ParseConvertType parses a type as it would appear in a CAST,
+// for example "decimal(10, 2)" or "map<string,array<int>>".
+func ParseConvertType(typ string) (*ConvertType, error) {
+	stmt, err := Parse("select cast(null as " + typ + ")")
+	if err != nil {
+		return nil, fmt.Errorf("invalid type %q: %v", typ, err)
+	}
+	// Make sure nothing but the type was parsed.
+	if sel, ok := stmt.(*Select); ok && len(sel.SelectExprs) == 1 {
+		if expr, ok := sel.SelectExprs[0].(*AliasedExpr); ok {
+			if convert, ok := expr.Expr.(*ConvertExpr); ok && String(sel, false) == "select cast(null as "+String(convert.Type, false)+") from dual" {
+				return convert.Type, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("invalid type %q", typ)
+}
+
+// ComplexType represents the element types of a Hive array, map or
+// struct type. Value is the element type of an array and the value
+// type of a map.
+type ComplexType struct {
+	Key    *ConvertType
+	Value  *ConvertType
+	Fields []*StructField
+}
+
+// Complex type names.
+const (
+	ArrayStr  = "array"
+	MapStr    = "map"
+	StructStr = "struct"
+)
+
+// Format formats the node.
+func (node *ComplexType) Format(buf *TrackedBuffer) {
+	switch {
+	case node.Fields != nil:
+		prefix := "<"
+		for _, field := range node.Fields {
+			buf.Myprintf("%s%v", prefix, field)
+			prefix = ","
+		}
+		buf.Myprintf(">")
+	case node.Key != nil:
+		buf.Myprintf("<%v,%v>", node.Key, node.Value)
+	default:
+		buf.Myprintf("<%v>", node.Value)
+	}
+}
+
+func (node *ComplexType) walkSubtree(visit Visit) error {
+	return nil
+}
+
+// Field returns the type of the named struct field, or nil
+// if there is no such field.
+func (node *ComplexType) Field(name string) *ConvertType {
+	for _, field := range node.Fields {
+		if field.Name.EqualString(name) {
+			return field.Type
+		}
+	}
+	return nil
+}
+
+// StructField represents a name:type field of a struct type.
+type StructField struct {
+	Name ColIdent
+	Type *ConvertType
+}
+
+// Format formats the node.
+func (node *StructField) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v:%v", node.Name, node.Type)
+}
+
+func (node *StructField) walkSubtree(visit Visit) error {
+	return nil
+}
+
 // MatchExpr represents a call to the MATCH function
 type MatchExpr struct {
 	Columns SelectExprs
@@ -3599,6 +4324,12 @@ func formatID(buf *TrackedBuffer, original, lowered string) {
 	if len(original) > 1 && original[:2] == "@@" {
 		isDbSystemVariable = true
 	}
+	// Identifiers with template variables, like ${db} or dm_${env},
+	// must stay unquoted for the substitution to produce a valid name.
+	if strings.Contains(original, "${") {
+		buf.Myprintf("%s", original)
+		return
+	}
 
 	for i, c := range original {
 		if !isLetter(uint16(c)) && (!isDbSystemVariable || !isCarat(uint16(c))) {
@@ -308,6 +308,12 @@ func TestReplaceExpr(t *testing.T) {
 	}, {
 		in:  "select * from t where group_concat(1 order by a, (select a from b))",
 		out: "group_concat(1 order by a asc, :a asc)",
+	}, {
+		in:  "select * from t where func(1) over (partition by (select a from b))",
+		out: "func(1) over (partition by :a)",
+	}, {
+		in:  "select * from t where func(1) over (order by a rows between (select a from b) preceding and current row)",
+		out: "func(1) over (order by a asc rows between :a preceding and current row)",
 	}, {
 		in:  "select * from t where substr(a, (select a from b), b)",
 		out: "substr(a, :a, b)",
@@ -529,6 +535,96 @@ func TestHexDecode(t *testing.T) {
 	}
 }
 
+func TestQuoteMode(t *testing.T) {
+	testcases := []struct {
+		in     string
+		single string
+		double string
+		json   string
+	}{{
+		in:     "abc",
+		single: "'abc'",
+		double: `"abc"`,
+		json:   `"abc"`,
+	}, {
+		in:     "it's",
+		single: `'it\'s'`,
+		double: `"it's"`,
+		json:   `"it's"`,
+	}, {
+		in:     `say "hi"`,
+		single: `'say \"hi\"'`,
+		double: `"say \"hi\""`,
+		json:   `"say \"hi\""`,
+	}, {
+		in:     `a\b`,
+		single: `'a\\b'`,
+		double: `"a\\b"`,
+		json:   `"a\\b"`,
+	}, {
+		in:     "tab\tline\n",
+		single: `'tab\tline\n'`,
+		double: `"tab\tline\n"`,
+		json:   `"tab\tline\n"`,
+	}, {
+		in:     "\x01<&>",
+		single: "'\x01<&>'",
+		double: "\"\x01<&>\"",
+		json:   `"\u0001<&>"`,
+	}, {
+		in:     "中文 ✓",
+		single: "'中文 ✓'",
+		double: `"中文 ✓"`,
+		json:   `"中文 ✓"`,
+	}}
+	for _, tcase := range testcases {
+		val := NewStrVal([]byte(tcase.in))
+		for _, quoting := range []struct {
+			mode QuoteMode
+			want string
+		}{
+			{SingleQuote, tcase.single},
+			{DoubleQuote, tcase.double},
+			{JSONQuote, tcase.json},
+		} {
+			got := StringWithQuoteMode(val, false, quoting.mode)
+			if got != quoting.want {
+				t.Errorf("StringWithQuoteMode(%q, %d): %s, want %s", tcase.in, quoting.mode, got, quoting.want)
+				continue
+			}
+			if quoting.mode == JSONQuote {
+				var decoded string
+				if err := json.Unmarshal([]byte(got), &decoded); err != nil || decoded != tcase.in {
+					t.Errorf("json.Unmarshal(%s): %q, %v, want %q", got, decoded, err, tcase.in)
+				}
+				continue
+			}
+			// The quoted literal must read back as the original value.
+			tree, err := Parse("select " + got + " from t")
+			if err != nil {
+				t.Errorf("Parse(%s) err: %v", got, err)
+				continue
+			}
+			expr := tree.(*Select).SelectExprs[0].(*AliasedExpr).Expr
+			if parsed, ok := expr.(*SQLVal); !ok || string(parsed.Val) != tcase.in {
+				t.Errorf("Parse(%s): %s, want %q", got, String(expr, false), tcase.in)
+			}
+		}
+	}
+	if got := String(NewStrVal([]byte("it's")), false); got != `'it\'s'` {
+		t.Errorf("String: %s, want single quotes by default", got)
+	}
+
+	tree, err := Parse("create table t (a varchar(8) default 'x' comment 'it''s')")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "create table t (\n\ta varchar(8) default \"x\" comment \"it's\"\n)"
+	if got := StringWithQuoteMode(tree, false, DoubleQuote); got != want {
+		t.Errorf("StringWithQuoteMode(%s, DoubleQuote):\n%s, want\n%s", String(tree, false), got, want)
+	}
+}
+
 func TestCompliantName(t *testing.T) {
 	testcases := []struct {
 		in, out string
@@ -634,3 +730,134 @@ func TestSplitStatementToPieces(t *testing.T) {
 		}
 	}
 }
+
+func TestNamedWindowsResolve(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+		err string
+	}{{
+		in:  "select sum(x) over (partition by a order by b) from t",
+		out: "partition by a order by b asc",
+	}, {
+		in:  "select sum(x) over w from t window w as (partition by a order by b)",
+		out: "partition by a order by b asc",
+	}, {
+		in:  "select sum(x) over (w order by b rows 1 preceding) from t window w as (partition by a)",
+		out: "partition by a order by b asc rows 1 preceding",
+	}, {
+		in:  "select sum(x) over w2 from t window w as (partition by a), w2 as (w order by b)",
+		out: "partition by a order by b asc",
+	}, {
+		in:  "select sum(x) over w from t window w2 as (partition by a)",
+		err: "window w is not defined",
+	}, {
+		in:  "select sum(x) over (w partition by b) from t window w as (partition by a)",
+		err: "window referencing w cannot have its own partition by",
+	}, {
+		in:  "select sum(x) over (w order by c) from t window w as (order by b)",
+		err: "window referencing w cannot override its order by",
+	}, {
+		in:  "select sum(x) over (w order by c) from t window w as (rows 1 preceding)",
+		err: "window w has a frame definition, so cannot be referenced by another window",
+	}, {
+		in:  "select sum(x) over w from t window w as (w2), w2 as (w)",
+		err: "window w is defined in terms of itself",
+	}}
+	for _, tcase := range testcases {
+		tree, err := Parse(tcase.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sel := tree.(*Select)
+		fn := sel.SelectExprs[0].(*AliasedExpr).Expr.(*FuncExpr)
+		spec, err := sel.Windows.Resolve(fn.Over)
+		if tcase.err != "" {
+			if err == nil || err.Error() != tcase.err {
+				t.Errorf("Resolve(%s) err: %v, want %s", tcase.in, err, tcase.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Resolve(%s) err: %v", tcase.in, err)
+			continue
+		}
+		if got := String(spec, false); got != tcase.out {
+			t.Errorf("Resolve(%s): %s, want %s", tcase.in, got, tcase.out)
+		}
+	}
+}
+
+func TestHiveTableSpec(t *testing.T) {
+	tree, err := ParseStrictDDL("create table t (id bigint) partitioned by (dt string, hour string) clustered by (id) into 4 buckets tblproperties ('orc.compress' = 'ZLIB')")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := tree.(*DDL).TableSpec
+	var partitions []string
+	for _, col := range ts.PartitionedBy {
+		partitions = append(partitions, col.Name.String())
+	}
+	if got, want := strings.Join(partitions, ","), "dt,hour"; got != want {
+		t.Errorf("PartitionedBy: %s, want %s", got, want)
+	}
+	if got, want := String(ts.ClusteredBy.Buckets, false), "4"; got != want {
+		t.Errorf("Buckets: %s, want %s", got, want)
+	}
+	if got := ts.TblProperties.Find("orc.compress"); got == nil || string(got.Val) != "ZLIB" {
+		t.Errorf("Find(orc.compress): %v, want ZLIB", got)
+	}
+	if got := ts.TblProperties.Find("missing"); got != nil {
+		t.Errorf("Find(missing): %v, want nil", got)
+	}
+}
+
+func TestParseConvertType(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+		err string
+	}{{
+		in:  "double",
+		out: "double",
+	}, {
+		in:  "DECIMAL(10,2)",
+		out: "decimal(10, 2)",
+	}, {
+		in:  "map<string, array<struct<name:string,weight:double>>>",
+		out: "map<string,array<struct<name:string,weight:double>>>",
+	}, {
+		in:  "array<>",
+		err: `invalid type "array<>": syntax error at position 28`,
+	}, {
+		in:  "int) from t where (1",
+		err: `invalid type "int) from t where (1"`,
+	}}
+	for _, tcase := range testcases {
+		got, err := ParseConvertType(tcase.in)
+		if tcase.err != "" {
+			if err == nil || err.Error() != tcase.err {
+				t.Errorf("ParseConvertType(%s) err: %v, want %s", tcase.in, err, tcase.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseConvertType(%s) err: %v", tcase.in, err)
+			continue
+		}
+		if out := String(got, false); out != tcase.out {
+			t.Errorf("ParseConvertType(%s): %s, want %s", tcase.in, out, tcase.out)
+		}
+	}
+
+	typ, err := ParseConvertType("struct<name:string,weight:map<string,double>>")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := typ.Complex.Field("weight"); got == nil || String(got.Complex.Value, false) != "double" {
+		t.Errorf("Field(weight): %v, want map<string,double>", got)
+	}
+	if got := typ.Complex.Field("missing"); got != nil {
+		t.Errorf("Field(missing): %v, want nil", got)
+	}
+}
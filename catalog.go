@@ -0,0 +1,318 @@
+package sqlparser
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Catalog holds the columns of known tables. RewriteSqls uses it to
+// expand SELECT * into the columns it stands for.
+type Catalog struct {
+	tables map[string][]*CatalogColumn
+}
+
+// CatalogColumn is a column of a catalog table.
+type CatalogColumn struct {
+	Name string `json:"name"`
+	Type string `json:"type,omitempty"`
+}
+
+// NewCatalog creates an empty Catalog.
+func NewCatalog() *Catalog {
+	return &Catalog{tables: make(map[string][]*CatalogColumn)}
+}
+
+// AddTable adds or replaces the table with the given name, such as t or
+// db.t.
+func (c *Catalog) AddTable(name string, columns ...*CatalogColumn) {
+	c.tables[strings.ToLower(name)] = columns
+}
+
+// AddDDL adds the tables created by the CREATE TABLE statements of sql.
+// Other statements are ignored.
+func (c *Catalog) AddDDL(sql string) error {
+	tokenizer := NewStringTokenizer(sql)
+	for {
+		stmt, err := ParseNext(tokenizer)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		ddl, ok := stmt.(*DDL)
+		if !ok || ddl.Action != CreateStr {
+			continue
+		}
+		var columns []*CatalogColumn
+		switch {
+		case ddl.Select != nil:
+			// CREATE TABLE ... AS SELECT, where the table spec only has
+			// the Hive clauses and maybe the partition columns.
+			names, missing := newProjectionResolver(c).selectStatementColumns(ddl.Select)
+			if len(missing) != 0 {
+				return fmt.Errorf("create table %s: cannot resolve %s", String(ddl.NewName, false), strings.Join(missing, ", "))
+			}
+			selected := make(map[string]bool, len(names))
+			for _, name := range names {
+				selected[strings.ToLower(name)] = true
+				columns = append(columns, &CatalogColumn{Name: name})
+			}
+			if ddl.TableSpec != nil {
+				for _, col := range ddl.TableSpec.PartitionedBy {
+					if !selected[col.Name.Lowered()] {
+						columns = append(columns, &CatalogColumn{Name: col.Name.String(), Type: col.Type.DescribeType()})
+					}
+				}
+			}
+		case ddl.TableSpec != nil:
+			for _, col := range append(ddl.TableSpec.Columns, ddl.TableSpec.PartitionedBy...) {
+				columns = append(columns, &CatalogColumn{Name: col.Name.String(), Type: col.Type.DescribeType()})
+			}
+		default:
+			continue
+		}
+		c.AddTable(String(ddl.NewName, false), columns...)
+	}
+}
+
+// Table returns the columns of the named table. An unqualified name
+// also matches a table of any database if there's only one.
+func (c *Catalog) Table(name TableName) ([]*CatalogColumn, bool) {
+	if c == nil {
+		return nil, false
+	}
+	if columns, ok := c.tables[strings.ToLower(name.Name.String())]; ok && name.Qualifier.IsEmpty() {
+		return columns, true
+	}
+	if !name.Qualifier.IsEmpty() {
+		if columns, ok := c.tables[strings.ToLower(name.Qualifier.String()+"."+name.Name.String())]; ok {
+			return columns, true
+		}
+		columns, ok := c.tables[strings.ToLower(name.Name.String())]
+		return columns, ok
+	}
+	var found []*CatalogColumn
+	matches := 0
+	for key, columns := range c.tables {
+		if strings.HasSuffix(key, "."+strings.ToLower(name.Name.String())) {
+			found = columns
+			matches++
+		}
+	}
+	return found, matches == 1
+}
+
+// projectionResolver resolves the output columns of select statements
+// from the catalog and the common table expressions in scope.
+type projectionResolver struct {
+	catalog *Catalog
+	ctes    map[string]*CommonTableExpr
+}
+
+func newProjectionResolver(catalog *Catalog) *projectionResolver {
+	return &projectionResolver{catalog: catalog}
+}
+
+// withCTEs returns a resolver that also resolves the given common table
+// expressions.
+func (r *projectionResolver) withCTEs(ctes CommonTableExprs) *projectionResolver {
+	return &projectionResolver{catalog: r.catalog, ctes: scopeCTEs(r.ctes, ctes)}
+}
+
+// scopeCTEs returns the common table expressions in scope, by lowercased
+// name, once ctes are added to those of the enclosing statements.
+func scopeCTEs(scope map[string]*CommonTableExpr, ctes CommonTableExprs) map[string]*CommonTableExpr {
+	scoped := make(map[string]*CommonTableExpr, len(scope)+len(ctes))
+	for name, cte := range scope {
+		scoped[name] = cte
+	}
+	for _, cte := range ctes {
+		scoped[strings.ToLower(cte.Name.String())] = cte
+	}
+	return scoped
+}
+
+// resolvedTable is a table of a FROM clause with its columns.
+type resolvedTable struct {
+	name    TableIdent
+	columns []string
+}
+
+// selectStatementColumns returns the names of the output columns of
+// stmt, and a description of what couldn't be resolved.
+func (r *projectionResolver) selectStatementColumns(stmt SelectStatement) ([]string, []string) {
+	switch stmt := stmt.(type) {
+	case *Select:
+		var (
+			names   []string
+			missing []string
+		)
+		for _, expr := range stmt.SelectExprs {
+			switch expr := expr.(type) {
+			case *StarExpr:
+				exprs, starMissing := r.expandStar(expr, stmt.From)
+				missing = append(missing, starMissing...)
+				for _, expanded := range exprs {
+					names = append(names, aliasOrColumnName(expanded.(*AliasedExpr)))
+				}
+			case *AliasedExpr:
+				name := aliasOrColumnName(expr)
+				if name == "" {
+					name = derivedColumnName(len(names))
+				}
+				names = append(names, name)
+			default:
+				missing = append(missing, String(expr, false))
+			}
+		}
+		return names, missing
+	case *Union:
+		return r.selectStatementColumns(stmt.Left)
+	case *ParenSelect:
+		return r.selectStatementColumns(stmt.Select)
+	case *With:
+		return r.withCTEs(stmt.CTEs).selectStatementColumns(stmt.Stmt)
+	}
+	return nil, []string{fmt.Sprintf("columns of %T", stmt)}
+}
+
+// fromTables returns the tables of a FROM clause in order.
+func (r *projectionResolver) fromTables(exprs TableExprs) ([]resolvedTable, []string) {
+	var (
+		tables  []resolvedTable
+		missing []string
+	)
+	for _, expr := range exprs {
+		switch expr := expr.(type) {
+		case *AliasedTableExpr:
+			table := resolvedTable{name: expr.As}
+			switch source := expr.Expr.(type) {
+			case TableName:
+				if table.name.IsEmpty() {
+					table.name = source.Name
+				}
+				columns, ok := r.tableColumns(source)
+				if !ok {
+					missing = append(missing, fmt.Sprintf("table %s is not in the catalog", String(source, false)))
+					continue
+				}
+				table.columns = columns
+			case *Subquery:
+				columns, subqueryMissing := r.selectStatementColumns(source.Select)
+				missing = append(missing, subqueryMissing...)
+				table.columns = columns
+			}
+			tables = append(tables, table)
+			for _, view := range expr.LateralViews {
+				viewTable := resolvedTable{name: view.As}
+				for _, col := range view.Columns {
+					viewTable.columns = append(viewTable.columns, col.String())
+				}
+				tables = append(tables, viewTable)
+			}
+		case *ParenTableExpr:
+			parenTables, parenMissing := r.fromTables(expr.Exprs)
+			tables = append(tables, parenTables...)
+			missing = append(missing, parenMissing...)
+		case *JoinTableExpr:
+			joinTables, joinMissing := r.fromTables(TableExprs{expr.LeftExpr, expr.RightExpr})
+			tables = append(tables, joinTables...)
+			missing = append(missing, joinMissing...)
+		}
+	}
+	return tables, missing
+}
+
+// tableColumns returns the columns of a common table expression in
+// scope or of a catalog table.
+func (r *projectionResolver) tableColumns(name TableName) ([]string, bool) {
+	if cte, ok := r.ctes[strings.ToLower(name.Name.String())]; ok && name.Qualifier.IsEmpty() {
+		if len(cte.Columns) != 0 {
+			columns := make([]string, 0, len(cte.Columns))
+			for _, col := range cte.Columns {
+				columns = append(columns, col.String())
+			}
+			return columns, true
+		}
+		if cte.Subquery == nil {
+			return nil, false
+		}
+		columns, missing := r.selectStatementColumns(cte.Subquery.Select)
+		return columns, len(missing) == 0
+	}
+	catalogColumns, ok := r.catalog.Table(name)
+	if !ok {
+		return nil, false
+	}
+	columns := make([]string, 0, len(catalogColumns))
+	for _, col := range catalogColumns {
+		columns = append(columns, col.Name)
+	}
+	return columns, true
+}
+
+// expandStar returns the columns star stands for. The columns are
+// qualified by their table if star is qualified or if there's more than
+// one table.
+func (r *projectionResolver) expandStar(star *StarExpr, from TableExprs) (SelectExprs, []string) {
+	tables, missing := r.fromTables(from)
+	if len(missing) != 0 {
+		return nil, []string{fmt.Sprintf("%s (%s)", String(star, false), strings.Join(missing, ", "))}
+	}
+	qualify := !star.TableName.IsEmpty() || len(tables) > 1
+	var exprs SelectExprs
+	found := false
+	for _, table := range tables {
+		if !star.TableName.IsEmpty() && !strings.EqualFold(table.name.String(), star.TableName.Name.String()) {
+			continue
+		}
+		found = true
+		for _, column := range table.columns {
+			col := &ColName{Name: NewColIdent(column)}
+			if qualify {
+				col.Qualifier = TableName{Name: table.name}
+			}
+			exprs = append(exprs, &AliasedExpr{Expr: col})
+		}
+	}
+	if !found {
+		return nil, []string{fmt.Sprintf("%s (no table %s in FROM)", String(star, false), star.TableName.Name.String())}
+	}
+	return exprs, nil
+}
+
+// resolveProjection expands the stars of sel and names its unnamed
+// expressions the way Hive does, _c0, _c1 and so on by position.
+func (r *projectionResolver) resolveProjection(sel *Select) error {
+	var (
+		selectExprs SelectExprs
+		missing     []string
+	)
+	for _, expr := range sel.SelectExprs {
+		switch expr := expr.(type) {
+		case *StarExpr:
+			exprs, starMissing := r.expandStar(expr, sel.From)
+			missing = append(missing, starMissing...)
+			selectExprs = append(selectExprs, exprs...)
+			continue
+		case *AliasedExpr:
+			if aliasOrColumnName(expr) == "" {
+				expr.As = NewColIdent(derivedColumnName(len(selectExprs)))
+			}
+		}
+		selectExprs = append(selectExprs, expr)
+	}
+	if len(missing) != 0 {
+		return newRewriteError(ErrUnresolvedProjection, "", "add the tables to the catalog or list the columns explicitly",
+			"cannot resolve projection: %s", strings.Join(missing, ", "))
+	}
+	sel.SelectExprs = selectExprs
+	return nil
+}
+
+func derivedColumnName(i int) string {
+	return "_c" + strconv.Itoa(i)
+}
@@ -0,0 +1,142 @@
+package sqlparser
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func testCatalog(t *testing.T) *Catalog {
+	catalog := NewCatalog()
+	err := catalog.AddDDL(`CREATE TABLE db.leaf (
+  id bigint,
+  name string,
+  tags array<string>
+)
+PARTITIONED BY (dt string);
+DROP TABLE db.other;
+CREATE TABLE db.leaf_copy AS SELECT id, upper(name) FROM db.leaf;
+CREATE TABLE db.leaf_orc STORED AS ORC AS SELECT id, name FROM db.leaf;
+CREATE TABLE db.leaf_daily PARTITIONED BY (day string) STORED AS ORC AS SELECT id, dt FROM db.leaf`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	catalog.AddTable("edges", &CatalogColumn{Name: "src"}, &CatalogColumn{Name: "dst"})
+	return catalog
+}
+
+func TestCatalogTable(t *testing.T) {
+	catalog := testCatalog(t)
+	testcases := []struct {
+		in  string
+		out []*CatalogColumn
+	}{{
+		in: "db.leaf",
+		out: []*CatalogColumn{
+			{Name: "id", Type: "bigint"},
+			{Name: "name", Type: "string"},
+			{Name: "tags", Type: "array<string>"},
+			{Name: "dt", Type: "string"},
+		},
+	}, {
+		in:  "DB.LEAF_COPY",
+		out: []*CatalogColumn{{Name: "id"}, {Name: "_c1"}},
+	}, {
+		in:  "leaf_copy",
+		out: []*CatalogColumn{{Name: "id"}, {Name: "_c1"}},
+	}, {
+		in:  "db.leaf_orc",
+		out: []*CatalogColumn{{Name: "id"}, {Name: "name"}},
+	}, {
+		in:  "leaf_daily",
+		out: []*CatalogColumn{{Name: "id"}, {Name: "dt"}, {Name: "day", Type: "string"}},
+	}, {
+		in:  "other.edges",
+		out: []*CatalogColumn{{Name: "src"}, {Name: "dst"}},
+	}, {
+		in: "other",
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse("select * from " + tcase.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		name := stmt.(*Select).From[0].(*AliasedTableExpr).Expr.(TableName)
+		got, ok := catalog.Table(name)
+		if ok != (tcase.out != nil) || !reflect.DeepEqual(got, tcase.out) {
+			t.Errorf("Table(%s): %v %v, want %v", tcase.in, got, ok, tcase.out)
+		}
+	}
+}
+
+func TestResolveProjection(t *testing.T) {
+	catalog := testCatalog(t)
+	testcases := []struct {
+		in  string
+		out string
+		err string
+	}{{
+		in:  "select *, 'leaf' as point_type from db.leaf",
+		out: "select id, name, tags, dt, 'leaf' as point_type from db.leaf",
+	}, {
+		in:  "select l.*, e.dst from db.leaf as l join edges as e on l.id = e.src",
+		out: "select l.id, l.name, l.tags, l.dt, e.dst from db.leaf as l join edges as e on l.id = e.src",
+	}, {
+		in:  "select * from db.leaf as l join edges as e on l.id = e.src",
+		out: "select l.id, l.name, l.tags, l.dt, e.src, e.dst from db.leaf as l join edges as e on l.id = e.src",
+	}, {
+		in:  "select * from (select a as point_id, concat(a, b) from t) as x lateral view explode(x.tags) v as tag",
+		out: "select x.point_id, x._c1, v.tag from (select a as point_id, concat(a, b) from t) as x lateral view explode(x.tags) v as tag",
+	}, {
+		in:  "select a, upper(b), count(*) from t",
+		out: "select a, upper(b) as _c1, count(*) as _c2 from t",
+	}, {
+		in:  "select *, x.* from db.missing join edges",
+		err: "cannot resolve projection: * (table db.missing is not in the catalog), x.* (table db.missing is not in the catalog)",
+	}, {
+		in:  "select x.* from edges",
+		err: "cannot resolve projection: x.* (no table x in FROM)",
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sel := stmt.(*Select)
+		err = newProjectionResolver(catalog).resolveProjection(sel)
+		if tcase.err != "" {
+			var rewriteErr *RewriteError
+			if !errors.As(err, &rewriteErr) || rewriteErr.Code != ErrUnresolvedProjection || rewriteErr.Message != tcase.err {
+				t.Errorf("resolveProjection(%s) err: %v, want %s", tcase.in, err, tcase.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("resolveProjection(%s) err: %v", tcase.in, err)
+			continue
+		}
+		if got := String(sel, false); got != tcase.out {
+			t.Errorf("resolveProjection(%s):\n%s, want\n%s", tcase.in, got, tcase.out)
+		}
+	}
+}
+
+func TestRewriteSqlsCatalog(t *testing.T) {
+	sql := `WITH base AS (SELECT id AS point_id, name FROM db.leaf)
+SELECT *, 'leaf' AS point_type FROM base`
+	results, err := RewriteSqlsOrdered(sql, WithCatalog(testCatalog(t)), WithDedupPolicy(&DedupPolicy{Strategy: DedupNone}))
+	if err != nil {
+		t.Fatalf("RewriteSqlsOrdered error: %v", err)
+	}
+	want := `select label, id, name from (with base as (select id as point_id, name from db.leaf) ` +
+		`select "leaf" as label, cast(point_id as string) as id, name from base);`
+	if got := results[0].Sql; got != want {
+		t.Errorf("RewriteSqlsOrdered:\n%s, want\n%s", got, want)
+	}
+
+	_, err = RewriteSqlsOrdered("SELECT *, 'leaf' AS point_type FROM db.unknown")
+	var rewriteErr *RewriteError
+	if !errors.As(err, &rewriteErr) || rewriteErr.Code != ErrUnresolvedProjection {
+		t.Errorf("RewriteSqlsOrdered err: %v, want an %s RewriteError", err, ErrUnresolvedProjection)
+	}
+}
@@ -0,0 +1,425 @@
+// Command sqlrewrite rewrites the point and edge statements of SQL
+// scripts into the statements that load each graph label.
+//
+// Usage:
+//
+//	sqlrewrite [flags] [file ...]
+//
+// The files are read in order, as one script, or stdin if there are
+// none. The exit code is 0 if every statement was rewritten cleanly, 1
+// if the script was rewritten with warnings, such as columns padded with
+// NULL or, with -type-check lenient, type map entries that don't apply,
+// and 2 if a statement failed or nothing was rewritten; the labels that
+// could be rewritten are still written. With -format diff, it's 3 if a
+// label file differs and no statement failed. The diff is in the unified
+// format and applies with patch -p0.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/xwb1989/sqlparser"
+)
+
+// Exit codes.
+const (
+	exitClean    = 0
+	exitWarnings = 1
+	exitFailed   = 2
+	exitDiffers  = 3
+)
+
+// Output formats.
+const (
+	formatJSON  = "json"
+	formatFiles = "files"
+	formatDiff  = "diff"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// input is a file of the script.
+type input struct {
+	name string
+	// offset is where the file starts in the script.
+	offset int
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("sqlrewrite", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	var (
+		typeMapFile  = flags.String("type-map", "", "JSON `file` mapping each label to the types of its columns")
+		typeCheck    = flags.String("type-check", "", "check the type map: strict fails on entries that don't apply, lenient reports them as warnings")
+		schemaFile   = flags.String("schema", "", "JSON or YAML graph schema `file`")
+		pretty       = flags.Bool("pretty", false, "pretty-print the rewritten statements")
+		replaceMaxPt = flags.Bool("replace-max-pt", false, "replace date = max_pt(...) with date = '${date}'")
+		format       = flags.String("format", formatJSON, "output `format`: json, files or diff, which exits with 3 if a file differs")
+		outDir       = flags.String("out", ".", "`directory` of the label files written by -format files and compared by -format diff")
+	)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "usage: sqlrewrite [flags] [file ...]\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return exitFailed
+	}
+	switch *format {
+	case formatJSON, formatFiles, formatDiff:
+	default:
+		fmt.Fprintf(stderr, "sqlrewrite: unknown format %s\n", *format)
+		return exitFailed
+	}
+
+	opts := []sqlparser.RewriteOption{
+		sqlparser.WithPretty(*pretty),
+		sqlparser.WithReplaceMaxPt(*replaceMaxPt),
+		sqlparser.WithCollectErrors(true),
+		sqlparser.WithTypeMapValidation(sqlparser.TypeMapValidation(*typeCheck)),
+	}
+	if *typeMapFile != "" {
+		data, err := os.ReadFile(*typeMapFile)
+		if err != nil {
+			fmt.Fprintf(stderr, "sqlrewrite: %v\n", err)
+			return exitFailed
+		}
+		var typeMap map[string]map[string]string
+		if err := json.Unmarshal(data, &typeMap); err != nil {
+			fmt.Fprintf(stderr, "sqlrewrite: type map %s: %v\n", *typeMapFile, err)
+			return exitFailed
+		}
+		opts = append(opts, sqlparser.WithTypeMap(typeMap))
+	}
+	if *schemaFile != "" {
+		data, err := os.ReadFile(*schemaFile)
+		if err != nil {
+			fmt.Fprintf(stderr, "sqlrewrite: %v\n", err)
+			return exitFailed
+		}
+		schema, err := sqlparser.LoadGraphSchema(data)
+		if err != nil {
+			fmt.Fprintf(stderr, "sqlrewrite: %s: %v\n", *schemaFile, err)
+			return exitFailed
+		}
+		opts = append(opts, sqlparser.WithGraphSchema(schema))
+	}
+
+	script, inputs, err := readScript(flags.Args(), stdin)
+	if err != nil {
+		fmt.Fprintf(stderr, "sqlrewrite: %v\n", err)
+		return exitFailed
+	}
+
+	results, err := sqlparser.RewriteSqlsOrdered(script, opts...)
+	warnings, failures := 0, 0
+	var (
+		rewriteErrs sqlparser.RewriteErrors
+		typeIssues  sqlparser.TypeMapIssues
+	)
+	switch {
+	case errors.As(err, &typeIssues):
+		for _, issue := range typeIssues {
+			fmt.Fprintf(stderr, "%s: %v\n", *typeMapFile, issue)
+			warnings++
+		}
+	case errors.As(err, &rewriteErrs):
+		for _, rewriteErr := range rewriteErrs {
+			fmt.Fprintf(stderr, "%s: %s\n", locate(script, inputs, rewriteErr.Start), rewriteErr.Message)
+			if rewriteErr.Hint != "" {
+				fmt.Fprintf(stderr, "\thint: %s\n", rewriteErr.Hint)
+			}
+			failures++
+		}
+	case err != nil:
+		fmt.Fprintf(stderr, "sqlrewrite: %v\n", err)
+		return exitFailed
+	}
+	if len(results) == 0 {
+		fmt.Fprintf(stderr, "sqlrewrite: no statements were rewritten\n")
+		return exitFailed
+	}
+	for _, result := range results {
+		for _, padding := range result.Paddings {
+			source := result.Sources[0]
+			for _, s := range result.Sources {
+				if s.Index == padding.Statement {
+					source = s
+				}
+			}
+			fmt.Fprintf(stderr, "%s: label %s: column %s padded with NULL\n", locate(script, inputs, source.Start), result.Label, padding.Column)
+			warnings++
+		}
+	}
+
+	differs := false
+	switch *format {
+	case formatJSON:
+		err = writeJSON(stdout, results)
+	case formatFiles:
+		err = writeFiles(*outDir, results)
+	case formatDiff:
+		differs, err = writeDiff(stdout, *outDir, results)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "sqlrewrite: %v\n", err)
+		return exitFailed
+	}
+	if failures != 0 {
+		return exitFailed
+	}
+	if differs {
+		return exitDiffers
+	}
+	if warnings != 0 {
+		return exitWarnings
+	}
+	return exitClean
+}
+
+// readScript concatenates the named files, or reads stdin if there are
+// none. The files are separated by semicolons unless they end with one,
+// on a line of their own so that a trailing comment doesn't swallow it.
+func readScript(names []string, stdin io.Reader) (string, []input, error) {
+	if len(names) == 0 {
+		data, err := io.ReadAll(stdin)
+		return string(data), []input{{name: "<stdin>"}}, err
+	}
+	var (
+		script strings.Builder
+		inputs []input
+	)
+	for _, name := range names {
+		data, err := os.ReadFile(name)
+		if err != nil {
+			return "", nil, err
+		}
+		if previous := strings.TrimSpace(script.String()); previous != "" && !strings.HasSuffix(previous, ";") {
+			script.WriteString("\n;\n")
+		}
+		inputs = append(inputs, input{name: name, offset: script.Len()})
+		script.Write(data)
+	}
+	return script.String(), inputs, nil
+}
+
+// locate returns the file and line of offset in the script.
+func locate(script string, inputs []input, offset int) string {
+	in := inputs[0]
+	for _, candidate := range inputs {
+		if candidate.offset <= offset {
+			in = candidate
+		}
+	}
+	if offset > len(script) {
+		offset = len(script)
+	}
+	line := strings.Count(script[in.offset:offset], "\n") + 1
+	return fmt.Sprintf("%s:%d", in.name, line)
+}
+
+func writeJSON(w io.Writer, results []*sqlparser.RewrittenSql) error {
+	defs := make(map[string]*sqlparser.SqlDef, len(results))
+	for _, result := range results {
+		defs[result.Label] = &result.SqlDef
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(defs)
+}
+
+// labelFile returns the file the statement of label is written to.
+func labelFile(dir, label string) string {
+	name := strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || r == os.PathSeparator {
+			return '_'
+		}
+		return r
+	}, label)
+	return filepath.Join(dir, name+".sql")
+}
+
+func writeFiles(dir string, results []*sqlparser.RewrittenSql) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	for _, result := range results {
+		if err := os.WriteFile(labelFile(dir, result.Label), []byte(result.Sql+"\n"), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeDiff writes how the label files in dir differ from the rewritten
+// statements, in the unified format. Missing files are diffed against
+// /dev/null. It returns whether a file differs.
+func writeDiff(w io.Writer, dir string, results []*sqlparser.RewrittenSql) (bool, error) {
+	sorted := append([]*sqlparser.RewrittenSql(nil), results...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Label < sorted[j].Label })
+	differs := false
+	for _, result := range sorted {
+		path := labelFile(dir, result.Label)
+		old, err := os.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			return differs, err
+		}
+		updated := []byte(result.Sql + "\n")
+		if bytes.Equal(old, updated) {
+			continue
+		}
+		differs = true
+		from := path
+		if err != nil {
+			from = os.DevNull
+		}
+		fmt.Fprintf(w, "--- %s\n+++ %s\n", from, path)
+		for _, line := range unifiedHunks(diffLines(splitLines(string(old)), splitLines(string(updated))), diffContext) {
+			fmt.Fprintln(w, line)
+		}
+	}
+	return differs, nil
+}
+
+// noNewline marks a last line that doesn't end with a newline. It can't
+// be part of a line, so such a line never matches the line it's replaced
+// with.
+const noNewline = "\n\\ No newline at end of file"
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	lines := strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+	if !strings.HasSuffix(s, "\n") {
+		lines[len(lines)-1] += noNewline
+	}
+	return lines
+}
+
+// diffContext is the number of unchanged lines around the changes of a
+// hunk.
+const diffContext = 3
+
+// unifiedHunks groups the lines returned by diffLines into hunks with
+// context unchanged lines around their changes, each headed by the
+// line ranges it spans.
+func unifiedHunks(lines []string, context int) []string {
+	var hunks []string
+	// oldLine and newLine number the next line of each side.
+	oldLine, newLine := 1, 1
+	for start := 0; start < len(lines); {
+		change := start
+		for change < len(lines) && lines[change][0] == ' ' {
+			change++
+		}
+		if change == len(lines) {
+			break
+		}
+		first := change - context
+		if first < start {
+			first = start
+		}
+		oldLine += first - start
+		newLine += first - start
+
+		// Changes closer than twice the context share a hunk.
+		end := change
+		for {
+			for end < len(lines) && lines[end][0] != ' ' {
+				end++
+			}
+			next := end
+			for next < len(lines) && lines[next][0] == ' ' {
+				next++
+			}
+			if next == len(lines) || next-end > 2*context {
+				if end += context; end > len(lines) {
+					end = len(lines)
+				}
+				break
+			}
+			end = next
+		}
+
+		oldCount, newCount := 0, 0
+		for _, line := range lines[first:end] {
+			if line[0] != '+' {
+				oldCount++
+			}
+			if line[0] != '-' {
+				newCount++
+			}
+		}
+		hunks = append(hunks, fmt.Sprintf("@@ -%s +%s @@", hunkRange(oldLine, oldCount), hunkRange(newLine, newCount)))
+		hunks = append(hunks, lines[first:end]...)
+		oldLine += oldCount
+		newLine += newCount
+		start = end
+	}
+	return hunks
+}
+
+// hunkRange formats the range of count lines from line. An empty range
+// is numbered by the line before it.
+func hunkRange(line, count int) string {
+	if count == 0 {
+		line--
+	}
+	return fmt.Sprintf("%d,%d", line, count)
+}
+
+// diffLines returns the lines of a and b prefixed with - if they're only
+// in a, + if they're only in b, and a space if they're in both, following
+// a longest common subsequence.
+func diffLines(a, b []string) []string {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	var lines []string
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, " "+a[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, "-"+a[i])
+			i++
+		default:
+			lines = append(lines, "+"+b[j])
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, "-"+a[i])
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, "+"+b[j])
+	}
+	return lines
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	pointSQL = "select id as point_id, 'shop' as point_type, score from t where date = max_pt('db.t')"
+	edgeSQL  = "select src as point1_id, tgt as point2_id, 'shop' as point1_type, 'sim' as point2_type, 'shop_sim' as edge_type from e"
+)
+
+func TestRunJSON(t *testing.T) {
+	dir := t.TempDir()
+	typeMap := filepath.Join(dir, "types.json")
+	if err := os.WriteFile(typeMap, []byte(`{"shop": {"score": "double"}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"--type-map", typeMap, "--replace-max-pt"}, strings.NewReader(pointSQL+";\n"+edgeSQL), &stdout, &stderr)
+	if code != exitClean {
+		t.Fatalf("run: exit %d, stderr %s", code, stderr.String())
+	}
+	var defs map[string]struct {
+		Sql       string `json:"sql"`
+		LabelType string `json:"label_type"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &defs); err != nil {
+		t.Fatalf("run output %s: %v", stdout.String(), err)
+	}
+	if len(defs) != 2 {
+		t.Errorf("run: %d labels, want 2", len(defs))
+	}
+	if sql := defs["shop"].Sql; !strings.Contains(sql, "cast(score as double)") || !strings.Contains(sql, "`date` = \"${date}\"") {
+		t.Errorf("run: shop sql %s", sql)
+	}
+}
+
+func TestRunWarnings(t *testing.T) {
+	dir := t.TempDir()
+	first := filepath.Join(dir, "first.sql")
+	second := filepath.Join(dir, "second.sql")
+	if err := os.WriteFile(first, []byte(pointSQL+";\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(second, []byte("\nselect id as point_id from t;\nselect id as point_id, 'shop' as point_type from u"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	var stdout, stderr bytes.Buffer
+	code := run([]string{first, second}, nil, &stdout, &stderr)
+	if code != exitFailed {
+		t.Fatalf("run: exit %d, want %d; stderr %s", code, exitFailed, stderr.String())
+	}
+	for _, want := range []string{second + ":2: missing required point columns: point_type", second + ":3: label shop: column score padded with NULL"} {
+		if !strings.Contains(stderr.String(), want) {
+			t.Errorf("run stderr:\n%s\nwant %s", stderr.String(), want)
+		}
+	}
+	if !strings.Contains(stdout.String(), `"shop"`) {
+		t.Errorf("run with a failed statement:\n%s\nwant the shop label", stdout.String())
+	}
+
+	// Padding alone is a warning.
+	if err := os.WriteFile(second, []byte("select id as point_id, 'shop' as point_type from u"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	stdout.Reset()
+	stderr.Reset()
+	if code := run([]string{first, second}, nil, &stdout, &stderr); code != exitWarnings {
+		t.Errorf("run with padding: exit %d, want %d; stderr %s", code, exitWarnings, stderr.String())
+	}
+
+	// A file ending with a comment is still separated from the next one.
+	if err := os.WriteFile(first, []byte(pointSQL+"\n-- shops"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(second, []byte(edgeSQL), 0644); err != nil {
+		t.Fatal(err)
+	}
+	stdout.Reset()
+	stderr.Reset()
+	if code := run([]string{first, second}, nil, &stdout, &stderr); code != exitClean {
+		t.Errorf("run with a trailing comment: exit %d, want %d; stderr %s", code, exitClean, stderr.String())
+	}
+	if !strings.Contains(stdout.String(), `"shop_sim"`) {
+		t.Errorf("run with a trailing comment:\n%s\nwant the shop_sim label", stdout.String())
+	}
+
+	stderr.Reset()
+	if code := run(nil, strings.NewReader("select 1 from t"), &stdout, &stderr); code != exitFailed {
+		t.Errorf("run: exit %d, want %d", code, exitFailed)
+	}
+	if code := run([]string{"--format", "xml"}, strings.NewReader(pointSQL), &stdout, &stderr); code != exitFailed {
+		t.Errorf("run with an unknown format: exit %d, want %d", code, exitFailed)
+	}
+}
+
+func TestRunTypeCheck(t *testing.T) {
+	typeMap := filepath.Join(t.TempDir(), "types.json")
+	if err := os.WriteFile(typeMap, []byte(`{"shop": {"score": "dubble"}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	testcases := []struct {
+		check string
+		code  int
+	}{
+		{check: "", code: exitFailed},
+		{check: "lenient", code: exitWarnings},
+		{check: "strict", code: exitFailed},
+		{check: "loose", code: exitFailed},
+	}
+	for _, tcase := range testcases {
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"--type-map", typeMap, "--type-check", tcase.check}, strings.NewReader(pointSQL), &stdout, &stderr)
+		if code != tcase.code {
+			t.Errorf("run -type-check %q: exit %d, want %d; stderr %s", tcase.check, code, tcase.code, stderr.String())
+		}
+		if want := typeMap + ": type map label shop column score: unknown type dubble"; tcase.check == "lenient" && !strings.Contains(stderr.String(), want) {
+			t.Errorf("run -type-check lenient stderr:\n%s\nwant %s", stderr.String(), want)
+		}
+	}
+}
+
+func TestRunFilesAndDiff(t *testing.T) {
+	dir := t.TempDir()
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"--format", "files", "--out", dir}, strings.NewReader(pointSQL+";"+edgeSQL), &stdout, &stderr); code != exitClean {
+		t.Fatalf("run: exit %d, stderr %s", code, stderr.String())
+	}
+	for _, label := range []string{"shop", "shop_sim"} {
+		if _, err := os.Stat(filepath.Join(dir, label+".sql")); err != nil {
+			t.Errorf("run: %v", err)
+		}
+	}
+
+	if code := run([]string{"--format", "diff", "--out", dir}, strings.NewReader(pointSQL+";"+edgeSQL), &stdout, &stderr); code != exitClean {
+		t.Fatalf("run: exit %d, stderr %s", code, stderr.String())
+	}
+	if stdout.Len() != 0 {
+		t.Errorf("run: diff of unchanged files:\n%s", stdout.String())
+	}
+
+	if code := run([]string{"--format", "diff", "--out", dir, "--pretty"}, strings.NewReader(edgeSQL), &stdout, &stderr); code != exitDiffers {
+		t.Fatalf("run: exit %d, stderr %s", code, stderr.String())
+	}
+	path := filepath.Join(dir, "shop_sim.sql")
+	for _, want := range []string{"--- " + path + "\n+++ " + path + "\n@@ -1,1 +1,", "\n-select outv_pk_prop, ", "\n+select outv_pk_prop,\n"} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Errorf("run diff:\n%s\nwant %q", stdout.String(), want)
+		}
+	}
+}
+
+func TestUnifiedHunks(t *testing.T) {
+	testcases := []struct {
+		a, b string
+		out  string
+	}{{
+		a:   "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n",
+		b:   "1\nx\n3\n4\n5\n6\n7\n8\n9\n10\n11\ny\n",
+		out: "@@ -1,5 +1,5 @@\n 1\n-2\n+x\n 3\n 4\n 5\n@@ -9,4 +9,4 @@\n 9\n 10\n 11\n-12\n+y",
+	}, {
+		a:   "1\n2\n3\n4\n5\n6\n7\n",
+		b:   "1\n3\n4\n5\n6\n7\nz\n",
+		out: "@@ -1,7 +1,7 @@\n 1\n-2\n 3\n 4\n 5\n 6\n 7\n+z",
+	}, {
+		a:   "",
+		b:   "a\nb\n",
+		out: "@@ -0,0 +1,2 @@\n+a\n+b",
+	}, {
+		a:   "a",
+		b:   "a\n",
+		out: "@@ -1,1 +1,1 @@\n-a\n\\ No newline at end of file\n+a",
+	}}
+	for _, tcase := range testcases {
+		got := strings.Join(unifiedHunks(diffLines(splitLines(tcase.a), splitLines(tcase.b)), diffContext), "\n")
+		if got != tcase.out {
+			t.Errorf("unifiedHunks(%q, %q):\n%s\nwant\n%s", tcase.a, tcase.b, got, tcase.out)
+		}
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	got := diffLines([]string{"a", "b", "c"}, []string{"a", "c", "d"})
+	want := []string{" a", "-b", " c", "+d"}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("diffLines: %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,600 @@
+package sqlparser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ConstValue is the value of a constant expression.
+type ConstValue struct {
+	Value string
+	// Null is set if the value is NULL.
+	Null bool
+}
+
+// ConstFunc folds a call to a function whose arguments are constants.
+type ConstFunc func(args []ConstValue) (ConstValue, error)
+
+// ConstError tells why an expression isn't constant.
+type ConstError struct {
+	Expr   string
+	Reason string
+}
+
+func (e *ConstError) Error() string {
+	return fmt.Sprintf("%s is not constant: %s", e.Expr, e.Reason)
+}
+
+func constError(node SQLNode, format string, args ...interface{}) *ConstError {
+	return &ConstError{Expr: String(node, false), Reason: fmt.Sprintf(format, args...)}
+}
+
+// maxConstDepth bounds how deep derived tables and common table
+// expressions are followed, so that recursive ones terminate.
+const maxConstDepth = 32
+
+var (
+	constTrue  = ConstValue{Value: "true"}
+	constFalse = ConstValue{Value: "false"}
+)
+
+// ConstEvaluator folds expressions to constants. Columns are resolved
+// from the string equalities of WHERE clauses, and from the constants
+// selected by derived tables and common table expressions.
+type ConstEvaluator struct {
+	// WhereBindings resolves a column bound to a string literal by the
+	// WHERE clause, e.g. with link_type = 'shop' or link_type IN ('shop').
+	// A column that IN binds to several literals isn't constant.
+	WhereBindings bool
+	// DerivedTables resolves a column from the derived tables and common
+	// table expressions of the FROM clause.
+	DerivedTables bool
+
+	functions map[string]ConstFunc
+	// usedDerivedTable is set once a column is resolved from a derived
+	// table.
+	usedDerivedTable bool
+	// choices is set while enumerating the values of an expression.
+	choices *constChoices
+	// firstBinding resolves a column that IN binds to several literals
+	// to the first of them. Label mappings that don't enumerate their
+	// labels take the label of the first value this way.
+	firstBinding bool
+}
+
+// NewConstEvaluator returns an evaluator that resolves columns both ways
+// and knows concat, concat_ws, lower, upper, trim, if, coalesce and nvl.
+// The || operator, which is parsed as OR, concatenates strings.
+func NewConstEvaluator() *ConstEvaluator {
+	e := &ConstEvaluator{
+		WhereBindings: true,
+		DerivedTables: true,
+		functions:     make(map[string]ConstFunc),
+	}
+	e.Register("concat", constConcat)
+	e.Register("concat_ws", constConcatWS)
+	e.Register("lower", constStringFunc(strings.ToLower))
+	e.Register("lcase", constStringFunc(strings.ToLower))
+	e.Register("upper", constStringFunc(strings.ToUpper))
+	e.Register("ucase", constStringFunc(strings.ToUpper))
+	e.Register("trim", constStringFunc(strings.TrimSpace))
+	e.Register("if", constIf)
+	e.Register("coalesce", constCoalesce)
+	e.Register("nvl", constCoalesce)
+	return e
+}
+
+// Register adds or replaces the function with the given name.
+func (e *ConstEvaluator) Register(name string, fn ConstFunc) {
+	e.functions[strings.ToLower(name)] = fn
+}
+
+// Eval folds expr, resolving columns from bindings only.
+func (e *ConstEvaluator) Eval(expr Expr, bindings map[string]string) (ConstValue, error) {
+	lowered := make(map[string][]string, len(bindings))
+	for name, value := range bindings {
+		lowered[strings.ToLower(name)] = []string{value}
+	}
+	return e.eval(expr, &constScope{bindings: lowered})
+}
+
+// EvalColumn folds the expression that stmt selects as column.
+func (e *ConstEvaluator) EvalColumn(stmt SelectStatement, column string) (ConstValue, error) {
+	return e.evalColumn(stmt, column, nil, 0)
+}
+
+// evalIn folds expr selected by sel with the given common table
+// expressions in scope.
+func (e *ConstEvaluator) evalIn(expr Expr, sel *Select, ctes map[string]*CommonTableExpr) (ConstValue, error) {
+	return e.eval(expr, e.newScope(sel, ctes, 0))
+}
+
+// constScope is the select an expression is evaluated in.
+type constScope struct {
+	sel      *Select
+	ctes     map[string]*CommonTableExpr
+	bindings map[string][]string
+	depth    int
+}
+
+func (e *ConstEvaluator) newScope(sel *Select, ctes map[string]*CommonTableExpr, depth int) *constScope {
+	scope := &constScope{sel: sel, ctes: ctes, bindings: make(map[string][]string), depth: depth}
+	if e.WhereBindings && sel.Where != nil {
+		collectStringColumnBindings(sel.Where.Expr, scope.bindings)
+	}
+	return scope
+}
+
+func (e *ConstEvaluator) eval(expr Expr, scope *constScope) (ConstValue, error) {
+	switch expr := expr.(type) {
+	case *SQLVal:
+		switch expr.Type {
+		case StrVal, IntVal, FloatVal:
+			return ConstValue{Value: string(expr.Val)}, nil
+		}
+		return ConstValue{}, constError(expr, "unsupported literal")
+	case *NullVal:
+		return ConstValue{Null: true}, nil
+	case BoolVal:
+		if expr {
+			return constTrue, nil
+		}
+		return constFalse, nil
+	case *ParenExpr:
+		return e.eval(expr.Expr, scope)
+	case *ConvertExpr:
+		return e.eval(expr.Expr, scope)
+	case *ColName:
+		return e.evalColName(expr, scope)
+	case *FuncExpr:
+		if e.choices != nil && expr.Name.Lowered() == "if" && len(expr.Exprs) == 3 {
+			if value, ok, err := e.chooseIf(expr, scope); ok {
+				return value, err
+			}
+		}
+		fn, ok := e.functions[expr.Name.Lowered()]
+		if !ok {
+			return ConstValue{}, constError(expr, "function %s is not known", expr.Name.String())
+		}
+		args := make([]ConstValue, 0, len(expr.Exprs))
+		for _, arg := range expr.Exprs {
+			aliased, ok := arg.(*AliasedExpr)
+			if !ok {
+				return ConstValue{}, constError(expr, "unsupported argument %s", String(arg, false))
+			}
+			value, err := e.eval(aliased.Expr, scope)
+			if err != nil {
+				return ConstValue{}, err
+			}
+			args = append(args, value)
+		}
+		value, err := fn(args)
+		if err != nil {
+			return ConstValue{}, constError(expr, "%v", err)
+		}
+		return value, nil
+	case *OrExpr:
+		if isBoolExpr(expr.Left) || isBoolExpr(expr.Right) {
+			return ConstValue{}, constError(expr, "boolean OR is not supported")
+		}
+		left, err := e.eval(expr.Left, scope)
+		if err != nil {
+			return ConstValue{}, err
+		}
+		right, err := e.eval(expr.Right, scope)
+		if err != nil {
+			return ConstValue{}, err
+		}
+		return constConcat([]ConstValue{left, right})
+	case *ComparisonExpr:
+		left, err := e.eval(expr.Left, scope)
+		if err != nil {
+			return ConstValue{}, err
+		}
+		right, err := e.eval(expr.Right, scope)
+		if err != nil {
+			return ConstValue{}, err
+		}
+		switch expr.Operator {
+		case NullSafeEqualStr:
+			return constBool(left == right), nil
+		case EqualStr, NotEqualStr:
+			if left.Null || right.Null {
+				return ConstValue{Null: true}, nil
+			}
+			return constBool((left.Value == right.Value) == (expr.Operator == EqualStr)), nil
+		}
+		return ConstValue{}, constError(expr, "operator %s is not supported", expr.Operator)
+	case *IsExpr:
+		value, err := e.eval(expr.Expr, scope)
+		if err != nil {
+			return ConstValue{}, err
+		}
+		switch expr.Operator {
+		case IsNullStr:
+			return constBool(value.Null), nil
+		case IsNotNullStr:
+			return constBool(!value.Null), nil
+		}
+		return ConstValue{}, constError(expr, "operator %s is not supported", expr.Operator)
+	case *CaseExpr:
+		return e.evalCase(expr, scope)
+	}
+	return ConstValue{}, constError(expr, "unsupported expression")
+}
+
+func (e *ConstEvaluator) evalCase(expr *CaseExpr, scope *constScope) (ConstValue, error) {
+	var subject ConstValue
+	if expr.Expr != nil {
+		value, err := e.eval(expr.Expr, scope)
+		if err != nil && e.choices != nil {
+			return e.chooseCase(expr, 0, scope)
+		}
+		if err != nil {
+			return ConstValue{}, err
+		}
+		subject = value
+	}
+	for i, when := range expr.Whens {
+		cond, err := e.eval(when.Cond, scope)
+		if err != nil && e.choices != nil {
+			return e.chooseCase(expr, i, scope)
+		}
+		if err != nil {
+			return ConstValue{}, err
+		}
+		matched := cond == constTrue
+		if expr.Expr != nil {
+			matched = !subject.Null && !cond.Null && subject.Value == cond.Value
+		}
+		if matched {
+			return e.eval(when.Val, scope)
+		}
+	}
+	if expr.Else == nil {
+		return ConstValue{Null: true}, nil
+	}
+	return e.eval(expr.Else, scope)
+}
+
+// chooseCase folds expr to any of its branches from the i-th on when
+// the condition of the i-th isn't constant.
+func (e *ConstEvaluator) chooseCase(expr *CaseExpr, i int, scope *constScope) (ConstValue, error) {
+	if pick := i + e.choices.choose(expr, len(expr.Whens)-i+1); pick < len(expr.Whens) {
+		return e.eval(expr.Whens[pick].Val, scope)
+	}
+	if expr.Else == nil {
+		return ConstValue{Null: true}, nil
+	}
+	return e.eval(expr.Else, scope)
+}
+
+// chooseIf folds if(cond, a, b) to a or b when cond isn't constant. It
+// returns false if cond is constant.
+func (e *ConstEvaluator) chooseIf(expr *FuncExpr, scope *constScope) (ConstValue, bool, error) {
+	cond, ok := expr.Exprs[0].(*AliasedExpr)
+	if !ok {
+		return ConstValue{}, false, nil
+	}
+	if _, err := e.eval(cond.Expr, scope); err == nil {
+		return ConstValue{}, false, nil
+	}
+	arg := expr.Exprs[1+e.choices.choose(expr, 2)]
+	branch, ok := arg.(*AliasedExpr)
+	if !ok {
+		return ConstValue{}, true, constError(expr, "unsupported argument %s", String(arg, false))
+	}
+	value, err := e.eval(branch.Expr, scope)
+	return value, true, err
+}
+
+// evalColName resolves col from the WHERE bindings or the derived tables
+// of the scope.
+func (e *ConstEvaluator) evalColName(col *ColName, scope *constScope) (ConstValue, error) {
+	if values, ok := scope.bindings[col.Name.Lowered()]; ok {
+		if len(values) == 1 {
+			return ConstValue{Value: values[0]}, nil
+		}
+		if e.choices == nil && e.firstBinding {
+			return ConstValue{Value: values[0]}, nil
+		}
+		if e.choices == nil {
+			return ConstValue{}, constError(col, "column %s is bound to %d values", col.Name.String(), len(values))
+		}
+		return ConstValue{Value: values[e.choices.choose(bindingChoice{sel: scope.sel, column: col.Name.Lowered()}, len(values))]}, nil
+	}
+	if !e.DerivedTables || scope.sel == nil {
+		return ConstValue{}, constError(col, "column %s is not bound to a constant", col.Name.String())
+	}
+	var reasons []string
+	for _, tableExpr := range scope.sel.From {
+		value, found, err := e.evalTableColumn(tableExpr, col, scope)
+		if err != nil {
+			return ConstValue{}, err
+		}
+		if found {
+			e.usedDerivedTable = true
+			return value, nil
+		}
+		reasons = append(reasons, String(tableExpr, false))
+	}
+	return ConstValue{}, constError(col, "column %s is not selected as a constant by %s", col.Name.String(), strings.Join(reasons, ", "))
+}
+
+// evalTableColumn folds the column col of tableExpr. It returns false if
+// tableExpr doesn't provide col.
+func (e *ConstEvaluator) evalTableColumn(tableExpr TableExpr, col *ColName, scope *constScope) (ConstValue, bool, error) {
+	switch tableExpr := tableExpr.(type) {
+	case *AliasedTableExpr:
+		if !col.Qualifier.IsEmpty() && !tableMatches(tableExpr, col.Qualifier) {
+			return ConstValue{}, false, nil
+		}
+		var stmt SelectStatement
+		column := col.Name.String()
+		switch source := tableExpr.Expr.(type) {
+		case *Subquery:
+			stmt = source.Select
+		case TableName:
+			cte, ok := scope.ctes[strings.ToLower(source.Name.String())]
+			if !ok || !source.Qualifier.IsEmpty() || cte.Subquery == nil {
+				return ConstValue{}, false, nil
+			}
+			stmt = cte.Subquery.Select
+			if len(cte.Columns) != 0 {
+				column = ""
+				for i, name := range cte.Columns {
+					if name.Equal(col.Name) {
+						column = derivedColumnName(i)
+						stmt = positionalColumns(stmt)
+						break
+					}
+				}
+				if column == "" {
+					return ConstValue{}, false, nil
+				}
+			}
+		default:
+			return ConstValue{}, false, nil
+		}
+		if !selectsColumn(stmt, column) {
+			return ConstValue{}, false, nil
+		}
+		value, err := e.evalColumn(stmt, column, scope.ctes, scope.depth+1)
+		return value, err == nil, err
+	case *ParenTableExpr:
+		for _, expr := range tableExpr.Exprs {
+			if value, found, err := e.evalTableColumn(expr, col, scope); found || err != nil {
+				return value, found, err
+			}
+		}
+	case *JoinTableExpr:
+		for _, expr := range []TableExpr{tableExpr.LeftExpr, tableExpr.RightExpr} {
+			if value, found, err := e.evalTableColumn(expr, col, scope); found || err != nil {
+				return value, found, err
+			}
+		}
+	}
+	return ConstValue{}, false, nil
+}
+
+// evalColumn folds the expression that stmt selects as column.
+func (e *ConstEvaluator) evalColumn(stmt SelectStatement, column string, ctes map[string]*CommonTableExpr, depth int) (ConstValue, error) {
+	if depth > maxConstDepth {
+		return ConstValue{}, constError(stmt, "derived tables are nested too deeply")
+	}
+	switch stmt := stmt.(type) {
+	case *Select:
+		for _, expr := range stmt.SelectExprs {
+			aliased, ok := expr.(*AliasedExpr)
+			if ok && strings.EqualFold(aliasOrColumnName(aliased), column) {
+				return e.eval(aliased.Expr, e.newScope(stmt, ctes, depth))
+			}
+		}
+		return ConstValue{}, constError(stmt, "column %s is not selected", column)
+	case *Union:
+		if e.choices != nil {
+			if e.choices.choose(stmt, 2) == 0 {
+				return e.evalColumn(stmt.Left, column, ctes, depth)
+			}
+			return e.evalColumn(stmt.Right, column, ctes, depth)
+		}
+		left, err := e.evalColumn(stmt.Left, column, ctes, depth)
+		if err != nil {
+			return ConstValue{}, err
+		}
+		right, err := e.evalColumn(stmt.Right, column, ctes, depth)
+		if err != nil {
+			return ConstValue{}, err
+		}
+		if left != right {
+			return ConstValue{}, constError(stmt, "the branches select %s and %s as %s", left.Value, right.Value, column)
+		}
+		return left, nil
+	case *ParenSelect:
+		return e.evalColumn(stmt.Select, column, ctes, depth)
+	case *With:
+		return e.evalColumn(stmt.Stmt, column, scopeCTEs(ctes, stmt.CTEs), depth)
+	}
+	return ConstValue{}, constError(stmt, "unsupported statement")
+}
+
+// selectsColumn reports whether stmt selects column by name.
+func selectsColumn(stmt SelectStatement, column string) bool {
+	switch stmt := stmt.(type) {
+	case *Select:
+		for _, expr := range stmt.SelectExprs {
+			if aliased, ok := expr.(*AliasedExpr); ok && strings.EqualFold(aliasOrColumnName(aliased), column) {
+				return true
+			}
+		}
+	case *Union:
+		return selectsColumn(stmt.Left, column)
+	case *ParenSelect:
+		return selectsColumn(stmt.Select, column)
+	case *With:
+		return selectsColumn(stmt.Stmt, column)
+	}
+	return false
+}
+
+// positionalColumns returns a copy of the selects of stmt whose
+// columns are named by position, _c0, _c1 and so on, for common table
+// expressions that rename their columns.
+func positionalColumns(stmt SelectStatement) SelectStatement {
+	switch stmt := stmt.(type) {
+	case *Select:
+		renamed := *stmt
+		renamed.SelectExprs = make(SelectExprs, len(stmt.SelectExprs))
+		for i, expr := range stmt.SelectExprs {
+			if aliased, ok := expr.(*AliasedExpr); ok {
+				expr = &AliasedExpr{Expr: aliased.Expr, As: NewColIdent(derivedColumnName(i))}
+			}
+			renamed.SelectExprs[i] = expr
+		}
+		return &renamed
+	case *Union:
+		return &Union{Type: stmt.Type, Left: positionalColumns(stmt.Left), Right: positionalColumns(stmt.Right)}
+	case *ParenSelect:
+		return &ParenSelect{Select: positionalColumns(stmt.Select)}
+	case *With:
+		return &With{CTEs: stmt.CTEs, Stmt: positionalColumns(stmt.Stmt)}
+	}
+	return stmt
+}
+
+// isBoolExpr reports whether expr is a condition rather than a value.
+// OR is a condition if one of its operands is, and a || concatenation
+// otherwise.
+func isBoolExpr(expr Expr) bool {
+	switch expr := expr.(type) {
+	case *OrExpr:
+		return isBoolExpr(expr.Left) || isBoolExpr(expr.Right)
+	case *ParenExpr:
+		return isBoolExpr(expr.Expr)
+	case *AndExpr, *NotExpr, *ComparisonExpr, *RangeCond, *IsExpr, *ExistsExpr, BoolVal:
+		return true
+	}
+	return false
+}
+
+func constBool(b bool) ConstValue {
+	if b {
+		return constTrue
+	}
+	return constFalse
+}
+
+func constConcat(args []ConstValue) (ConstValue, error) {
+	var builder strings.Builder
+	for _, arg := range args {
+		if arg.Null {
+			return ConstValue{Null: true}, nil
+		}
+		builder.WriteString(arg.Value)
+	}
+	return ConstValue{Value: builder.String()}, nil
+}
+
+func constConcatWS(args []ConstValue) (ConstValue, error) {
+	if len(args) == 0 {
+		return ConstValue{}, fmt.Errorf("concat_ws needs a separator")
+	}
+	if args[0].Null {
+		return ConstValue{Null: true}, nil
+	}
+	var parts []string
+	for _, arg := range args[1:] {
+		if !arg.Null {
+			parts = append(parts, arg.Value)
+		}
+	}
+	return ConstValue{Value: strings.Join(parts, args[0].Value)}, nil
+}
+
+func constStringFunc(fn func(string) string) ConstFunc {
+	return func(args []ConstValue) (ConstValue, error) {
+		if len(args) != 1 {
+			return ConstValue{}, fmt.Errorf("expected 1 argument, got %d", len(args))
+		}
+		if args[0].Null {
+			return args[0], nil
+		}
+		return ConstValue{Value: fn(args[0].Value)}, nil
+	}
+}
+
+func constIf(args []ConstValue) (ConstValue, error) {
+	if len(args) != 3 {
+		return ConstValue{}, fmt.Errorf("expected 3 arguments, got %d", len(args))
+	}
+	if args[0] == constTrue {
+		return args[1], nil
+	}
+	return args[2], nil
+}
+
+func constCoalesce(args []ConstValue) (ConstValue, error) {
+	for _, arg := range args {
+		if !arg.Null {
+			return arg, nil
+		}
+	}
+	return ConstValue{Null: true}, nil
+}
+
+// constChoices enumerates the values of an expression depth first. Each
+// pass of the evaluator picks one option at every choice point it meets:
+// a column bound to several values, a CASE or if whose condition isn't
+// constant, or a UNION. next then moves to the following combination.
+type constChoices struct {
+	trail []constChoice
+	pos   int
+	// picked holds the options picked by the current pass, so that a
+	// choice point met twice picks the same option.
+	picked map[interface{}]int
+}
+
+type constChoice struct {
+	pick, count int
+}
+
+// bindingChoice is the choice point of a column bound to several values
+// by the WHERE clause of sel.
+type bindingChoice struct {
+	sel    *Select
+	column string
+}
+
+func newConstChoices() *constChoices {
+	return &constChoices{picked: make(map[interface{}]int)}
+}
+
+// choose returns the option picked at the choice point key out of count.
+func (c *constChoices) choose(key interface{}, count int) int {
+	if pick, ok := c.picked[key]; ok {
+		return pick
+	}
+	if c.pos == len(c.trail) {
+		c.trail = append(c.trail, constChoice{count: count})
+	}
+	pick := c.trail[c.pos].pick
+	c.pos++
+	c.picked[key] = pick
+	return pick
+}
+
+// next moves to the next combination of options. It returns false once
+// every combination was passed.
+func (c *constChoices) next() bool {
+	for len(c.trail) != 0 {
+		last := &c.trail[len(c.trail)-1]
+		if last.pick+1 < last.count {
+			last.pick++
+			c.pos = 0
+			c.picked = make(map[interface{}]int)
+			return true
+		}
+		c.trail = c.trail[:len(c.trail)-1]
+	}
+	return false
+}
@@ -0,0 +1,182 @@
+package sqlparser
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestConstEvaluator(t *testing.T) {
+	testcases := []struct {
+		sql    string
+		column string
+		out    string
+		err    string
+	}{{
+		sql:    "select concat_ws('_', lower('SHOP'), upper(kind), null) as edge_type from t where kind = 'sim'",
+		column: "edge_type",
+		out:    "shop_SIM",
+	}, {
+		sql:    "select a || '_' || b as edge_type from t where a = 'shop' and b in ('group', 'group')",
+		column: "edge_type",
+		out:    "shop_group",
+	}, {
+		sql:    "select a || '_' || b as edge_type from t where a = 'shop' and b in ('group', 'x')",
+		column: "edge_type",
+		err:    "column b is bound to 2 values",
+	}, {
+		sql:    "select if(kind = 'shop', 'shop_sim', 'author_sim') as edge_type from t where kind = 'shop'",
+		column: "edge_type",
+		out:    "shop_sim",
+	}, {
+		sql:    "select coalesce(null, cast(kind as string), 'x') as edge_type from (select 'shop' as kind from t) a",
+		column: "edge_type",
+		out:    "shop",
+	}, {
+		sql:    "select case kind when 'a' then 'x' when 'shop' then 'y' end as edge_type from t where 'shop' = kind",
+		column: "edge_type",
+		out:    "y",
+	}, {
+		sql: "with kinds as (select 'shop' as kind from t), renamed (k) as (select 'author' from t) " +
+			"select concat(k.kind, '_', r.k) as edge_type from kinds k join renamed r on 1 = 1",
+		column: "edge_type",
+		out:    "shop_author",
+	}, {
+		sql:    "select kind as edge_type from (select 'a' as kind from t union all select 'a' as kind from u) x",
+		column: "edge_type",
+		out:    "a",
+	}, {
+		sql:    "select kind as edge_type from (select 'a' as kind from t union all select 'b' as kind from u) x",
+		column: "edge_type",
+		err:    "the branches select a and b as kind",
+	}, {
+		sql:    "select concat(kind, '_x') as edge_type from t",
+		column: "edge_type",
+		err:    "column kind is not selected as a constant by t",
+	}, {
+		sql:    "select md5(kind) as edge_type from t where kind = 'a'",
+		column: "edge_type",
+		err:    "md5(kind) is not constant: function md5 is not known",
+	}}
+	evaluator := NewConstEvaluator()
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.sql)
+		if err != nil {
+			t.Errorf("Parse(%s) err: %v", tcase.sql, err)
+			continue
+		}
+		value, err := evaluator.EvalColumn(stmt.(SelectStatement), tcase.column)
+		if tcase.err != "" {
+			var constErr *ConstError
+			if !errors.As(err, &constErr) || !strings.Contains(err.Error(), tcase.err) {
+				t.Errorf("EvalColumn(%s) err: %v, want %s", tcase.sql, err, tcase.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("EvalColumn(%s) err: %v", tcase.sql, err)
+			continue
+		}
+		if value.Value != tcase.out {
+			t.Errorf("EvalColumn(%s): %s, want %s", tcase.sql, value.Value, tcase.out)
+		}
+	}
+}
+
+func TestConstEvaluatorRegister(t *testing.T) {
+	evaluator := NewConstEvaluator()
+	evaluator.Register("REPEAT", func(args []ConstValue) (ConstValue, error) {
+		if len(args) != 2 {
+			return ConstValue{}, fmt.Errorf("expected 2 arguments")
+		}
+		return ConstValue{Value: strings.Repeat(args[0].Value, len(args[1].Value))}, nil
+	})
+	stmt, err := Parse("select repeat(kind, 'xx') from t")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expr := stmt.(*Select).SelectExprs[0].(*AliasedExpr).Expr
+	value, err := evaluator.Eval(expr, map[string]string{"KIND": "ab"})
+	if err != nil || value.Value != "abab" {
+		t.Errorf("Eval: %v, %v, want abab", value, err)
+	}
+}
+
+func TestRewriteLabelReasons(t *testing.T) {
+	sql := `with e as (select concat_ws('_', 'shop', 'sim') as edge_type from t)
+select src as point1_id, tgt as point2_id, 'shop' as point1_type, 'sim' as point2_type, edge_type from e`
+	rewritten, err := RewriteSqls(sql)
+	if err != nil {
+		t.Fatalf("RewriteSqls err: %v", err)
+	}
+	if _, ok := rewritten["shop_sim"]; !ok {
+		t.Errorf("RewriteSqls: no shop_sim label in %v", rewritten)
+	}
+
+	sql = "select src as point1_id, tgt as point2_id, 'shop' as point1_type, 'sim' as point2_type, kind as edge_type from t"
+	_, err = RewriteSqls(sql)
+	var constErr *ConstError
+	if !errors.As(err, &constErr) || constErr.Reason != "column kind is not selected as a constant by t" {
+		t.Errorf("RewriteSqls(%s) err: %v, want a ConstError", sql, err)
+	}
+
+	// Without enumeration, a label bound to several values takes the
+	// first of them.
+	sql = "select src as point1_id, tgt as point2_id, 'shop' as point1_type, 'sim' as point2_type, " +
+		"concat_ws('_', 'x', k) as edge_type from t where k in ('p', 'q')"
+	rewritten, err = RewriteSqls(sql)
+	if err != nil {
+		t.Fatalf("RewriteSqls err: %v", err)
+	}
+	if _, ok := rewritten["x_p"]; !ok || len(rewritten) != 1 {
+		t.Errorf("RewriteSqls: %v, want x_p", rewritten)
+	}
+
+	schema := DefaultGraphSchema()
+	schema.Mappings[0].Label.Enumerate = true
+	rewritten, err = RewriteSqls(sql, WithGraphSchema(schema))
+	if err != nil {
+		t.Fatalf("RewriteSqls enumerated err: %v", err)
+	}
+	if _, ok := rewritten["x_p"]; !ok || len(rewritten) != 2 {
+		t.Errorf("RewriteSqls enumerated: %v, want x_p and x_q", rewritten)
+	}
+}
+
+func TestRewriteConstFunctions(t *testing.T) {
+	functions := map[string]ConstFunc{
+		"Edge_Name": func(args []ConstValue) (ConstValue, error) {
+			if len(args) != 1 {
+				return ConstValue{}, fmt.Errorf("expected 1 argument")
+			}
+			return ConstValue{Value: args[0].Value + "_edge"}, nil
+		},
+	}
+	sql := "select src as point1_id, tgt as point2_id, 'shop' as point1_type, 'sim' as point2_type, " +
+		"edge_name(k) as edge_type from t where k = 'shop'"
+	_, err := RewriteSqls(sql)
+	var constErr *ConstError
+	if !errors.As(err, &constErr) || constErr.Reason != "function edge_name is not known" {
+		t.Errorf("RewriteSqls(%s) err: %v, want an unknown function", sql, err)
+	}
+	rewritten, err := RewriteSqls(sql, WithConstFunctions(functions))
+	if err != nil {
+		t.Fatalf("RewriteSqls(%s) err: %v", sql, err)
+	}
+	if _, ok := rewritten["shop_edge"]; !ok || len(rewritten) != 1 {
+		t.Errorf("RewriteSqls(%s): %v, want shop_edge", sql, rewritten)
+	}
+
+	schema := DefaultGraphSchema()
+	schema.Mappings[0].Label.Enumerate = true
+	sql = "select src as point1_id, tgt as point2_id, 'shop' as point1_type, 'sim' as point2_type, " +
+		"edge_name(k) as edge_type from t where k in ('p', 'q')"
+	rewritten, err = RewriteSqls(sql, WithGraphSchema(schema), WithConstFunctions(functions))
+	if err != nil {
+		t.Fatalf("RewriteSqls(%s) err: %v", sql, err)
+	}
+	if _, ok := rewritten["q_edge"]; !ok || len(rewritten) != 2 {
+		t.Errorf("RewriteSqls(%s): %v, want p_edge and q_edge", sql, rewritten)
+	}
+}
@@ -0,0 +1,155 @@
+package sqlparser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DedupStrategy selects which of the rows sharing the dedup keys of a
+// label is kept.
+type DedupStrategy string
+
+// DedupStrategy values.
+const (
+	// DedupAny keeps an arbitrary row:
+	// row_number() over (partition by keys order by 1) = 1.
+	DedupAny DedupStrategy = "any"
+	// DedupLatest keeps the row with the latest OrderBy column, tsUs by
+	// default.
+	DedupLatest DedupStrategy = "latest"
+	// DedupMaxWeight keeps the row with the largest OrderBy column.
+	DedupMaxWeight DedupStrategy = "max_weight"
+	// DedupDistinct removes duplicate rows with SELECT DISTINCT.
+	DedupDistinct DedupStrategy = "distinct"
+	// DedupGroupBy groups by the dedup keys and keeps the max() of each
+	// other column.
+	DedupGroupBy DedupStrategy = "group_by"
+	// DedupNone keeps all rows.
+	DedupNone DedupStrategy = "none"
+)
+
+// defaultDedupOrderBy is the timestamp output column of the default
+// graph schema.
+const defaultDedupOrderBy = "tsUs"
+
+// DedupPolicy describes how the rows of a label are deduplicated.
+type DedupPolicy struct {
+	Strategy DedupStrategy `json:"strategy" yaml:"strategy"`
+	// OrderBy is the output column that orders the rows for DedupLatest
+	// and DedupMaxWeight. The row with the largest value is kept.
+	OrderBy string `json:"order_by,omitempty" yaml:"order_by,omitempty"`
+}
+
+// Validate checks the strategy and that it has the column it needs.
+func (policy *DedupPolicy) Validate() error {
+	switch policy.Strategy {
+	case DedupAny, DedupLatest, DedupDistinct, DedupGroupBy, DedupNone:
+	case DedupMaxWeight:
+		if policy.OrderBy == "" {
+			return fmt.Errorf("dedup policy: %s needs an order_by column", policy.Strategy)
+		}
+	default:
+		return fmt.Errorf("dedup policy: unknown strategy %q", policy.Strategy)
+	}
+	return nil
+}
+
+func (policy *DedupPolicy) orderBy() string {
+	if policy.OrderBy == "" && policy.Strategy == DedupLatest {
+		return defaultDedupOrderBy
+	}
+	return policy.OrderBy
+}
+
+// dedupPolicy returns the policy of the given label.
+func (options *RewriteOptions) dedupPolicy(label string) *DedupPolicy {
+	if policy, ok := options.LabelDedup[label]; ok {
+		return policy
+	}
+	if options.Dedup != nil {
+		return options.Dedup
+	}
+	return &DedupPolicy{Strategy: DedupAny}
+}
+
+// dedupe deduplicates the rows that sel selects from the union of a
+// label according to policy.
+func dedupe(sel *Select, keys []string, policy *DedupPolicy) error {
+	if len(keys) == 0 || policy.Strategy == DedupNone {
+		return nil
+	}
+	switch policy.Strategy {
+	case DedupLatest, DedupMaxWeight:
+		column, ok := projectedColumn(sel, policy.orderBy())
+		if !ok {
+			return newRewriteError(ErrInvalidDedup, "", "order by one of the output columns of the label",
+				"dedup order column %s is not projected", policy.orderBy())
+		}
+		applyDeduplication(sel, columnNamesToExprs(keys), OrderBy{&Order{
+			Expr:      &ColName{Name: NewColIdent(column)},
+			Direction: DescScr,
+		}})
+	case DedupDistinct:
+		sel.Distinct = DistinctStr
+	case DedupGroupBy:
+		for _, key := range keys {
+			if _, ok := projectedColumn(sel, key); !ok {
+				return newRewriteError(ErrInvalidDedup, "", "", "dedup key %s is not projected", key)
+			}
+		}
+		applyGroupByDeduplication(sel, keys)
+	default:
+		applyDeduplication(sel, columnNamesToExprs(keys), OrderBy{&Order{
+			Expr: NewIntVal([]byte("1")),
+		}})
+	}
+	return nil
+}
+
+// projectedColumn returns the name under which sel projects column.
+func projectedColumn(sel *Select, column string) (string, bool) {
+	for _, expr := range sel.SelectExprs {
+		if aliased, ok := expr.(*AliasedExpr); ok {
+			if name := aliasOrColumnName(aliased); strings.EqualFold(name, column) {
+				return name, true
+			}
+		}
+	}
+	return "", false
+}
+
+// applyGroupByDeduplication groups the rows by keys and aggregates the
+// other columns with max().
+func applyGroupByDeduplication(sel *Select, keys []string) {
+	groupBy := make(GroupBy, 0, len(keys))
+	for _, key := range keys {
+		groupBy = append(groupBy, &ColName{Name: NewColIdent(key)})
+	}
+	for _, expr := range sel.SelectExprs {
+		aliased, ok := expr.(*AliasedExpr)
+		if !ok {
+			continue
+		}
+		name := aliasOrColumnName(aliased)
+		if _, isKey := findString(keys, name); isKey {
+			continue
+		}
+		aliased.Expr = &FuncExpr{
+			Name:  NewColIdent("max"),
+			Exprs: SelectExprs{&AliasedExpr{Expr: &ColName{Name: NewColIdent(name)}}},
+		}
+		aliased.As = NewColIdent(name)
+	}
+	sel.GroupBy = groupBy
+}
+
+// findString returns the index of the first case-insensitive occurrence
+// of s in list.
+func findString(list []string, s string) (int, bool) {
+	for i, item := range list {
+		if strings.EqualFold(item, s) {
+			return i, true
+		}
+	}
+	return -1, false
+}
@@ -0,0 +1,102 @@
+package sqlparser
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDedupPolicies(t *testing.T) {
+	sql := `SELECT src AS point1_id, tgt AS point2_id, 'shop' AS point1_type, 'sim' AS point2_type,
+        'shop_sim' AS edge_type, ts AS ts_us, w AS weight
+FROM   t`
+	const inner = "select named_struct('id', cast(src as string)) as outv_pk_prop, cast(tgt as string) as bg__id, " +
+		"'shop' as outv_label, 'sim' as bg__bg__label, 'shop_sim' as label, ts as tsUs, w as weight from t"
+	testcases := []struct {
+		policy *DedupPolicy
+		out    string
+	}{{
+		policy: nil,
+		out: "select outv_pk_prop, bg__id, outv_label, bg__bg__label, label, tsUs, weight from (" +
+			"select *, row_number() over (partition by outv_pk_prop, bg__id, outv_label, bg__bg__label order by 1) as rn from (" +
+			inner + ")) where rn = 1;",
+	}, {
+		policy: &DedupPolicy{Strategy: DedupLatest},
+		out: "select outv_pk_prop, bg__id, outv_label, bg__bg__label, label, tsUs, weight from (" +
+			"select *, row_number() over (partition by outv_pk_prop, bg__id, outv_label, bg__bg__label order by tsUs desc) as rn from (" +
+			inner + ")) where rn = 1;",
+	}, {
+		policy: &DedupPolicy{Strategy: DedupMaxWeight, OrderBy: "WEIGHT"},
+		out: "select outv_pk_prop, bg__id, outv_label, bg__bg__label, label, tsUs, weight from (" +
+			"select *, row_number() over (partition by outv_pk_prop, bg__id, outv_label, bg__bg__label order by weight desc) as rn from (" +
+			inner + ")) where rn = 1;",
+	}, {
+		policy: &DedupPolicy{Strategy: DedupDistinct},
+		out:    "select distinct outv_pk_prop, bg__id, outv_label, bg__bg__label, label, tsUs, weight from (" + inner + ");",
+	}, {
+		policy: &DedupPolicy{Strategy: DedupGroupBy},
+		out: "select outv_pk_prop, bg__id, outv_label, bg__bg__label, max(label) as label, max(tsUs) as tsUs, max(weight) as weight from (" +
+			inner + ") group by outv_pk_prop, bg__id, outv_label, bg__bg__label;",
+	}, {
+		policy: &DedupPolicy{Strategy: DedupNone},
+		out:    "select outv_pk_prop, bg__id, outv_label, bg__bg__label, label, tsUs, weight from (" + inner + ");",
+	}}
+	for _, tcase := range testcases {
+		var opts []RewriteOption
+		if tcase.policy != nil {
+			opts = append(opts, WithDedupPolicy(tcase.policy))
+		}
+		opts = append(opts, WithQuoteMode(SingleQuote))
+		results, err := RewriteSqlsOrdered(sql, opts...)
+		if err != nil {
+			t.Errorf("RewriteSqlsOrdered(%+v) err: %v", tcase.policy, err)
+			continue
+		}
+		if got := results[0].Sql; got != tcase.out {
+			t.Errorf("RewriteSqlsOrdered(%+v):\n%s, want\n%s", tcase.policy, got, tcase.out)
+		}
+	}
+}
+
+func TestLabelDedupPolicy(t *testing.T) {
+	results, err := RewriteSqlsOrdered(`SELECT 'leaf' AS point_type, id AS point_id FROM t1;
+SELECT 'root' AS point_type, id AS point_id FROM t2`,
+		WithDedupPolicy(&DedupPolicy{Strategy: DedupNone}),
+		WithLabelDedupPolicy("root", &DedupPolicy{Strategy: DedupDistinct}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := results[0].Sql, `select label, id from (select "leaf" as label, cast(id as string) as id from t1);`; got != want {
+		t.Errorf("leaf: %s, want %s", got, want)
+	}
+	if got, want := results[1].Sql, `select distinct label, id from (select "root" as label, cast(id as string) as id from t2);`; got != want {
+		t.Errorf("root: %s, want %s", got, want)
+	}
+}
+
+func TestDedupPolicyErrors(t *testing.T) {
+	sql := "SELECT 'leaf' AS point_type, id AS point_id FROM t1"
+	testcases := []struct {
+		policy *DedupPolicy
+		err    string
+	}{{
+		policy: &DedupPolicy{Strategy: "newest"},
+		err:    `dedup policy: unknown strategy "newest"`,
+	}, {
+		policy: &DedupPolicy{Strategy: DedupMaxWeight},
+		err:    "dedup policy: max_weight needs an order_by column",
+	}, {
+		policy: &DedupPolicy{Strategy: DedupLatest},
+		err:    "statement 1 (line 1:1): dedup order column tsUs is not projected",
+	}}
+	for _, tcase := range testcases {
+		_, err := RewriteSqlsOrdered(sql, WithDedupPolicy(tcase.policy))
+		if err == nil || err.Error() != tcase.err {
+			t.Errorf("RewriteSqlsOrdered(%+v) err: %v, want %s", tcase.policy, err, tcase.err)
+		}
+	}
+	_, err := RewriteSqlsOrdered(sql, WithDedupPolicy(&DedupPolicy{Strategy: DedupLatest}))
+	var rewriteErr *RewriteError
+	if !errors.As(err, &rewriteErr) || rewriteErr.Code != ErrInvalidDedup {
+		t.Errorf("RewriteSqlsOrdered err: %v, want an %s RewriteError", err, ErrInvalidDedup)
+	}
+}
@@ -0,0 +1,105 @@
+package sqlparser
+
+// RewriteTrace explains how a statement was rewritten. Traces are
+// returned in RewrittenSql.Traces when RewriteOptions.Explain is set.
+type RewriteTrace struct {
+	// Statement is the index of the statement in the script.
+	Statement int `json:"statement"`
+	// Selects explains the rewrite of each select of the statement, such
+	// as the branches of a UNION.
+	Selects []*SelectTrace `json:"selects"`
+	// Reversed is set for the reverse of an edge statement.
+	Reversed bool `json:"reversed,omitempty"`
+	// Dedup and DedupKeys tell how the rows of the label are deduplicated.
+	Dedup     DedupStrategy `json:"dedup"`
+	DedupKeys []string      `json:"dedup_keys"`
+}
+
+// SelectTrace explains how a select was rewritten.
+type SelectTrace struct {
+	// Mapping is the name of the graph mapping that recognized the
+	// select, e.g. point or edge.
+	Mapping     string        `json:"mapping"`
+	Label       string        `json:"label"`
+	LabelExpr   string        `json:"label_expr"`
+	LabelSource LabelSource   `json:"label_source"`
+	Columns     []ColumnTrace `json:"columns"`
+	Casts       []CastTrace   `json:"casts,omitempty"`
+}
+
+// LabelSource tells where the label of a select was found.
+type LabelSource string
+
+// LabelSource values.
+const (
+	// LabelFromLiteral is a label selected as a string literal.
+	LabelFromLiteral LabelSource = "literal"
+	// LabelFromDerivedTable is a label selected by a derived table or a
+	// common table expression.
+	LabelFromDerivedTable LabelSource = "derived_table"
+	// LabelFromWhere is a label column bound by the WHERE clause.
+	LabelFromWhere LabelSource = "where_binding"
+	// LabelFromEvaluation is a label computed from an expression such as
+	// concat(link_type, '_group').
+	LabelFromEvaluation LabelSource = "evaluation"
+	// LabelFromEnumeration is one of the labels a select can select,
+	// rewritten in a copy of the statement of its own.
+	LabelFromEnumeration LabelSource = "enumeration"
+)
+
+// ColumnRole tells what the graph mapping did with a selected column.
+type ColumnRole string
+
+// ColumnRole values.
+const (
+	// ColumnRequired is a required column of the mapping.
+	ColumnRequired ColumnRole = "required"
+	// ColumnMapped is an optional column of the mapping, renamed in place.
+	ColumnMapped ColumnRole = "mapped"
+	// ColumnKept is a column the mapping doesn't know, kept as it is.
+	ColumnKept ColumnRole = "kept"
+	// ColumnDropped is a deprecated column of the mapping.
+	ColumnDropped ColumnRole = "dropped"
+)
+
+// ColumnTrace tells which output column a selected column became.
+type ColumnTrace struct {
+	Input  string     `json:"input"`
+	Output string     `json:"output,omitempty"`
+	Role   ColumnRole `json:"role"`
+}
+
+// CastTrace reports a cast added to an output column.
+type CastTrace struct {
+	Column string `json:"column"`
+	Type   string `json:"type"`
+	// Reason is "graph mapping" for the string casts of the key columns
+	// and "type map" for the casts of RewriteOptions.TypeMap.
+	Reason string `json:"reason"`
+}
+
+// addColumn records what happened to the selected column input.
+func (trace *SelectTrace) addColumn(input, output string, role ColumnRole) {
+	trace.Columns = append(trace.Columns, ColumnTrace{Input: input, Output: output, Role: role})
+}
+
+// addCast records a cast of column to typ.
+func (trace *SelectTrace) addCast(column, typ, reason string) {
+	trace.Casts = append(trace.Casts, CastTrace{Column: column, Type: typ, Reason: reason})
+}
+
+// traceCast records the string cast mapExpr adds to expr, if any.
+func (col *ColumnMapping) traceCast(expr Expr, trace *SelectTrace) {
+	if col.CastString && !isStringCastExpr(expr) {
+		trace.addCast(col.Output, "string", "graph mapping")
+	}
+}
+
+// enumerated marks the trace of a copy of a statement restricted to one
+// of its labels. labelExpr is the label expression the copy replaced.
+func (trace *RewriteTrace) enumerated(labelExpr string) {
+	for _, sel := range trace.Selects {
+		sel.LabelExpr = labelExpr
+		sel.LabelSource = LabelFromEnumeration
+	}
+}
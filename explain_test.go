@@ -0,0 +1,116 @@
+package sqlparser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExplain(t *testing.T) {
+	const edge = "select src as point1_id, tgt as point2_id, 'shop' as point1_type, 'author' as point2_type, "
+	testcases := []struct {
+		in     string
+		source LabelSource
+		expr   string
+		cols   []ColumnTrace
+		casts  []CastTrace
+	}{{
+		in:     "select id as point_id, 'shop' as point_type, name, point_value from t",
+		source: LabelFromLiteral,
+		expr:   "'shop'",
+		cols: []ColumnTrace{
+			{Input: "point_value", Role: ColumnDropped},
+			{Input: "point_type", Output: "label", Role: ColumnRequired},
+			{Input: "point_id", Output: "id", Role: ColumnRequired},
+			{Input: "name", Output: "name", Role: ColumnKept},
+		},
+		casts: []CastTrace{
+			{Column: "id", Type: "string", Reason: "graph mapping"},
+			{Column: "name", Type: "string", Reason: "type map"},
+		},
+	}, {
+		in:     "select s.id as point_id, s.point_type from (select id, 'shop' as point_type from t) s",
+		source: LabelFromDerivedTable,
+		expr:   "s.point_type",
+	}, {
+		in:     "select id as point_id, case when x > 0 then 'shop' end as point_type from t",
+		source: LabelFromEnumeration,
+		expr:   "case when x > 0 then 'shop' end",
+	}, {
+		in:     edge + "edge_type from t where edge_type = 'shop'",
+		source: LabelFromWhere,
+		expr:   "edge_type",
+		cols: []ColumnTrace{
+			{Input: "point1_id", Output: "outv_pk_prop", Role: ColumnRequired},
+			{Input: "point2_id", Output: "bg__id", Role: ColumnRequired},
+			{Input: "point1_type", Output: "outv_label", Role: ColumnRequired},
+			{Input: "point2_type", Output: "bg__bg__label", Role: ColumnRequired},
+			{Input: "edge_type", Output: "label", Role: ColumnMapped},
+		},
+	}, {
+		in:     edge + "concat(k, '_author') as edge_type from t where k = 'shop'",
+		source: LabelFromEvaluation,
+		expr:   "concat(k, '_author')",
+	}}
+	typeMap := map[string]map[string]string{"shop": {"name": "string"}}
+	for _, tcase := range testcases {
+		results, err := RewriteSqlsOrdered(tcase.in, WithExplain(true), WithTypeMap(typeMap))
+		if err != nil {
+			t.Errorf("RewriteSqlsOrdered(%s) err: %v", tcase.in, err)
+			continue
+		}
+		if len(results) != 1 || len(results[0].Traces) != 1 || len(results[0].Traces[0].Selects) != 1 {
+			t.Errorf("RewriteSqlsOrdered(%s): %+v, want a single trace", tcase.in, results)
+			continue
+		}
+		trace := results[0].Traces[0].Selects[0]
+		if trace.LabelSource != tcase.source || trace.LabelExpr != tcase.expr {
+			t.Errorf("RewriteSqlsOrdered(%s) label: %s from %s, want %s from %s", tcase.in, trace.LabelExpr, trace.LabelSource, tcase.expr, tcase.source)
+		}
+		if tcase.cols != nil && !reflect.DeepEqual(trace.Columns, tcase.cols) {
+			t.Errorf("RewriteSqlsOrdered(%s) columns: %+v, want %+v", tcase.in, trace.Columns, tcase.cols)
+		}
+		if tcase.casts != nil && !reflect.DeepEqual(trace.Casts, tcase.casts) {
+			t.Errorf("RewriteSqlsOrdered(%s) casts: %+v, want %+v", tcase.in, trace.Casts, tcase.casts)
+		}
+	}
+}
+
+func TestExplainStatements(t *testing.T) {
+	sql := "select 'shop' as point_type, id as point_id from t; " +
+		"select src as point1_id, tgt as point2_id, 'shop' as point1_type, 'author' as point2_type, 'shop_author' as edge_type from t"
+	results, err := RewriteSqlsOrdered(sql, WithExplain(true), WithReverseEdge("shop_author", &ReverseEdge{Label: "author_shop"}))
+	if err != nil {
+		t.Fatalf("RewriteSqlsOrdered err: %v", err)
+	}
+	want := []struct {
+		label    string
+		mapping  string
+		reversed []bool
+		keys     []string
+	}{
+		{label: "shop", mapping: "point", reversed: []bool{false}, keys: []string{"id", "label"}},
+		{label: "shop_author", mapping: "edge", reversed: []bool{false, true}, keys: []string{"outv_pk_prop", "bg__id", "outv_label", "bg__bg__label", "label"}},
+	}
+	if len(results) != len(want) {
+		t.Fatalf("RewriteSqlsOrdered: %d labels, want %d", len(results), len(want))
+	}
+	for i, result := range results {
+		if result.Label != want[i].label || len(result.Traces) != len(want[i].reversed) {
+			t.Errorf("RewriteSqlsOrdered[%d]: %s with %d traces, want %s with %d", i, result.Label, len(result.Traces), want[i].label, len(want[i].reversed))
+			continue
+		}
+		for j, trace := range result.Traces {
+			if trace.Statement != i || trace.Reversed != want[i].reversed[j] || trace.Selects[0].Mapping != want[i].mapping {
+				t.Errorf("RewriteSqlsOrdered[%d].Traces[%d]: %+v, want statement %d, reversed %v", i, j, trace, i, want[i].reversed[j])
+			}
+			if trace.Dedup != DedupAny || !reflect.DeepEqual(trace.DedupKeys, want[i].keys) {
+				t.Errorf("RewriteSqlsOrdered[%d].Traces[%d] dedup: %s by %v, want %s by %v", i, j, trace.Dedup, trace.DedupKeys, DedupAny, want[i].keys)
+			}
+		}
+	}
+
+	results, err = RewriteSqlsOrdered(sql)
+	if err != nil || results[0].Traces != nil {
+		t.Errorf("RewriteSqlsOrdered without explain: %v, %v, want no traces", results[0].Traces, err)
+	}
+}
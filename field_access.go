@@ -0,0 +1,176 @@
+package sqlparser
+
+import "strings"
+
+// ResolveFieldAccess turns the column names of stmt whose qualifier
+// doesn't name a table in scope into struct field accesses, the way
+// Hive resolves them: t.profile.city is the field city of column
+// profile when t is an alias in scope and db.t is not, and
+// profile.city is a field access unless profile is a table in scope.
+// Parse leaves names of up to three parts as a ColName; the rewriter
+// calls ResolveFieldAccess on every statement it parses.
+func ResolveFieldAccess(stmt Statement) {
+	switch stmt := stmt.(type) {
+	case SelectStatement:
+		resolveFieldAccessInSelectStatement(stmt, nil)
+	case *Insert:
+		if sel, ok := stmt.Rows.(SelectStatement); ok {
+			resolveFieldAccessInSelectStatement(sel, nil)
+		}
+	case *DDL:
+		if stmt.Select != nil {
+			resolveFieldAccessInSelectStatement(stmt.Select, nil)
+		}
+	}
+}
+
+func resolveFieldAccessInSelectStatement(stmt SelectStatement, outer []TableName) {
+	switch stmt := stmt.(type) {
+	case *Select:
+		resolveFieldAccessInSelect(stmt, outer)
+	case *Union:
+		resolveFieldAccessInSelectStatement(stmt.Left, outer)
+		resolveFieldAccessInSelectStatement(stmt.Right, outer)
+	case *ParenSelect:
+		resolveFieldAccessInSelectStatement(stmt.Select, outer)
+	case *With:
+		for _, cte := range stmt.CTEs {
+			if cte.Subquery != nil {
+				resolveFieldAccessInSelectStatement(cte.Subquery.Select, outer)
+			}
+		}
+		resolveFieldAccessInSelectStatement(stmt.Stmt, outer)
+	}
+}
+
+func resolveFieldAccessInSelect(sel *Select, outer []TableName) {
+	scope := append(tablesInScope(sel.From, nil), outer...)
+
+	replacements := make(map[*ColName]Expr)
+	_ = Walk(func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *Subquery:
+			resolveFieldAccessInSelectStatement(node.Select, scope)
+			return false, nil
+		case *ColName:
+			if expr := fieldAccessForColName(node, scope); expr != nil {
+				replacements[node] = expr
+			}
+		}
+		return true, nil
+	}, sel)
+	if len(replacements) == 0 {
+		return
+	}
+
+	_ = Walk(func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *Subquery:
+			return false, nil
+		case *AliasedExpr:
+			replaceColNames(replacements, &node.Expr)
+		case *Where:
+			if node != nil {
+				replaceColNames(replacements, &node.Expr)
+			}
+		case *Order:
+			replaceColNames(replacements, &node.Expr)
+		case *JoinTableExpr:
+			replaceColNames(replacements, &node.Condition.On)
+		case GroupBy:
+			for i := range node {
+				replaceColNames(replacements, &node[i])
+			}
+		case Exprs:
+			for i := range node {
+				replaceColNames(replacements, &node[i])
+			}
+		}
+		return len(replacements) > 0, nil
+	}, sel)
+}
+
+// replaceColNames replaces the columns found in the expression
+// tree rooted at *expr.
+func replaceColNames(replacements map[*ColName]Expr, expr *Expr) {
+	if *expr == nil {
+		return
+	}
+	for col, to := range replacements {
+		if *expr == Expr(col) {
+			*expr = to
+		} else if !(*expr).replace(col, to) {
+			continue
+		}
+		delete(replacements, col)
+	}
+}
+
+// tablesInScope appends the names by which the columns of the
+// given table expressions can be qualified.
+func tablesInScope(exprs TableExprs, scope []TableName) []TableName {
+	for _, expr := range exprs {
+		switch expr := expr.(type) {
+		case *AliasedTableExpr:
+			if !expr.As.IsEmpty() {
+				scope = append(scope, TableName{Name: expr.As})
+			} else if name, ok := expr.Expr.(TableName); ok {
+				scope = append(scope, name)
+			}
+			for _, view := range expr.LateralViews {
+				scope = append(scope, TableName{Name: view.As})
+			}
+		case *ParenTableExpr:
+			scope = tablesInScope(expr.Exprs, scope)
+		case *JoinTableExpr:
+			scope = tablesInScope(TableExprs{expr.LeftExpr, expr.RightExpr}, scope)
+		}
+	}
+	return scope
+}
+
+// fieldAccessForColName returns the field access that col stands for,
+// or nil if col is a column of a table in scope.
+func fieldAccessForColName(col *ColName, scope []TableName) Expr {
+	qualifier := col.Qualifier
+	if qualifier.IsEmpty() || tableInScope(qualifier, scope) {
+		return nil
+	}
+	if qualifier.Qualifier.IsEmpty() {
+		// column.field
+		return &FieldAccessExpr{
+			Expr:  &ColName{Name: NewColIdent(qualifier.Name.String())},
+			Field: col.Name,
+		}
+	}
+	if tableInScope(TableName{Name: qualifier.Qualifier}, scope) {
+		// alias.column.field
+		return &FieldAccessExpr{
+			Expr: &ColName{
+				Qualifier: TableName{Name: qualifier.Qualifier},
+				Name:      NewColIdent(qualifier.Name.String()),
+			},
+			Field: col.Name,
+		}
+	}
+	// column.field.field
+	return &FieldAccessExpr{
+		Expr: &FieldAccessExpr{
+			Expr:  &ColName{Name: NewColIdent(qualifier.Qualifier.String())},
+			Field: NewColIdent(qualifier.Name.String()),
+		},
+		Field: col.Name,
+	}
+}
+
+func tableInScope(name TableName, scope []TableName) bool {
+	for _, table := range scope {
+		if !strings.EqualFold(table.Name.String(), name.Name.String()) {
+			continue
+		}
+		if name.Qualifier.IsEmpty() || strings.EqualFold(table.Qualifier.String(), name.Qualifier.String()) {
+			return true
+		}
+	}
+	return false
+}
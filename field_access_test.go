@@ -0,0 +1,116 @@
+package sqlparser
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestResolveFieldAccess(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+	}{{
+		in:  "select a.b from t as a",
+		out: "col(a.b)",
+	}, {
+		in:  "select profile.city from t",
+		out: "field(col(profile), city)",
+	}, {
+		in:  "select t.profile.city from db.t",
+		out: "field(col(t.profile), city)",
+	}, {
+		in:  "select db.t.city from db.t",
+		out: "col(db.t.city)",
+	}, {
+		in:  "select a.profile.city from db.t as a",
+		out: "field(col(a.profile), city)",
+	}, {
+		in:  "select profile.address.city from t",
+		out: "field(field(col(profile), address), city)",
+	}, {
+		in:  "select a.profile.address.city from t as a",
+		out: "field(field(col(a.profile), address), city)",
+	}, {
+		in:  "select props['weight'].value from t",
+		out: "field(bracket(col(props)), value)",
+	}, {
+		in:  "select x.nid.id from t lateral view explode(ids) x as nid",
+		out: "field(col(x.nid), id)",
+	}}
+	for _, tcase := range testcases {
+		tree, err := Parse(tcase.in)
+		if err != nil {
+			t.Errorf("Parse(%s) err: %v", tcase.in, err)
+			continue
+		}
+		ResolveFieldAccess(tree)
+		if got := String(tree, false); got != tcase.in {
+			t.Errorf("Parse(%s): %s, want unchanged", tcase.in, got)
+		}
+		expr := tree.(*Select).SelectExprs[0].(*AliasedExpr).Expr
+		if got := describeFieldAccess(expr); got != tcase.out {
+			t.Errorf("Parse(%s): %s, want %s", tcase.in, got, tcase.out)
+		}
+	}
+}
+
+func TestResolveFieldAccessCorrelated(t *testing.T) {
+	tree, err := Parse("select 1 from t where exists (select 1 from u where u.id = t.id and t.info.id = u.id and u.tags.a = 1)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ResolveFieldAccess(tree)
+	var got []string
+	_ = Walk(func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *ColName, *FieldAccessExpr:
+			got = append(got, describeFieldAccess(node.(Expr)))
+			return false, nil
+		}
+		return true, nil
+	}, tree)
+	want := "col(u.id) col(t.id) field(col(t.info), id) col(u.id) field(col(u.tags), a)"
+	if strings.Join(got, " ") != want {
+		t.Errorf("got %s, want %s", strings.Join(got, " "), want)
+	}
+}
+
+func TestParseKeepsColNames(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+	}{{
+		in:  "select profile.city from t",
+		out: "col(profile.city)",
+	}, {
+		in:  "select t.profile.city from db.t",
+		out: "col(t.profile.city)",
+	}, {
+		in:  "select a.profile.address.city from t as a",
+		out: "field(col(a.profile.address), city)",
+	}}
+	for _, tcase := range testcases {
+		tree, err := Parse(tcase.in)
+		if err != nil {
+			t.Errorf("Parse(%s) err: %v", tcase.in, err)
+			continue
+		}
+		expr := tree.(*Select).SelectExprs[0].(*AliasedExpr).Expr
+		if got := describeFieldAccess(expr); got != tcase.out {
+			t.Errorf("Parse(%s): %s, want %s", tcase.in, got, tcase.out)
+		}
+	}
+}
+
+func describeFieldAccess(expr Expr) string {
+	switch expr := expr.(type) {
+	case *ColName:
+		return fmt.Sprintf("col(%s)", String(expr, false))
+	case *FieldAccessExpr:
+		return fmt.Sprintf("field(%s, %s)", describeFieldAccess(expr.Expr), expr.Field.String())
+	case *BracketExpr:
+		return fmt.Sprintf("bracket(%s)", describeFieldAccess(expr.Expr))
+	}
+	return String(expr, false)
+}
@@ -0,0 +1,267 @@
+package sqlparser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// GraphSchema maps the columns of the queries given to RewriteSqls to
+// the columns of a graph store. Each statement is rewritten by the
+// first mapping whose required columns it selects.
+type GraphSchema struct {
+	Mappings []*GraphMapping `json:"mappings" yaml:"mappings"`
+}
+
+// GraphMapping describes how one kind of graph element, such as an
+// edge or a point, is mapped.
+type GraphMapping struct {
+	// Name names the mapping in error messages, e.g. edge or point.
+	Name string `json:"name" yaml:"name"`
+	// Columns lists the mapped input columns. Required columns are moved
+	// to the front of the output in the order they're listed. The other
+	// columns are renamed in place.
+	Columns []*ColumnMapping `json:"columns" yaml:"columns"`
+	// Deprecated lists the input columns that are dropped.
+	Deprecated []string `json:"deprecated,omitempty" yaml:"deprecated,omitempty"`
+	// Label describes how the label literal of a statement is found.
+	// Statements are grouped by label.
+	Label LabelMapping `json:"label" yaml:"label"`
+	// DedupKeys lists the output columns that identify a row.
+	DedupKeys []string `json:"dedup_keys,omitempty" yaml:"dedup_keys,omitempty"`
+	// ReversedColumns lists the pairs of input columns that are swapped
+	// to reverse an element, e.g. point1_id and point2_id. Elements can
+	// only be reversed if the mapping has some.
+	ReversedColumns [][2]string `json:"reversed_columns,omitempty" yaml:"reversed_columns,omitempty"`
+}
+
+// ColumnMapping maps an input column, by alias or column name, to an
+// output column.
+type ColumnMapping struct {
+	Input    string `json:"input" yaml:"input"`
+	Output   string `json:"output" yaml:"output"`
+	Required bool   `json:"required,omitempty" yaml:"required,omitempty"`
+	// CastString wraps the value in cast(... as string) unless it's
+	// already cast to string.
+	CastString bool `json:"cast_string,omitempty" yaml:"cast_string,omitempty"`
+	// StructField, if set, wraps the value in named_struct('field', ...).
+	StructField string `json:"struct_field,omitempty" yaml:"struct_field,omitempty"`
+}
+
+// LabelMapping describes where the label literal is taken from.
+type LabelMapping struct {
+	// Column is the input column that selects the label literal.
+	Column string `json:"column" yaml:"column"`
+	// FromSubquery also looks for Column in the derived tables of the
+	// statement, e.g. 'shop' AS edge_type selected by FROM (...) a.
+	FromSubquery bool `json:"from_subquery,omitempty" yaml:"from_subquery,omitempty"`
+	// FromWhere evaluates the expression of Column with the string
+	// equalities of the WHERE clause, e.g. concat(link_type, '_group')
+	// with link_type = 'shop'.
+	FromWhere bool `json:"from_where,omitempty" yaml:"from_where,omitempty"`
+	// Enumerate rewrites statements whose label isn't a single literal
+	// once per label they can select, e.g. the branches of a CASE or the
+	// values of an IN list, each copy filtered on its label. Without it,
+	// a column that IN binds to several values takes the first of them.
+	Enumerate bool `json:"enumerate,omitempty" yaml:"enumerate,omitempty"`
+}
+
+// DefaultGraphSchema returns the schema RewriteSqls uses when none is
+// given. Edges select point1_id, point2_id, point1_type, point2_type and
+// edge_type; points select point_id and point_type.
+func DefaultGraphSchema() *GraphSchema {
+	return &GraphSchema{
+		Mappings: []*GraphMapping{{
+			Name: "edge",
+			Columns: []*ColumnMapping{
+				{Input: "point1_id", Output: "outv_pk_prop", Required: true, CastString: true, StructField: "id"},
+				{Input: "point2_id", Output: "bg__id", Required: true, CastString: true},
+				{Input: "point1_type", Output: "outv_label", Required: true},
+				{Input: "point2_type", Output: "bg__bg__label", Required: true},
+				{Input: "edge_type", Output: "label"},
+				{Input: "ts_us", Output: "tsUs"},
+			},
+			Deprecated: []string{"value"},
+			Label: LabelMapping{
+				Column:       "edge_type",
+				FromSubquery: true,
+				FromWhere:    true,
+			},
+			DedupKeys: []string{"outv_pk_prop", "bg__id", "outv_label", "bg__bg__label"},
+			ReversedColumns: [][2]string{
+				{"point1_id", "point2_id"},
+				{"point1_type", "point2_type"},
+			},
+		}, {
+			Name: "point",
+			Columns: []*ColumnMapping{
+				{Input: "point_type", Output: "label", Required: true},
+				{Input: "point_id", Output: "id", Required: true, CastString: true},
+			},
+			Deprecated: []string{"point_value"},
+			Label: LabelMapping{
+				Column:       "point_type",
+				FromSubquery: true,
+				Enumerate:    true,
+			},
+			DedupKeys: []string{"id", "label"},
+		}},
+	}
+}
+
+// LoadGraphSchema parses a GraphSchema from JSON or YAML. Unknown
+// fields are rejected so that misspelled settings don't go unnoticed.
+func LoadGraphSchema(data []byte) (*GraphSchema, error) {
+	schema := &GraphSchema{}
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		decoder := json.NewDecoder(bytes.NewReader(trimmed))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(schema); err != nil {
+			return nil, fmt.Errorf("graph schema: %w", err)
+		}
+	} else {
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(schema); err != nil {
+			return nil, fmt.Errorf("graph schema: %w", err)
+		}
+	}
+	if err := schema.Validate(); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// Validate checks that every mapping is usable: it must have a name,
+// at least one required column, a label column, and no input or output
+// column mapped twice.
+func (schema *GraphSchema) Validate() error {
+	if len(schema.Mappings) == 0 {
+		return fmt.Errorf("graph schema: no mappings")
+	}
+	for i, mapping := range schema.Mappings {
+		if mapping == nil || mapping.Name == "" {
+			return fmt.Errorf("graph schema: mapping %d has no name", i)
+		}
+		if err := mapping.validate(); err != nil {
+			return fmt.Errorf("graph schema: mapping %s: %w", mapping.Name, err)
+		}
+	}
+	return nil
+}
+
+func (mapping *GraphMapping) validate() error {
+	inputs := make(map[string]bool)
+	outputs := make(map[string]bool)
+	required := 0
+	for _, col := range mapping.Columns {
+		if col == nil || col.Input == "" || col.Output == "" {
+			return fmt.Errorf("column mappings need an input and an output")
+		}
+		input := strings.ToLower(col.Input)
+		if inputs[input] {
+			return fmt.Errorf("input column %s is mapped twice", col.Input)
+		}
+		inputs[input] = true
+		output := strings.ToLower(col.Output)
+		if outputs[output] {
+			return fmt.Errorf("output column %s is mapped twice", col.Output)
+		}
+		outputs[output] = true
+		if col.Required {
+			required++
+		}
+	}
+	if required == 0 {
+		return fmt.Errorf("no required columns")
+	}
+	for _, name := range mapping.Deprecated {
+		if inputs[strings.ToLower(name)] {
+			return fmt.Errorf("deprecated column %s is also mapped", name)
+		}
+	}
+	if mapping.Label.Column == "" {
+		return fmt.Errorf("no label column")
+	}
+	for _, pair := range mapping.ReversedColumns {
+		if pair[0] == "" || pair[1] == "" || strings.EqualFold(pair[0], pair[1]) {
+			return fmt.Errorf("reversed columns need two different columns")
+		}
+	}
+	return nil
+}
+
+// columnIndex returns the index of the mapping of the given input
+// column, or -1.
+func (mapping *GraphMapping) columnIndex(input string) int {
+	for i, col := range mapping.Columns {
+		if strings.EqualFold(col.Input, input) {
+			return i
+		}
+	}
+	return -1
+}
+
+// reversedColumn returns the column that input is swapped with to
+// reverse an element.
+func (mapping *GraphMapping) reversedColumn(input string) (string, bool) {
+	for _, pair := range mapping.ReversedColumns {
+		if strings.EqualFold(pair[0], input) {
+			return pair[1], true
+		}
+		if strings.EqualFold(pair[1], input) {
+			return pair[0], true
+		}
+	}
+	return "", false
+}
+
+// labelOutput returns the output column of the label.
+func (mapping *GraphMapping) labelOutput() string {
+	if i := mapping.columnIndex(mapping.Label.Column); i >= 0 {
+		return mapping.Columns[i].Output
+	}
+	return mapping.Label.Column
+}
+
+// keyColumns returns the lowercased output columns that identify a row
+// of the mapping: the label and the required and cast-to-string columns.
+// They stay strings whatever the TypeMap says.
+func (mapping *GraphMapping) keyColumns() map[string]bool {
+	keys := map[string]bool{strings.ToLower(mapping.labelOutput()): true}
+	for _, col := range mapping.Columns {
+		if col.Required || col.CastString {
+			keys[strings.ToLower(col.Output)] = true
+		}
+	}
+	return keys
+}
+
+func (mapping *GraphMapping) isDeprecated(input string) bool {
+	for _, name := range mapping.Deprecated {
+		if strings.EqualFold(name, input) {
+			return true
+		}
+	}
+	return false
+}
+
+// mapExpr returns the output expression of the column.
+func (col *ColumnMapping) mapExpr(expr Expr) Expr {
+	if col.CastString {
+		expr = ensureStringCast(expr)
+	}
+	if col.StructField != "" {
+		expr = &FuncExpr{
+			Name: NewColIdent("named_struct"),
+			Exprs: SelectExprs{
+				&AliasedExpr{Expr: NewStrVal([]byte(col.StructField))},
+				&AliasedExpr{Expr: expr},
+			},
+		}
+	}
+	return expr
+}
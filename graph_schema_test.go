@@ -0,0 +1,123 @@
+package sqlparser
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestLoadGraphSchemaDefault(t *testing.T) {
+	data, err := json.Marshal(DefaultGraphSchema())
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema, err := LoadGraphSchema(data)
+	if err != nil {
+		t.Fatalf("LoadGraphSchema(%s) err: %v", data, err)
+	}
+	if !reflect.DeepEqual(schema, DefaultGraphSchema()) {
+		t.Errorf("LoadGraphSchema(%s): %+v, want the default schema", data, schema)
+	}
+}
+
+func TestLoadGraphSchemaErrors(t *testing.T) {
+	testcases := []struct {
+		in  string
+		err string
+	}{{
+		in:  `{"mappings": []}`,
+		err: "graph schema: no mappings",
+	}, {
+		in:  `{"mappings": [{"name": "edge", "colums": []}]}`,
+		err: `graph schema: json: unknown field "colums"`,
+	}, {
+		in: `
+mappings:
+- name: edge
+  columns:
+  - {input: src, output: src_id}
+  label: {column: edge_type}`,
+		err: "graph schema: mapping edge: no required columns",
+	}, {
+		in: `
+mappings:
+- name: edge
+  columns:
+  - {input: src, output: id, required: true}
+  - {input: dst, output: id, required: true}
+  label: {column: edge_type}`,
+		err: "graph schema: mapping edge: output column id is mapped twice",
+	}, {
+		in: `
+mappings:
+- name: edge
+  columns:
+  - {input: src, output: src_id, required: true}
+  deprecated: [src]
+  label: {column: edge_type}`,
+		err: "graph schema: mapping edge: deprecated column src is also mapped",
+	}, {
+		in: `
+mappings:
+- name: edge
+  columns:
+  - {input: src, output: src_id, required: true}`,
+		err: "graph schema: mapping edge: no label column",
+	}}
+	for _, tcase := range testcases {
+		_, err := LoadGraphSchema([]byte(tcase.in))
+		if err == nil || err.Error() != tcase.err {
+			t.Errorf("LoadGraphSchema(%s) err: %v, want %s", tcase.in, err, tcase.err)
+		}
+	}
+}
+
+func TestRewriteSqlsGraphSchema(t *testing.T) {
+	schema, err := LoadGraphSchema([]byte(`
+mappings:
+- name: edge
+  columns:
+  - {input: src, output: src_id, required: true, cast_string: true}
+  - {input: dst, output: dst_id, required: true, cast_string: true}
+  - {input: relation, output: rel}
+  deprecated: [weight_v1]
+  label:
+    column: relation
+    from_where: true
+  dedup_keys: [src_id, dst_id]
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rewritten, err := RewriteSqls(`SELECT  concat(kind, '_follow') AS relation,
+        0.5 AS weight_v1,
+        score,
+        b AS dst,
+        a AS src
+FROM    db.follow
+WHERE   kind = 'user'`, WithGraphSchema(schema), WithTypeMap(map[string]map[string]string{
+		"user_follow": {"score": "double"},
+	}))
+	if err != nil {
+		t.Fatalf("RewriteSqls error: %v", err)
+	}
+	def, ok := rewritten["user_follow"]
+	if !ok {
+		t.Fatalf("expected rewritten sql for user_follow, got %v", rewritten)
+	}
+	want := "select src_id, dst_id, rel, score from (" +
+		"select *, row_number() over (partition by src_id, dst_id order by 1) as rn from (" +
+		"select cast(a as string) as src_id, cast(b as string) as dst_id, concat(kind, \"_follow\") as rel, cast(score as double) " +
+		"from db.follow where kind = \"user\")) where rn = 1;"
+	if def.Sql != want {
+		t.Errorf("RewriteSqls:\n%s, want\n%s", def.Sql, want)
+	}
+
+	_, err = RewriteSqls("select a as src, 'x' as relation from t", WithGraphSchema(schema))
+	if rewriteErr, ok := err.(*RewriteError); !ok || rewriteErr.Message != "missing required edge columns: dst" {
+		t.Errorf("RewriteSqls err: %v, want missing required edge columns: dst", err)
+	}
+	if _, err := RewriteSqls("select point_id, 'x' as point_type from t", WithGraphSchema(schema)); err == nil {
+		t.Errorf("expected points not to be recognized by a schema without a point mapping")
+	}
+}
@@ -0,0 +1,186 @@
+package sqlparser
+
+import (
+	"errors"
+	"strings"
+)
+
+// multiLabelError is returned by rewriteMappedSql when the label of a
+// statement isn't a single literal but can be enumerated. The statement
+// is then rewritten once per label by rewriteStatements.
+type multiLabelError struct {
+	mapping *GraphMapping
+	labels  []string
+	// labelExpr is the label expression as it was written.
+	labelExpr string
+}
+
+func (e *multiLabelError) Error() string {
+	return "statement selects labels " + strings.Join(e.labels, ", ")
+}
+
+// rewriteStatementLabels rewrites stmt, parsed from text, and returns its
+// label. If its label can be enumerated, a copy of stmt restricted to
+// each label is rewritten instead.
+func rewriteStatementLabels(stmt Statement, text string, options *RewriteOptions) ([]*rewriteResult, []string, error) {
+	result, key, err := rewriteStatement(stmt, options)
+	var split *multiLabelError
+	if !errors.As(err, &split) {
+		if err != nil {
+			return nil, nil, err
+		}
+		return []*rewriteResult{result}, []string{key}, nil
+	}
+
+	var (
+		results []*rewriteResult
+		keys    []string
+	)
+	for i, label := range split.labels {
+		stmt, err := Parse(text)
+		if err != nil {
+			return nil, nil, err
+		}
+		ResolveFieldAccess(stmt)
+		if !pinLabel(stmt, split.mapping, label) {
+			return nil, nil, newRewriteError(ErrMissingLabel, split.mapping.Label.Column,
+				"statements selecting several labels must be a single select that selects "+split.mapping.Label.Column,
+				"%s sql selects labels %s but can't be split by label", split.mapping.Name, strings.Join(split.labels, ", "))
+		}
+		result, key, err := rewriteStatement(stmt, options)
+		if err != nil {
+			return nil, nil, err
+		}
+		result.label = label
+		result.trace.enumerated(split.labelExpr)
+		if i > 0 {
+			// The substitutions are reported for the first label only.
+			result.substitutions = nil
+		}
+		results = append(results, result)
+		keys = append(keys, key)
+	}
+	return results, keys, nil
+}
+
+// maxEnumeratedLabels bounds the combinations enumerateLabels folds.
+const maxEnumeratedLabels = 1024
+
+// enumerateLabels returns every label that expr, the label expression
+// selected by sel, folds to: one per combination of the values that the
+// WHERE clauses bind a column to with IN, of the branches of CASE and if
+// whose conditions aren't constant, and of the branches of UNIONs in
+// derived tables. It returns why if one of the combinations doesn't fold
+// to a constant. The WHERE clauses and derived tables are only looked
+// into as labelMapping allows.
+func enumerateLabels(sel *Select, expr Expr, labelMapping LabelMapping, functions map[string]ConstFunc, ctes map[string]*CommonTableExpr) ([]string, error) {
+	evaluator := labelEvaluator(labelMapping, functions)
+	evaluator.choices = newConstChoices()
+	var labels []string
+	seen := make(map[string]bool)
+	for i := 0; ; i++ {
+		if i == maxEnumeratedLabels {
+			return nil, constError(expr, "it takes more than %d combinations of values", maxEnumeratedLabels)
+		}
+		value, err := evaluator.evalIn(expr, sel, ctes)
+		if err != nil {
+			return nil, err
+		}
+		// Rows without a label are filtered out.
+		if !value.Null {
+			if value.Value == "" {
+				return nil, constError(expr, "the label is empty")
+			}
+			if !seen[value.Value] {
+				seen[value.Value] = true
+				labels = append(labels, value.Value)
+			}
+		}
+		if !evaluator.choices.next() {
+			return labels, nil
+		}
+	}
+}
+
+// pinLabel restricts stmt to the rows of label: the label expression of
+// mapping is replaced by the label literal and the select is filtered
+// on the expression being label. It returns false if stmt isn't a single
+// select that selects the label column.
+func pinLabel(stmt Statement, mapping *GraphMapping, label string) bool {
+	selectStmt, ok := rewriteSource(stmt)
+	if !ok {
+		return false
+	}
+	branches := selectBranches(selectStmt)
+	if len(branches) != 1 {
+		return false
+	}
+	sel := branches[0]
+	for _, expr := range sel.SelectExprs {
+		aliased, ok := expr.(*AliasedExpr)
+		if !ok || !strings.EqualFold(aliasOrColumnName(aliased), mapping.Label.Column) {
+			continue
+		}
+		filter := concatExpr(aliased.Expr)
+		if sel.Where == nil || !impliesEqual(sel.Where.Expr, filter, label) {
+			if sel.Where != nil {
+				if _, ok := sel.Where.Expr.(*OrExpr); ok {
+					sel.Where.Expr = &ParenExpr{Expr: sel.Where.Expr}
+				}
+			}
+			sel.AddWhere(&ComparisonExpr{Operator: EqualStr, Left: filter, Right: NewStrVal([]byte(label))})
+		}
+		aliased.Expr = NewStrVal([]byte(label))
+		aliased.As = NewColIdent(mapping.Label.Column)
+		return true
+	}
+	return false
+}
+
+// concatExpr returns expr with its || operators, which are parsed as OR,
+// turned into concat calls so that it can be compared.
+func concatExpr(expr Expr) Expr {
+	var ors []*OrExpr
+	_ = Walk(func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *Subquery:
+			return false, nil
+		case *OrExpr:
+			if !isBoolExpr(node) {
+				ors = append(ors, node)
+				return false, nil
+			}
+		}
+		return true, nil
+	}, expr)
+	for _, or := range ors {
+		expr = ReplaceExpr(expr, or, &FuncExpr{Name: NewColIdent("concat"), Exprs: SelectExprs{
+			&AliasedExpr{Expr: concatExpr(or.Left)},
+			&AliasedExpr{Expr: concatExpr(or.Right)},
+		}})
+	}
+	return expr
+}
+
+// impliesEqual reports whether where, ANDed, compares expr to the
+// string value.
+func impliesEqual(where Expr, expr Expr, value string) bool {
+	switch where := where.(type) {
+	case *AndExpr:
+		return impliesEqual(where.Left, expr, value) || impliesEqual(where.Right, expr, value)
+	case *ParenExpr:
+		return impliesEqual(where.Expr, expr, value)
+	case *ComparisonExpr:
+		if where.Operator != EqualStr {
+			return false
+		}
+		formatted := String(expr, false)
+		for _, sides := range [][2]Expr{{where.Left, where.Right}, {where.Right, where.Left}} {
+			val, ok := sides[1].(*SQLVal)
+			if ok && val.Type == StrVal && string(val.Val) == value && String(sides[0], false) == formatted {
+				return true
+			}
+		}
+	}
+	return false
+}
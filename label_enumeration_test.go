@@ -0,0 +1,118 @@
+package sqlparser
+
+import (
+	"testing"
+)
+
+func TestMultiLabelPoints(t *testing.T) {
+	testcases := []struct {
+		sql string
+		out map[string]string
+	}{{
+		sql: "select id as point_id, case when is_shop then 'shop' else 'author' end as point_type, score from t where a = 1 or b = 2",
+		out: map[string]string{
+			"shop": "select label, id, score from (select 'shop' as label, cast(id as string) as id, cast(score as double) from t " +
+				"where (a = 1 or b = 2) and case when is_shop then 'shop' else 'author' end = 'shop');",
+			"author": "select label, id, score from (select 'author' as label, cast(id as string) as id, score from t " +
+				"where (a = 1 or b = 2) and case when is_shop then 'shop' else 'author' end = 'author');",
+		},
+	}, {
+		sql: "select id as point_id, kind as point_type from t where kind in ('shop', 'author')",
+		out: map[string]string{
+			"shop": "select label, id from (select 'shop' as label, cast(id as string) as id from t " +
+				"where kind in ('shop', 'author') and kind = 'shop');",
+			"author": "select label, id from (select 'author' as label, cast(id as string) as id from t " +
+				"where kind in ('shop', 'author') and kind = 'author');",
+		},
+	}, {
+		sql: "select id as point_id, point_type from (select id, if(x > 0, 'shop', 'author') as point_type from t) a",
+		out: map[string]string{
+			"shop": "select label, id from (select 'shop' as label, cast(id as string) as id from " +
+				"(select id, if(x > 0, 'shop', 'author') as point_type from t) as a where point_type = 'shop');",
+			"author": "select label, id from (select 'author' as label, cast(id as string) as id from " +
+				"(select id, if(x > 0, 'shop', 'author') as point_type from t) as a where point_type = 'author');",
+		},
+	}, {
+		sql: "select id as point_id, concat_ws('_', 'x', k) as point_type from t where k in ('p', 'q')",
+		out: map[string]string{
+			"x_p": "select label, id from (select 'x_p' as label, cast(id as string) as id from t " +
+				"where k in ('p', 'q') and concat_ws('_', 'x', k) = 'x_p');",
+			"x_q": "select label, id from (select 'x_q' as label, cast(id as string) as id from t " +
+				"where k in ('p', 'q') and concat_ws('_', 'x', k) = 'x_q');",
+		},
+	}, {
+		sql: "select id as point_id, lower(kind) || '_x' as point_type from t where kind in ('A', 'B', 'a')",
+		out: map[string]string{
+			"a_x": "select label, id from (select 'a_x' as label, cast(id as string) as id from t " +
+				"where kind in ('A', 'B', 'a') and concat(lower(kind), '_x') = 'a_x');",
+			"b_x": "select label, id from (select 'b_x' as label, cast(id as string) as id from t " +
+				"where kind in ('A', 'B', 'a') and concat(lower(kind), '_x') = 'b_x');",
+		},
+	}, {
+		sql: "select id as point_id, kind as point_type from t where kind in ('shop', 'author') and kind = 'shop'",
+		out: map[string]string{
+			"shop": "select label, id from (select kind as label, cast(id as string) as id from t " +
+				"where kind in ('shop', 'author') and kind = 'shop');",
+		},
+	}, {
+		sql: "select id as point_id, lower(k || '_X') as point_type from t where k in ('p', 'q')",
+		out: map[string]string{
+			"p_x": "select label, id from (select 'p_x' as label, cast(id as string) as id from t " +
+				"where k in ('p', 'q') and lower(concat(k, '_X')) = 'p_x');",
+			"q_x": "select label, id from (select 'q_x' as label, cast(id as string) as id from t " +
+				"where k in ('p', 'q') and lower(concat(k, '_X')) = 'q_x');",
+		},
+	}, {
+		sql: "select id as point_id, case when x > 0 then 'shop' end as point_type from t where case when x > 0 then 'shop' end = 'shop'",
+		out: map[string]string{
+			"shop": "select label, id from (select 'shop' as label, cast(id as string) as id from t " +
+				"where case when x > 0 then 'shop' end = 'shop');",
+		},
+	}, {
+		sql: "with k as (select id, 'shop' as kind from t union all select id, 'author' as kind from u) " +
+			"select id as point_id, kind as point_type from k",
+		out: map[string]string{
+			"shop": "select label, id from (with k as (select id, 'shop' as kind from t union all select id, 'author' as kind from u) " +
+				"select 'shop' as label, cast(id as string) as id from k where kind = 'shop');",
+			"author": "select label, id from (with k as (select id, 'shop' as kind from t union all select id, 'author' as kind from u) " +
+				"select 'author' as label, cast(id as string) as id from k where kind = 'author');",
+		},
+	}}
+	typeMap := map[string]map[string]string{"shop": {"score": "double"}}
+	schema := DefaultGraphSchema()
+	schema.Mappings[1].Label.FromWhere = true
+	for _, tcase := range testcases {
+		rewritten, err := RewriteSqls(tcase.sql, WithTypeMap(typeMap), WithQuoteMode(SingleQuote),
+			WithDedupPolicy(&DedupPolicy{Strategy: DedupNone}), WithGraphSchema(schema))
+		if err != nil {
+			t.Errorf("RewriteSqls(%s) err: %v", tcase.sql, err)
+			continue
+		}
+		if len(rewritten) != len(tcase.out) {
+			t.Errorf("RewriteSqls(%s): %d labels, want %d", tcase.sql, len(rewritten), len(tcase.out))
+		}
+		for label, want := range tcase.out {
+			var got string
+			if def, ok := rewritten[label]; ok {
+				got = def.Sql
+			}
+			if got != want {
+				t.Errorf("RewriteSqls(%s)[%s]:\n%s, want\n%s", tcase.sql, label, got, want)
+			}
+		}
+	}
+}
+
+func TestMultiLabelPointsUnresolved(t *testing.T) {
+	for _, sql := range []string{
+		"select id as point_id, kind as point_type from t",
+		"select id as point_id, kind as point_type from t where kind = 'shop'",
+		"select id as point_id, case when x then 'shop' else kind end as point_type from t",
+		"select id as point_id, concat(kind, x) as point_type from t where kind in ('shop', 'author')",
+	} {
+		_, err := RewriteSqls(sql)
+		if rewriteErr, ok := err.(*RewriteError); !ok || rewriteErr.Code != ErrMissingLabel {
+			t.Errorf("RewriteSqls(%s) err: %v, want %s", sql, err, ErrMissingLabel)
+		}
+	}
+}
@@ -455,6 +455,59 @@ var (
 		input: "select /* function with many params */ 1 from t where a = b(c, d)",
 	}, {
 		input: "select /* function with distinct */ count(distinct a) from t",
+	}, {
+		input: "select /* window function */ row_number() over (partition by id order by ts desc) as rn from t",
+	}, {
+		input:  "select /* window function order only */ rank() over (order by a, b desc) from t",
+		output: "select /* window function order only */ rank() over (order by a asc, b desc) from t",
+	}, {
+		input: "select /* window function partition only */ sum(x) over (partition by a, b) from t",
+	}, {
+		input: "select /* window function empty */ count(*) over () from t",
+	}, {
+		input: "select /* aggregate window with distinct */ count(distinct a) over (partition by b) from t",
+	}, {
+		input: "select /* window frame rows */ sum(x) over (partition by uid order by ts asc rows between 6 preceding and current row) from t",
+	}, {
+		input: "select /* window frame range */ avg(x) over (order by ts asc range between unbounded preceding and unbounded following) from t",
+	}, {
+		input: "select /* window frame single bound */ max(x) over (partition by a rows unbounded preceding) from t",
+	}, {
+		input:  "select /* window frame following */ min(x) over (ORDER BY ts ROWS BETWEEN CURRENT ROW AND 2 FOLLOWING) from t",
+		output: "select /* window frame following */ min(x) over (order by ts asc rows between current row and 2 following) from t",
+	}, {
+		input: "select /* window frame only */ sum(x) over (rows 1 preceding) from t",
+	}, {
+		input: "select /* named window */ rank() over w, sum(x) over w from t window w as (partition by a order by b asc)",
+	}, {
+		input: "select /* named window refinement */ sum(x) over (w order by b asc rows unbounded preceding) from t group by a having b > 1 window w as (partition by a), w2 as (w)",
+	}, {
+		input:  "select /* named window parenthesized */ sum(x) over (w) from t window w as (partition by a) order by a limit 1",
+		output: "select /* named window parenthesized */ sum(x) over w from t window w as (partition by a) order by a asc limit 1",
+	}, {
+		input:  "select /* frame keywords as columns */ current, preceding, following, unbounded from t",
+		output: "select /* frame keywords as columns */ `current`, `preceding`, `following`, `unbounded` from t",
+	}, {
+		input:  "select /* frame keyword column in a frame */ sum(x) over (order by ts rows between current preceding and current row) from t",
+		output: "select /* frame keyword column in a frame */ sum(x) over (order by ts asc rows between `current` preceding and current row) from t",
+	}, {
+		input:  "select /* frame units as columns */ range, rows from t where rows = 1 order by range",
+		output: "select /* frame units as columns */ `range`, `rows` from t where `rows` = 1 order by `range` asc",
+	}, {
+		input:  "select /* frame unit column in a window */ sum(x) over (order by range rows between rows preceding and current row) from t",
+		output: "select /* frame unit column in a window */ sum(x) over (order by `range` asc rows between `rows` preceding and current row) from t",
+	}, {
+		input:  "select /* window as identifier */ window, a window from window",
+		output: "select /* window as identifier */ `window`, a as `window` from `window`",
+	}, {
+		input:  "select /* window after a derived table */ rank() over w from (select a from t) window w as (order by a)",
+		output: "select /* window after a derived table */ rank() over w from (select a from t) window w as (order by a asc)",
+	}, {
+		input:  "select /* over as column */ over from t",
+		output: "select /* over as column */ `over` from t",
+	}, {
+		input:  "select /* over as alias */ a over, count(*) as over from over",
+		output: "select /* over as alias */ a as `over`, count(*) as `over` from `over`",
 	}, {
 		input: "select /* if as func */ 1 from t where a = if(b)",
 	}, {
@@ -549,6 +602,10 @@ var (
 		input: "select /* limit a */ 1 from t limit a",
 	}, {
 		input: "select /* limit a,b */ 1 from t limit a, b",
+	}, {
+		input: "select * from ${db}.t where `date` = ${date} limit ${n}",
+	}, {
+		input: "select ${hivevar:x} as ${alias} from ${db}_tmp.t_${env} as ${t} where b = '${d}' limit ${n}, ${m}",
 	}, {
 		input:  "select /* binary unary */ a- -b from t",
 		output: "select /* binary unary */ a - -b from t",
@@ -636,6 +693,36 @@ var (
 		output: "insert /* qualified columns */ into t(a, b) values (1, 2)",
 	}, {
 		input: "insert /* select */ into a select b, c from d",
+	}, {
+		input: "insert overwrite table db.t partition (`date` = '20240101', hour = '01') select a, b from s",
+	}, {
+		input: "insert overwrite table db.t partition (`date` = '20240101', hour) select a, b, hour from s",
+	}, {
+		input: "insert overwrite table t partition (dt = '1') if not exists select a from s",
+	}, {
+		input:  "INSERT OVERWRITE TABLE t SELECT a FROM s UNION ALL SELECT b FROM u",
+		output: "insert overwrite table t select a from s union all select b from u",
+	}, {
+		input: "insert overwrite table ${db}.t partition (dt = ${dt}) select a from s",
+	}, {
+		input:  "select /* overwrite as column */ overwrite from t",
+		output: "select /* overwrite as column */ `overwrite` from t",
+	}, {
+		input:  "insert into overwrite values (1)",
+		output: "insert into `overwrite` values (1)",
+	}, {
+		input:  "insert overwrite values (1)",
+		output: "insert into `overwrite` values (1)",
+	}, {
+		input:  "insert overwrite (a) values (1)",
+		output: "insert into `overwrite`(a) values (1)",
+	}, {
+		input:  "insert ignore overwrite values (1)",
+		output: "insert ignore into `overwrite` values (1)",
+	}, {
+		input: "insert into table t partition (dt = '1') select a from s",
+	}, {
+		input: "insert into table t partition (dt) values (1, '2')",
 	}, {
 		input:  "insert /* no cols & paren select */ into a(select * from t)",
 		output: "insert /* no cols & paren select */ into a select * from t",
@@ -1300,6 +1387,22 @@ var (
 		input: "select name, group_concat(distinct id, score order by id desc separator ':') from t group by name",
 	}, {
 		input: "select * from t partition (p0)",
+	}, {
+		input: "select t.profile.address.city, props['weight'].value, arr[0].f.g from t",
+	}, {
+		input: "select db.t.profile.city from db.t",
+	}, {
+		input: "select nid from t lateral view explode(split(ids, ',')) x as nid",
+	}, {
+		input:  "select * from t LATERAL VIEW OUTER posexplode(arr) x AS pos, val where pos > 0",
+		output: "select * from t lateral view outer posexplode(arr) x as pos, val where pos > 0",
+	}, {
+		input: "select a.id, x.k, y.v from db.t as a lateral view explode(m) x as k, v lateral view explode(l) y where a.id = 1",
+	}, {
+		input: "select * from (select ids from t) as s lateral view explode(ids) x as nid join u on x.nid = u.id",
+	}, {
+		input:  "select lateral, a lateral from t as lateral",
+		output: "select `lateral`, a as `lateral` from t as `lateral`",
 	}, {
 		input: "select * from t partition (p0, p1)",
 	}, {
@@ -1565,6 +1668,9 @@ func TestKeywords(t *testing.T) {
 	}, {
 		input:  "select variables from t",
 		output: "select `variables` from t",
+	}, {
+		input:  "select /* hive keywords as cols */ lines, stored, escaped, terminated, inputformat, outputformat from t",
+		output: "select /* hive keywords as cols */ `lines`, `stored`, `escaped`, `terminated`, `inputformat`, `outputformat` from t",
 	}}
 
 	for _, tcase := range validSQL {
@@ -1638,6 +1744,31 @@ func TestConvert(t *testing.T) {
 		input: "select convert('abc', json) from t",
 	}, {
 		input: "select convert('abc' using ascii) from t",
+	}, {
+		input: "select cast(a as varchar(10)), cast(b as boolean), cast(c as timestamp) from t",
+	}, {
+		input:  "select cast(tags as ARRAY<STRING>) from t",
+		output: "select cast(tags as array<string>) from t",
+	}, {
+		input:  "select cast(props as map<string, double>), cast(ids as map<string,array<bigint>>) from t",
+		output: "select cast(props as map<string,double>), cast(ids as map<string,array<bigint>>) from t",
+	}, {
+		input: "select cast(a as array<array<array<int>>>) from t where b >> 1 >= c",
+	}, {
+		input:  "select cast(a as struct<id:bigint, `date`:string, w:map<string,decimal(10, 2)>>) from t",
+		output: "select cast(a as struct<id:bigint,`date`:string,w:map<string,decimal(10, 2)>>) from t",
+	}, {
+		input:  "select a from t where map <= 1",
+		output: "select a from t where `map` <= 1",
+	}, {
+		input:  "select a from t where t.map < 1 and b = :v",
+		output: "select a from t where t.`map` < 1 and b = :v",
+	}, {
+		input:  "select a from t where array < 1 and b >> 1 > 0",
+		output: "select a from t where `array` < 1 and b >> 1 > 0",
+	}, {
+		input:  "select cast(m as struct<a:map<string,int>>) >= 1, struct from t where struct <> 2 and b = :v",
+		output: "select cast(m as struct<a:map<string,int>>) >= 1, `struct` from t where `struct` != 2 and b = :v",
 	}}
 
 	for _, tcase := range validSQL {
@@ -1775,6 +1906,11 @@ func TestCreateTable(t *testing.T) {
 			"	col_longtext longtext,\n" +
 			"	col_text text character set ascii collate ascii_bin,\n" +
 			"	col_json json,\n" +
+			"	col_string string,\n" +
+			"	col_boolean boolean,\n" +
+			"	col_array array<string>,\n" +
+			"	col_map map<string,array<decimal(10, 2)>> comment 'weights',\n" +
+			"	col_struct struct<id:bigint,tags:array<string>>,\n" +
 			"	col_enum enum('a', 'b', 'c', 'd'),\n" +
 			"	col_enum2 enum('a', 'b', 'c', 'd') character set ascii,\n" +
 			"	col_enum3 enum('a', 'b', 'c', 'd') collate ascii_bin,\n" +
@@ -1909,6 +2045,31 @@ func TestCreateTable(t *testing.T) {
 			"  stats_sample_pages 1,\n" +
 			"  tablespace tablespace_name storage disk,\n" +
 			"  tablespace tablespace_name\n",
+
+		// hive table clauses
+		"create table db.t (\n" +
+			"	id bigint comment 'id',\n" +
+			"	`date` string\n" +
+			") comment 'graph edges'\n" +
+			"partitioned by (dt string, hour string comment 'hour of day')\n" +
+			"clustered by (id) sorted by (id desc) into 8 buckets\n" +
+			"row format delimited fields terminated by '\\t' escaped by '\\\\' collection items terminated by ',' map keys terminated by ':' lines terminated by '\\n' null defined as ''\n" +
+			"stored as orc\n" +
+			"location 'hdfs://ns/warehouse/db.db/t'\n" +
+			"tblproperties ('orc.compress' = 'SNAPPY', 'comment' = 'x')",
+		"create table t (\n" +
+			"	id bigint\n" +
+			")\n" +
+			"row format serde 'org.apache.hadoop.hive.serde2.lazy.LazySimpleSerDe' with serdeproperties ('field.delim' = ',')\n" +
+			"stored as inputformat 'org.apache.hadoop.mapred.TextInputFormat' outputformat 'org.apache.hadoop.hive.ql.io.HiveIgnoreKeyTextOutputFormat'",
+		"create table t (\n" +
+			"	a int\n" +
+			") comment 'x'",
+		"create table t as select a, b from s",
+		"create table t\n" +
+			"partitioned by (dt string)\n" +
+			"stored as parquet\n" +
+			"as select a, b, dt from s",
 	}
 	for _, sql := range validSQL {
 		sql = strings.TrimSpace(sql)
@@ -1954,6 +2115,23 @@ func TestCreateTable(t *testing.T) {
 			"	unique key by_username2 (username) key_block_size 8,\n" +
 			"	unique by_username3 (username) key_block_size 4\n" +
 			")",
+	}, {
+		// hive clauses are printed in canonical order
+		input: "CREATE TABLE IF NOT EXISTS t (id BIGINT) STORED AS ORC TBLPROPERTIES ('a'='b') PARTITIONED BY (`date` STRING) COMMENT 'x'",
+		output: "create table t (\n" +
+			"	id bigint\n" +
+			") comment 'x'\n" +
+			"partitioned by (`date` string)\n" +
+			"stored as ORC\n" +
+			"tblproperties ('a' = 'b')",
+	}, {
+		// hive keywords as column names
+		input: "create table t (lines int, stored string) stored as orc",
+		output: "create table t (\n" +
+			"	`lines` int,\n" +
+			"	`stored` string\n" +
+			")\n" +
+			"stored as orc",
 	},
 	}
 	for _, tcase := range testCases {
@@ -2104,6 +2282,19 @@ var (
 	}, {
 		input:  "select /* straight_join using */ 1 from t1 straight_join t2 using (a)",
 		output: "syntax error at position 66 near 'using'",
+	}, {
+		input:        "select ${date from t",
+		output:       "syntax error at position 21 near '${date from t'",
+		excludeMulti: true,
+	}, {
+		input:  "insert overwrite t select 1",
+		output: "syntax error at position 19 near 't'",
+	}, {
+		input:  "insert overwrite table t values (1)",
+		output: "syntax error at position 32 near 'values'",
+	}, {
+		input:  "insert ignore overwrite table t select 1 from s",
+		output: "syntax error at position 48",
 	}, {
 		input:        "select 'aa",
 		output:       "syntax error at position 11 near 'aa'",
@@ -0,0 +1,219 @@
+package sqlparser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PartitionRule replaces the calls to partition functions, such as
+// max_pt('db.t'), that a partition column is compared with. Calls nested
+// in the compared expression are replaced too, so that
+// dt >= date_sub(max_pt('db.t'), 7) becomes dt >= date_sub('${date}', 7).
+type PartitionRule struct {
+	// Functions lists the partition function names, e.g. max_pt.
+	Functions []string `json:"functions" yaml:"functions"`
+	// Columns lists the partition columns, e.g. date or dt.
+	Columns []string `json:"columns" yaml:"columns"`
+	// Operators lists the comparison operators the rule applies to:
+	// =, !=, <, <=, >, >=, between or not between. They're read with the
+	// partition column on the left, so max_pt('db.t') <= dt is >=.
+	Operators []string `json:"operators" yaml:"operators"`
+	// Replacement is the SQL expression that replaces the calls, e.g.
+	// '${date}'. {column} stands for the name of the compared column,
+	// so '${{column}}' becomes '${hour}' for hour = max_pt('db.t').
+	Replacement string `json:"replacement" yaml:"replacement"`
+}
+
+// PartitionSubstitution reports a partition function call replaced by
+// a PartitionRule. Operator compares Column with the call, whichever
+// side of the comparison the column is on.
+type PartitionSubstitution struct {
+	// Statement is the index of the statement in the script.
+	Statement   int    `json:"statement"`
+	Column      string `json:"column"`
+	Operator    string `json:"operator"`
+	Original    string `json:"original"`
+	Replacement string `json:"replacement"`
+}
+
+// DefaultPartitionRules returns the rules used by WithReplaceMaxPt:
+// date = max_pt(...) becomes date = '${date}'.
+func DefaultPartitionRules() []*PartitionRule {
+	return []*PartitionRule{{
+		Functions:   []string{"max_pt"},
+		Columns:     []string{"date"},
+		Operators:   []string{EqualStr},
+		Replacement: "'${date}'",
+	}}
+}
+
+var partitionRuleOperators = map[string]bool{
+	EqualStr:        true,
+	NotEqualStr:     true,
+	LessThanStr:     true,
+	LessEqualStr:    true,
+	GreaterThanStr:  true,
+	GreaterEqualStr: true,
+	BetweenStr:      true,
+	NotBetweenStr:   true,
+}
+
+// Validate checks that the rule names functions, columns and supported
+// operators, and that its replacement is a valid expression.
+func (rule *PartitionRule) Validate() error {
+	if len(rule.Functions) == 0 || len(rule.Columns) == 0 || len(rule.Operators) == 0 {
+		return fmt.Errorf("partition rule: functions, columns and operators are required")
+	}
+	for _, op := range rule.Operators {
+		if !partitionRuleOperators[strings.ToLower(op)] {
+			return fmt.Errorf("partition rule: unsupported operator %s", op)
+		}
+	}
+	_, err := rule.replacement(rule.Columns[0])
+	return err
+}
+
+// replacement parses the replacement of the calls compared with column.
+func (rule *PartitionRule) replacement(column string) (Expr, error) {
+	template := strings.Replace(rule.Replacement, "{column}", column, -1)
+	stmt, err := Parse("select " + template + " from dual")
+	if err != nil {
+		return nil, fmt.Errorf("partition rule: invalid replacement %s: %v", rule.Replacement, err)
+	}
+	sel, ok := stmt.(*Select)
+	if !ok || len(sel.SelectExprs) != 1 {
+		return nil, fmt.Errorf("partition rule: invalid replacement %s", rule.Replacement)
+	}
+	aliased, ok := sel.SelectExprs[0].(*AliasedExpr)
+	if !ok || !aliased.As.IsEmpty() {
+		return nil, fmt.Errorf("partition rule: invalid replacement %s", rule.Replacement)
+	}
+	return aliased.Expr, nil
+}
+
+func (rule *PartitionRule) appliesTo(operator string) bool {
+	for _, op := range rule.Operators {
+		if strings.EqualFold(op, operator) {
+			return true
+		}
+	}
+	return false
+}
+
+// flippedOperator returns the operator that compares the operands of
+// operator the other way around, e.g. > for <.
+func flippedOperator(operator string) string {
+	switch operator {
+	case LessThanStr:
+		return GreaterThanStr
+	case LessEqualStr:
+		return GreaterEqualStr
+	case GreaterThanStr:
+		return LessThanStr
+	case GreaterEqualStr:
+		return LessEqualStr
+	}
+	return operator
+}
+
+// partitionColumn returns the name of expr if it's a partition column
+// of the rule.
+func (rule *PartitionRule) partitionColumn(expr Expr) (string, bool) {
+	col, ok := expr.(*ColName)
+	if !ok {
+		return "", false
+	}
+	for _, name := range rule.Columns {
+		if col.Name.EqualString(name) {
+			return col.Name.String(), true
+		}
+	}
+	return "", false
+}
+
+func (rule *PartitionRule) isPartitionFunc(node SQLNode) bool {
+	fn, ok := node.(*FuncExpr)
+	if !ok {
+		return false
+	}
+	for _, name := range rule.Functions {
+		if fn.Name.EqualString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// substitutePartitions applies the rules to the predicates of stmt and
+// returns the substitutions made.
+func substitutePartitions(stmt Statement, rules []*PartitionRule) ([]PartitionSubstitution, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	var substitutions []PartitionSubstitution
+	err := Walk(func(node SQLNode) (bool, error) {
+		for _, rule := range rules {
+			var err error
+			switch node := node.(type) {
+			case *ComparisonExpr:
+				if column, ok := rule.partitionColumn(node.Left); ok {
+					if rule.appliesTo(node.Operator) {
+						substitutions, err = rule.substitute(&node.Right, column, node.Operator, substitutions)
+					}
+				} else if column, ok := rule.partitionColumn(node.Right); ok {
+					if operator := flippedOperator(node.Operator); rule.appliesTo(operator) {
+						substitutions, err = rule.substitute(&node.Left, column, operator, substitutions)
+					}
+				}
+			case *RangeCond:
+				if !rule.appliesTo(node.Operator) {
+					continue
+				}
+				if column, ok := rule.partitionColumn(node.Left); ok {
+					substitutions, err = rule.substitute(&node.From, column, node.Operator, substitutions)
+					if err == nil {
+						substitutions, err = rule.substitute(&node.To, column, node.Operator, substitutions)
+					}
+				}
+			}
+			if err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	}, stmt)
+	return substitutions, err
+}
+
+// substitute replaces the partition function calls found in the
+// expression tree rooted at *expr.
+func (rule *PartitionRule) substitute(expr *Expr, column, operator string, substitutions []PartitionSubstitution) ([]PartitionSubstitution, error) {
+	var calls []Expr
+	_ = Walk(func(node SQLNode) (bool, error) {
+		if rule.isPartitionFunc(node) {
+			calls = append(calls, node.(Expr))
+			return false, nil
+		}
+		// The predicates of subqueries are visited on their own.
+		_, isSubquery := node.(*Subquery)
+		return !isSubquery, nil
+	}, *expr)
+	for _, call := range calls {
+		replacement, err := rule.replacement(column)
+		if err != nil {
+			return nil, err
+		}
+		substitutions = append(substitutions, PartitionSubstitution{
+			Column:      column,
+			Operator:    operator,
+			Original:    String(call, false),
+			Replacement: String(replacement, false),
+		})
+		if *expr == call {
+			*expr = replacement
+		} else {
+			(*expr).replace(call, replacement)
+		}
+	}
+	return substitutions, nil
+}
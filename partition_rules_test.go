@@ -0,0 +1,123 @@
+package sqlparser
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPartitionRules(t *testing.T) {
+	rules := []*PartitionRule{{
+		Functions:   []string{"max_pt", "latest_partition"},
+		Columns:     []string{"date", "dt", "p_date"},
+		Operators:   []string{"=", ">=", "between"},
+		Replacement: "'${date}'",
+	}, {
+		Functions:   []string{"max_pt"},
+		Columns:     []string{"hour"},
+		Operators:   []string{"="},
+		Replacement: "'${{column}}'",
+	}}
+	testcases := []struct {
+		in  string
+		out string
+	}{{
+		in:  "select 1 from t where date = max_pt('db.t')",
+		out: "select 1 from t where `date` = '${date}'",
+	}, {
+		in:  "select 1 from t where latest_partition('db.t') = p_date",
+		out: "select 1 from t where '${date}' = p_date",
+	}, {
+		in:  "select 1 from t where dt >= date_sub(max_pt('db.t'), 7)",
+		out: "select 1 from t where dt >= date_sub('${date}', 7)",
+	}, {
+		in:  "select 1 from t where dt between date_sub(max_pt('db.t'), 7) and max_pt('db.t')",
+		out: "select 1 from t where dt between date_sub('${date}', 7) and '${date}'",
+	}, {
+		in:  "select 1 from t where t.dt = max_pt('db.t') and hour = max_pt('db.t')",
+		out: "select 1 from t where t.dt = '${date}' and hour = '${hour}'",
+	}, {
+		// Unlisted operators and columns are left alone.
+		in:  "select 1 from t where dt < max_pt('db.t') and ds = max_pt('db.t') and hour >= max_pt('db.t')",
+		out: "select 1 from t where dt < max_pt('db.t') and ds = max_pt('db.t') and hour >= max_pt('db.t')",
+	}, {
+		// The operator is read with the column on the left.
+		in:  "select 1 from t where max_pt('db.t') <= dt and max_pt('db.t') >= hour",
+		out: "select 1 from t where '${date}' <= dt and max_pt('db.t') >= hour",
+	}, {
+		in:  "select 1 from t where dt = (select max(dt) from u where dt = max_pt('db.u'))",
+		out: "select 1 from t where dt = (select max(dt) from u where dt = '${date}')",
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Errorf("Parse(%s) err: %v", tcase.in, err)
+			continue
+		}
+		if _, err := substitutePartitions(stmt, rules); err != nil {
+			t.Errorf("substitutePartitions(%s) err: %v", tcase.in, err)
+			continue
+		}
+		if got := String(stmt, false); got != tcase.out {
+			t.Errorf("substitutePartitions(%s):\n%s, want\n%s", tcase.in, got, tcase.out)
+		}
+	}
+}
+
+func TestPartitionRuleValidate(t *testing.T) {
+	testcases := []struct {
+		rule *PartitionRule
+		err  string
+	}{{
+		rule: &PartitionRule{Functions: []string{"max_pt"}, Columns: []string{"dt"}, Replacement: "'${date}'"},
+		err:  "partition rule: functions, columns and operators are required",
+	}, {
+		rule: &PartitionRule{Functions: []string{"max_pt"}, Columns: []string{"dt"}, Operators: []string{"like"}, Replacement: "'${date}'"},
+		err:  "partition rule: unsupported operator like",
+	}, {
+		rule: &PartitionRule{Functions: []string{"max_pt"}, Columns: []string{"dt"}, Operators: []string{"="}, Replacement: "'${date}' as x"},
+		err:  "partition rule: invalid replacement '${date}' as x",
+	}, {
+		rule: &PartitionRule{Functions: []string{"max_pt"}, Columns: []string{"dt"}, Operators: []string{"="}, Replacement: "date_sub('${date}', 1)"},
+	}}
+	for _, tcase := range testcases {
+		err := tcase.rule.Validate()
+		if got := ""; err != nil {
+			got = err.Error()
+			if got != tcase.err {
+				t.Errorf("Validate(%+v) err: %s, want %s", tcase.rule, got, tcase.err)
+			}
+		} else if tcase.err != "" {
+			t.Errorf("Validate(%+v) err: nil, want %s", tcase.rule, tcase.err)
+		}
+	}
+}
+
+func TestRewriteSqlsPartitionSubstitutions(t *testing.T) {
+	results, err := RewriteSqlsOrdered(`SELECT 'leaf' AS point_type, id AS point_id FROM t1 WHERE dt = latest_partition('t1');
+SELECT 'leaf' AS point_type, id AS point_id FROM t2 WHERE date = max_pt('t2') AND hour = max_pt('t2');
+SELECT 'leaf' AS point_type, id AS point_id FROM t3 WHERE latest_partition('t3') < dt`, WithPartitionRules(&PartitionRule{
+		Functions:   []string{"max_pt", "latest_partition"},
+		Columns:     []string{"date", "dt", "hour"},
+		Operators:   []string{"=", ">"},
+		Replacement: "'${{column}}'",
+	}))
+	if err != nil {
+		t.Fatalf("RewriteSqlsOrdered error: %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected 1 label, got %d", len(results))
+	}
+	want := []PartitionSubstitution{
+		{Statement: 0, Column: "dt", Operator: "=", Original: "latest_partition('t1')", Replacement: "'${dt}'"},
+		{Statement: 1, Column: "date", Operator: "=", Original: "max_pt('t2')", Replacement: "'${date}'"},
+		{Statement: 1, Column: "hour", Operator: "=", Original: "max_pt('t2')", Replacement: "'${hour}'"},
+		{Statement: 2, Column: "dt", Operator: ">", Original: "latest_partition('t3')", Replacement: "'${dt}'"},
+	}
+	if got := results[0].Substitutions; !reflect.DeepEqual(got, want) {
+		t.Errorf("Substitutions:\n%+v, want\n%+v", got, want)
+	}
+	if strings.Contains(results[0].Sql, "max_pt") || strings.Contains(results[0].Sql, "latest_partition") {
+		t.Errorf("expected partition functions to be replaced, got %s", results[0].Sql)
+	}
+}
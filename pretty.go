@@ -33,12 +33,18 @@ func PrettyFormatter(buf *TrackedBuffer, node SQLNode) {
 			writeAlignedClauseKeyword(buf, "using")
 			buf.Myprintf("%v", node.Using)
 		}
+	case LateralViews:
+		for _, view := range node {
+			buf.Myprintf("\n%v", view)
+		}
 	case *Select:
 		prettyFormatSelect(buf, node)
 	case *Where:
 		prettyFormatWhereClause(buf, node)
 	case GroupBy:
 		prettyFormatGroupByClause(buf, node)
+	case NamedWindows:
+		prettyFormatWindowClause(buf, node)
 	case OrderBy:
 		prettyFormatOrderByClause(buf, node)
 	case *Limit:
@@ -109,6 +115,11 @@ func prettyFormatSelect(buf *TrackedBuffer, node *Select) {
 		buf.Myprintf("%v", node.Having)
 	}
 
+	if len(node.Windows) > 0 {
+		ensureClauseNewline(buf)
+		buf.Myprintf("%v", node.Windows)
+	}
+
 	if len(node.OrderBy) > 0 {
 		ensureClauseNewline(buf)
 		buf.Myprintf("%v", node.OrderBy)
@@ -222,6 +233,21 @@ func prettyFormatGroupByClause(buf *TrackedBuffer, node GroupBy) {
 	}
 }
 
+func prettyFormatWindowClause(buf *TrackedBuffer, node NamedWindows) {
+	if len(node) == 0 {
+		return
+	}
+
+	ensureClauseNewline(buf)
+	indent := strings.Repeat(" ", writeAlignedClauseKeyword(buf, "window"))
+	buf.Myprintf("%v", node[0])
+	for i := 1; i < len(node); i++ {
+		buf.WriteString(",\n")
+		buf.WriteString(indent)
+		buf.Myprintf("%v", node[i])
+	}
+}
+
 func prettyFormatOrderByClause(buf *TrackedBuffer, node OrderBy) {
 	if len(node) == 0 {
 		return
@@ -270,7 +296,7 @@ func prettyFormatSubquery(buf *TrackedBuffer, node *Subquery) {
 		buf.WriteString("()")
 		return
 	}
-	inner := NewTrackedBuffer(buf.nodeFormatter)
+	inner := NewTrackedBuffer(buf.nodeFormatter).SetQuoteMode(buf.quoteMode)
 	inner.Myprintf("%v", node.Select)
 	innerSQL := inner.String()
 	if innerSQL == "" {
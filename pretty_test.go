@@ -0,0 +1,42 @@
+package sqlparser
+
+import (
+	"testing"
+)
+
+func TestPrettyFormatter(t *testing.T) {
+	tcases := []struct {
+		input  string
+		output string
+	}{{
+		input: "select a, sum(x) over (partition by uid order by ts rows between 6 preceding and current row) as s from t where a = 1 and b = 2",
+		output: "select a,\n" +
+			"       sum(x) over (partition by uid order by ts asc rows between 6 preceding and current row) as s\n" +
+			"from   t\n" +
+			"where  a = 1\n" +
+			"and    b = 2",
+	}, {
+		input: "select rank() over w from t group by a window w as (partition by a), w2 as (w order by b)",
+		output: "select rank() over w\n" +
+			"from   t\n" +
+			"group by a\n" +
+			"window w as (partition by a),\n" +
+			"       w2 as (w order by b asc)",
+	}, {
+		input: "select nid from t lateral view outer explode(split(ids, ',')) x as nid where nid != ''",
+		output: "select nid\n" +
+			"from   t\n" +
+			"lateral view outer explode(split(ids, ',')) x as nid\n" +
+			"where  nid != ''",
+	}}
+	for _, tcase := range tcases {
+		tree, err := Parse(tcase.input)
+		if err != nil {
+			t.Errorf("Parse(%q) err: %v, want nil", tcase.input, err)
+			continue
+		}
+		if got := String(tree, true); got != tcase.output {
+			t.Errorf("String(%q, true):\n%s\nwant:\n%s", tcase.input, got, tcase.output)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package sqlparser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ReverseEdge makes RewriteSqls also emit the edges of a label reversed,
+// with the columns of GraphMapping.ReversedColumns swapped.
+type ReverseEdge struct {
+	// Label is the label of the reversed edges. {label} stands for the
+	// label of the edges, so {label}_reverse becomes shop_sim_reverse.
+	// It defaults to the label of the edges, for symmetric edge types.
+	Label string `json:"label,omitempty" yaml:"label,omitempty"`
+	// Separate rewrites the reversed edges into a statement of their own
+	// instead of the UNION ALL of the edges they reverse. Label must
+	// then differ from the label of the edges.
+	Separate bool `json:"separate,omitempty" yaml:"separate,omitempty"`
+}
+
+// reverseLabel returns the label of the reversed edges of label.
+func (reverse *ReverseEdge) reverseLabel(label string) string {
+	if reverse.Label == "" {
+		return label
+	}
+	return strings.Replace(reverse.Label, "{label}", label, -1)
+}
+
+// validate checks that the reversed edges of label can be told apart
+// from the edges they reverse when they're rewritten separately.
+func (reverse *ReverseEdge) validate(label string) error {
+	if reverse.Separate && reverse.reverseLabel(label) == label {
+		return fmt.Errorf("reverse edge %s: separate reversed edges need a label of their own", label)
+	}
+	return nil
+}
+
+// reverseResult rewrites the reverse of result, the rewritten statement
+// text of label, if options reverse the label. It returns the reversed
+// result and its label, or nil if the label isn't reversed.
+func reverseResult(result *rewriteResult, label, text string, options *RewriteOptions) (*rewriteResult, string, error) {
+	reverse := options.ReverseEdges[label]
+	if reverse == nil {
+		return nil, "", nil
+	}
+	reverseLabel := reverse.reverseLabel(label)
+	stmt, err := Parse(text)
+	if err != nil {
+		return nil, "", err
+	}
+	ResolveFieldAccess(stmt)
+	if result.label != "" && !pinLabel(stmt, result.mapping, result.label) {
+		return nil, "", fmt.Errorf("cannot restrict statement to label %s", result.label)
+	}
+	reversed, reversedKey, err := reverseStatement(stmt, result.mapping, reverseLabel, options)
+	if err != nil {
+		return nil, "", err
+	}
+	reversed.source = result.source
+	if !reverse.Separate {
+		// Tell the directions apart when they're labeled differently, so
+		// that they don't dedupe each other.
+		if reverseLabel != label {
+			labelColumn := result.mapping.labelOutput()
+			result.dedupColumns = append(result.dedupColumns, labelColumn)
+			reversed.dedupColumns = append(reversed.dedupColumns, labelColumn)
+		}
+		reversedKey = label
+	}
+	return reversed, reversedKey, nil
+}
+
+// reverseStatement rewrites stmt reversed: the reversed columns of
+// mapping are swapped and the label is replaced by label.
+func reverseStatement(stmt Statement, mapping *GraphMapping, label string, options *RewriteOptions) (*rewriteResult, string, error) {
+	if len(mapping.ReversedColumns) == 0 {
+		return nil, "", newRewriteError(ErrInvalidReverse, "", "set reversed_columns in the graph schema",
+			"%s statements can't be reversed", mapping.Name)
+	}
+	selectStmt, ok := rewriteSource(stmt)
+	if !ok {
+		return nil, "", newRewriteError(ErrUnsupportedStmt, "", "", "unexpected statement type %T", stmt)
+	}
+	for _, sel := range selectBranches(selectStmt) {
+		swapped := 0
+		for _, expr := range sel.SelectExprs {
+			aliased, ok := expr.(*AliasedExpr)
+			if !ok {
+				continue
+			}
+			name := aliasOrColumnName(aliased)
+			if strings.EqualFold(name, mapping.Label.Column) {
+				aliased.Expr = NewStrVal([]byte(label))
+				aliased.As = NewColIdent(name)
+			} else if reversed, ok := mapping.reversedColumn(name); ok {
+				aliased.As = NewColIdent(reversed)
+				swapped++
+			}
+		}
+		if swapped == 0 {
+			return nil, "", newRewriteError(ErrInvalidReverse, "", "select the reversed columns explicitly rather than with *",
+				"no reversed %s columns are selected", mapping.Name)
+		}
+	}
+	result, key, err := rewriteStatement(stmt, options)
+	if err != nil {
+		return nil, "", err
+	}
+	// The substitutions are reported for the edges that are reversed.
+	result.substitutions = nil
+	result.trace.Reversed = true
+	return result, key, nil
+}
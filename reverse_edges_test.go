@@ -0,0 +1,62 @@
+package sqlparser
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestReverseEdges(t *testing.T) {
+	sql := "select src as point1_id, tgt as point2_id, 'shop' as point1_type, 'author' as point2_type, 'shop_author' as edge_type from t"
+	const (
+		forward = "select named_struct('id', cast(src as string)) as outv_pk_prop, cast(tgt as string) as bg__id, " +
+			"'shop' as outv_label, 'author' as bg__bg__label, 'shop_author' as label from t"
+		backward = "select named_struct('id', cast(tgt as string)) as outv_pk_prop, cast(src as string) as bg__id, " +
+			"'author' as outv_label, 'shop' as bg__bg__label, %s as label from t"
+		dedupe = "select outv_pk_prop, bg__id, outv_label, bg__bg__label, label from (" +
+			"select *, row_number() over (partition by %s order by 1) as rn from (%s)) where rn = 1;"
+		keys = "outv_pk_prop, bg__id, outv_label, bg__bg__label"
+	)
+	testcases := []struct {
+		reverse *ReverseEdge
+		out     map[string]string
+	}{{
+		reverse: &ReverseEdge{},
+		out: map[string]string{
+			"shop_author": fmt.Sprintf(dedupe, keys, forward+" union all "+fmt.Sprintf(backward, "'shop_author'")),
+		},
+	}, {
+		reverse: &ReverseEdge{Label: "author_shop"},
+		out: map[string]string{
+			"shop_author": fmt.Sprintf(dedupe, keys+", label", forward+" union all "+fmt.Sprintf(backward, "'author_shop'")),
+		},
+	}, {
+		reverse: &ReverseEdge{Label: "{label}_reverse", Separate: true},
+		out: map[string]string{
+			"shop_author":         fmt.Sprintf(dedupe, keys, forward),
+			"shop_author_reverse": fmt.Sprintf(dedupe, keys, fmt.Sprintf(backward, "'shop_author_reverse'")),
+		},
+	}}
+	for _, tcase := range testcases {
+		rewritten, err := RewriteSqls(sql, WithReverseEdge("shop_author", tcase.reverse), WithQuoteMode(SingleQuote))
+		if err != nil {
+			t.Errorf("RewriteSqls(%+v) err: %v", tcase.reverse, err)
+			continue
+		}
+		if len(rewritten) != len(tcase.out) {
+			t.Errorf("RewriteSqls(%+v): %d labels, want %d", tcase.reverse, len(rewritten), len(tcase.out))
+		}
+		for label, want := range tcase.out {
+			if def, ok := rewritten[label]; !ok || def.Sql != want {
+				t.Errorf("RewriteSqls(%+v)[%s]:\n%v, want\n%s", tcase.reverse, label, def, want)
+			}
+		}
+	}
+
+	if _, err := RewriteSqls(sql, WithReverseEdge("shop_author", &ReverseEdge{Separate: true})); err == nil {
+		t.Errorf("RewriteSqls with a separate reverse edge of the same label: want an error")
+	}
+	_, err := RewriteSqls("select 'shop' as point_type, id as point_id from t", WithReverseEdge("shop", &ReverseEdge{}))
+	if rewriteErr, ok := err.(*RewriteError); !ok || rewriteErr.Code != ErrInvalidReverse {
+		t.Errorf("RewriteSqls reversing points err: %v, want %s", err, ErrInvalidReverse)
+	}
+}
@@ -9,18 +9,94 @@ import (
 type SqlDef struct {
 	Sql       string `json:"sql"`
 	LabelType string `json:"label_type"`
+	// Columns lists the output columns of Sql and their types.
+	Columns []*CatalogColumn `json:"columns,omitempty"`
+	// StagingTable creates a table Sql can be inserted into.
+	StagingTable    *DDL   `json:"-"`
+	StagingTableSql string `json:"staging_table_sql,omitempty"`
+	// Manifest describes the output to the graph loader.
+	Manifest *LoaderManifest `json:"manifest,omitempty"`
+}
+
+// RewrittenSql is the rewritten statement of one label along with the
+// input statements it was built from.
+type RewrittenSql struct {
+	Label string `json:"label"`
+	SqlDef
+	Sources []StatementSource `json:"sources"`
+	// Substitutions lists the partition functions replaced in the
+	// statements.
+	Substitutions []PartitionSubstitution `json:"substitutions,omitempty"`
+	// Paddings lists the NULL columns added to align the statements.
+	Paddings []ProjectionPadding `json:"paddings,omitempty"`
+	// Traces explains the rewrite of the statements if
+	// RewriteOptions.Explain is set.
+	Traces []*RewriteTrace `json:"traces,omitempty"`
+}
+
+// StatementSource identifies an input statement by its index in the
+// script and its byte range [Start, End) in the script text.
+type StatementSource struct {
+	Index int `json:"index"`
+	Start int `json:"start"`
+	End   int `json:"end"`
 }
 
 type rewriteResult struct {
-	statement    Statement
-	selectStmt   *Select
-	dedupColumns []string
+	statement     Statement
+	selectStmt    *Select
+	dedupColumns  []string
+	mapping       *GraphMapping
+	source        StatementSource
+	substitutions []PartitionSubstitution
+	// label is the label the statement was restricted to, if it selects
+	// several.
+	label string
+	trace *RewriteTrace
 }
 
 type RewriteOptions struct {
 	Pretty       bool
 	TypeMap      map[string]map[string]string
 	ReplaceMaxPt bool
+	// Schema maps the input columns to the graph columns. It defaults
+	// to DefaultGraphSchema.
+	Schema *GraphSchema
+	// QuoteMode quotes the string literals of the rewritten statements.
+	// It defaults to DoubleQuote.
+	QuoteMode QuoteMode
+	// PartitionRules replace the partition functions compared with
+	// partition columns. ReplaceMaxPt uses DefaultPartitionRules if
+	// there are none.
+	PartitionRules []*PartitionRule
+	// Dedup deduplicates the rows of the labels that have no policy in
+	// LabelDedup. It defaults to DedupAny.
+	Dedup *DedupPolicy
+	// LabelDedup sets the dedup policy of individual labels.
+	LabelDedup map[string]*DedupPolicy
+	// ReverseEdges makes the edges of the given labels also be emitted
+	// reversed.
+	ReverseEdges map[string]*ReverseEdge
+	// Catalog lists the columns of the tables that SELECT * reads.
+	Catalog *Catalog
+	// StagingTable names the staging table of each label, with {label}
+	// standing for the label. It defaults to {label}_staging.
+	StagingTable string
+	// TypeMapValidation checks that the entries of TypeMap apply to the
+	// rewritten statements. Entries are unchecked by default.
+	TypeMapValidation TypeMapValidation
+	// TypeNames lists the types TypeMap may cast to when TypeMapValidation
+	// is set. It defaults to DefaultTypeNames.
+	TypeNames []string
+	// Explain returns a trace of how each statement was rewritten.
+	Explain bool
+	// CollectErrors keeps rewriting after a statement fails. The failures
+	// are returned together as RewriteErrors, along with the labels that
+	// could still be rewritten.
+	CollectErrors bool
+	// ConstFunctions are the functions the labels are folded with, besides
+	// those of NewConstEvaluator. They're looked up by lower-case name.
+	ConstFunctions map[string]ConstFunc
 }
 
 type RewriteOption func(*RewriteOptions)
@@ -43,182 +119,458 @@ func WithReplaceMaxPt(replace bool) RewriteOption {
 	}
 }
 
+// WithPartitionRules sets the rules that replace partition functions.
+func WithPartitionRules(rules ...*PartitionRule) RewriteOption {
+	return func(o *RewriteOptions) {
+		o.PartitionRules = rules
+	}
+}
+
+// WithDedupPolicy sets the dedup policy of all labels that have no
+// policy of their own.
+func WithDedupPolicy(policy *DedupPolicy) RewriteOption {
+	return func(o *RewriteOptions) {
+		o.Dedup = policy
+	}
+}
+
+// WithLabelDedupPolicy sets the dedup policy of a label.
+func WithLabelDedupPolicy(label string, policy *DedupPolicy) RewriteOption {
+	return func(o *RewriteOptions) {
+		if o.LabelDedup == nil {
+			o.LabelDedup = make(map[string]*DedupPolicy)
+		}
+		o.LabelDedup[label] = policy
+	}
+}
+
+// WithReverseEdge makes the edges of label also be emitted reversed.
+func WithReverseEdge(label string, reverse *ReverseEdge) RewriteOption {
+	return func(o *RewriteOptions) {
+		if o.ReverseEdges == nil {
+			o.ReverseEdges = make(map[string]*ReverseEdge)
+		}
+		o.ReverseEdges[label] = reverse
+	}
+}
+
+// WithStagingTable sets the name of the staging tables. {label} stands
+// for the label, e.g. tmp.{label}_graph.
+func WithStagingTable(name string) RewriteOption {
+	return func(o *RewriteOptions) {
+		o.StagingTable = name
+	}
+}
+
+// WithCatalog sets the catalog used to expand SELECT *.
+func WithCatalog(catalog *Catalog) RewriteOption {
+	return func(o *RewriteOptions) {
+		o.Catalog = catalog
+	}
+}
+
+// WithQuoteMode sets how the string literals of the rewritten statements
+// are quoted.
+func WithQuoteMode(mode QuoteMode) RewriteOption {
+	return func(o *RewriteOptions) {
+		o.QuoteMode = mode
+	}
+}
+
+// WithTypeMapValidation sets how the entries of the TypeMap are checked.
+func WithTypeMapValidation(validation TypeMapValidation) RewriteOption {
+	return func(o *RewriteOptions) {
+		o.TypeMapValidation = validation
+	}
+}
+
+// WithTypeNames sets the types the TypeMap may cast to.
+func WithTypeNames(names ...string) RewriteOption {
+	return func(o *RewriteOptions) {
+		o.TypeNames = names
+	}
+}
+
+// WithExplain sets whether RewrittenSql.Traces explains the rewrite.
+func WithExplain(explain bool) RewriteOption {
+	return func(o *RewriteOptions) {
+		o.Explain = explain
+	}
+}
+
+// WithCollectErrors sets whether all statement failures are collected
+// instead of stopping at the first one.
+func WithCollectErrors(collect bool) RewriteOption {
+	return func(o *RewriteOptions) {
+		o.CollectErrors = collect
+	}
+}
+
+// WithConstFunctions adds functions the labels can be folded with, e.g.
+// a UDF that statements compute their label with.
+func WithConstFunctions(functions map[string]ConstFunc) RewriteOption {
+	return func(o *RewriteOptions) {
+		if o.ConstFunctions == nil {
+			o.ConstFunctions = make(map[string]ConstFunc)
+		}
+		for name, fn := range functions {
+			o.ConstFunctions[strings.ToLower(name)] = fn
+		}
+	}
+}
+
+// WithGraphSchema sets the column mapping used to recognize and rewrite
+// point and edge statements.
+func WithGraphSchema(schema *GraphSchema) RewriteOption {
+	return func(o *RewriteOptions) {
+		o.Schema = schema
+	}
+}
+
+// RewriteSqls rewrites the point and edge statements of sql and returns
+// the rewritten statement of each label. See RewriteSqlsOrdered.
 func RewriteSqls(sql string, opts ...RewriteOption) (map[string]*SqlDef, error) {
-	options := &RewriteOptions{}
+	results, err := RewriteSqlsOrdered(sql, opts...)
+	if results == nil {
+		return nil, err
+	}
+	rewritten := make(map[string]*SqlDef, len(results))
+	for _, result := range results {
+		rewritten[result.Label] = &result.SqlDef
+	}
+	return rewritten, err
+}
+
+// RewriteSqlsOrdered rewrites the point and edge statements of sql. The
+// statements of the same label are combined with UNION ALL in the order
+// they appear in sql, and the labels are returned in the order they're
+// first seen, so the output is stable across runs.
+//
+// Statement failures are returned as a *RewriteError, or as RewriteErrors
+// if options.CollectErrors is set. TypeMap entries that don't apply are
+// returned as TypeMapIssues if options.TypeMapValidation is set.
+func RewriteSqlsOrdered(sql string, opts ...RewriteOption) ([]*RewrittenSql, error) {
+	options, err := newRewriteOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	issues := options.checkTypeMap()
+	keys, grouped, failures, err := rewriteStatements(sql, options)
+	if err != nil || grouped == nil {
+		return nil, err
+	}
+
+	rewritten := make([]*RewrittenSql, 0, len(keys))
+	for _, key := range keys {
+		results := grouped[key]
+		stmt, paddings, err := finalizeRewriteGroup(results, options.dedupPolicy(key), options.TypeMap[key], options.Catalog)
+		if err != nil {
+			failures = append(failures, toRewriteError(err, sql, results[0].source))
+			if !options.CollectErrors {
+				return nil, failures[0]
+			}
+			continue
+		}
+
+		var (
+			substitutions []PartitionSubstitution
+			traces        []*RewriteTrace
+		)
+		sources := make([]StatementSource, 0, len(results))
+		for _, res := range results {
+			sources = append(sources, res.source)
+			substitutions = append(substitutions, res.substitutions...)
+			if options.Explain {
+				res.trace.Statement = res.source.Index
+				res.trace.Dedup = options.dedupPolicy(key).Strategy
+				res.trace.DedupKeys = res.dedupColumns
+				traces = append(traces, res.trace)
+			}
+		}
+		result := &RewrittenSql{
+			Label: key,
+			SqlDef: SqlDef{
+				Sql:       StringWithQuoteMode(stmt, options.Pretty, options.QuoteMode) + ";",
+				LabelType: "string",
+			},
+			Sources:       sources,
+			Substitutions: substitutions,
+			Paddings:      paddings,
+			Traces:        traces,
+		}
+		if err := describeOutput(&result.SqlDef, stmt, results, key, options); err != nil {
+			failures = append(failures, toRewriteError(err, sql, results[0].source))
+			if !options.CollectErrors {
+				return nil, failures[0]
+			}
+			continue
+		}
+		rewritten = append(rewritten, result)
+	}
+
+	if len(failures) != 0 {
+		return rewritten, failures
+	}
+	if options.TypeMapValidation != TypeMapUnchecked {
+		issues = append(issues, checkTypeMapLabels(options.TypeMap, keys, rewritten)...)
+	}
+	switch {
+	case len(issues) == 0:
+		return rewritten, nil
+	case options.TypeMapValidation == TypeMapStrict:
+		return nil, issues
+	}
+	return rewritten, issues
+}
+
+// newRewriteOptions applies opts to the default options and validates
+// the result.
+func newRewriteOptions(opts []RewriteOption) (*RewriteOptions, error) {
+	options := &RewriteOptions{QuoteMode: DoubleQuote}
 	for _, opt := range opts {
 		opt(options)
 	}
+	if options.Schema == nil {
+		options.Schema = DefaultGraphSchema()
+	} else if err := options.Schema.Validate(); err != nil {
+		return nil, err
+	}
+	if options.ReplaceMaxPt && len(options.PartitionRules) == 0 {
+		options.PartitionRules = DefaultPartitionRules()
+	}
+	for _, rule := range options.PartitionRules {
+		if err := rule.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	if options.Dedup != nil {
+		if err := options.Dedup.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	for label, policy := range options.LabelDedup {
+		if err := policy.Validate(); err != nil {
+			return nil, fmt.Errorf("label %s: %w", label, err)
+		}
+	}
+	for label, reverse := range options.ReverseEdges {
+		if err := reverse.validate(label); err != nil {
+			return nil, err
+		}
+	}
+	if err := options.TypeMapValidation.Validate(); err != nil {
+		return nil, err
+	}
+	return options, nil
+}
 
+// rewriteStatements rewrites the statements of sql one by one and groups
+// the results by label. The labels are returned in the order they're
+// first seen. The failures are returned as RewriteErrors if
+// options.CollectErrors is set, and the first one as an error otherwise.
+func rewriteStatements(sql string, options *RewriteOptions) ([]string, map[string][]*rewriteResult, RewriteErrors, error) {
 	if len(strings.TrimSpace(sql)) == 0 {
-		return nil, nil
+		return nil, nil, nil, nil
 	}
 	tokenizer := NewStringTokenizer(sql)
-	rewritten := make(map[string]*SqlDef)
+	var (
+		keys     []string
+		failures RewriteErrors
+	)
 	grouped := make(map[string][]*rewriteResult)
 	appendResult := func(key string, result *rewriteResult) {
+		if _, ok := grouped[key]; !ok {
+			keys = append(keys, key)
+		}
 		grouped[key] = append(grouped[key], result)
 	}
-	for {
+	for index := 0; ; index++ {
+		start := tokenizer.Position - 1
 		stmt, err := ParseNext(tokenizer)
 		if err == io.EOF {
 			break
 		}
+		source := statementSource(sql, index, start, tokenizer.Position-1)
 		if err != nil {
-			return nil, fmt.Errorf("ParseNext error: %w", err)
+			failures = append(failures, syntaxError(err, sql, source))
+			if !options.CollectErrors {
+				return nil, nil, nil, failures[0]
+			}
+			continue
 		}
 		if stmt == nil {
+			index--
 			continue
 		}
-
-		if options.ReplaceMaxPt {
-			if err := replaceMaxPtWithDate(stmt); err != nil {
-				return nil, err
+		ResolveFieldAccess(stmt)
+
+		text := sql[source.Start:source.End]
+		results, resultKeys, err := rewriteStatementLabels(stmt, text, options)
+		for i := 0; err == nil && i < len(results); i++ {
+			result := results[i]
+			result.source = source
+			for j := range result.substitutions {
+				result.substitutions[j].Statement = index
+			}
+			appendResult(resultKeys[i], result)
+
+			var (
+				reversed    *rewriteResult
+				reversedKey string
+			)
+			reversed, reversedKey, err = reverseResult(result, resultKeys[i], text, options)
+			if reversed != nil {
+				appendResult(reversedKey, reversed)
 			}
 		}
-		selectStmt, ok := stmt.(SelectStatement)
-		if !ok {
-			return nil, fmt.Errorf("unexpected statement type %T", stmt)
-		}
-		key, dedupCols, baseSelect, err := rewriteSelectStatement(selectStmt, options.TypeMap)
-		if err != nil {
-			return nil, err
-		}
-		appendResult(key, &rewriteResult{
-			statement:    selectStmt,
-			selectStmt:   baseSelect,
-			dedupColumns: dedupCols,
-		})
-	}
-
-	for key, results := range grouped {
-		stmt, err := finalizeRewriteGroup(results)
 		if err != nil {
-			return nil, err
-		}
-
-		rewritten[key] = &SqlDef{
-			Sql:       strings.Replace(String(stmt, options.Pretty)+";", "'", "\"", -1),
-			LabelType: "string",
+			failures = append(failures, toRewriteError(err, sql, source))
+			if !options.CollectErrors {
+				return nil, nil, nil, failures[0]
+			}
 		}
 	}
-
-	return rewritten, nil
+	return keys, grouped, failures, nil
 }
 
-func rewriteSql(sel *Select, typeMap map[string]map[string]string) (string, []string, error) {
-	if key, dedupCols, rewritten, err := rewriteEdgeSql(sel, typeMap); err != nil {
-		return "", nil, err
-	} else if rewritten {
-		return key, dedupCols, nil
+// rewriteStatement rewrites the select statement of stmt and returns
+// its label.
+func rewriteStatement(stmt Statement, options *RewriteOptions) (*rewriteResult, string, error) {
+	substitutions, err := substitutePartitions(stmt, options.PartitionRules)
+	if err != nil {
+		return nil, "", err
 	}
-	if key, dedupCols, rewritten, err := rewritePointSql(sel, typeMap); err != nil {
-		return "", nil, err
-	} else if rewritten {
-		return key, dedupCols, nil
+	selectStmt, ok := rewriteSource(stmt)
+	if !ok {
+		return nil, "", newRewriteError(ErrUnsupportedStmt, "", "only SELECT and INSERT ... SELECT statements can be rewritten",
+			"unexpected statement type %T", stmt)
 	}
-
-	return "", nil, fmt.Errorf("select does not contain recognizable point or edge columns")
-}
-
-func replaceMaxPtWithDate(stmt Statement) error {
-	return Walk(func(node SQLNode) (bool, error) {
-		comp, ok := node.(*ComparisonExpr)
-		if !ok {
-			return true, nil
-		}
-
-		replaceMaxPtComparison(comp)
-		return true, nil
-	}, stmt)
+	trace := &RewriteTrace{}
+	key, mapping, baseSelect, err := rewriteSelectStatement(selectStmt, options, newProjectionResolver(options.Catalog), trace)
+	if err != nil {
+		return nil, "", err
+	}
+	return &rewriteResult{
+		statement:     selectStmt,
+		selectStmt:    baseSelect,
+		dedupColumns:  append([]string(nil), mapping.DedupKeys...),
+		mapping:       mapping,
+		substitutions: substitutions,
+		trace:         trace,
+	}, key, nil
 }
 
-func replaceMaxPtComparison(comp *ComparisonExpr) {
-	if comp == nil {
-		return
+// statementSource returns the source of the statement scanned between
+// the given offsets, leaving out the separating semicolons and blanks.
+func statementSource(sql string, index, start, end int) StatementSource {
+	if start < 0 {
+		start = 0
 	}
-
-	if comp.Operator != EqualStr {
-		return
+	if end > len(sql) {
+		end = len(sql)
 	}
-
-	if isDateColumn(comp.Left) && isMaxPtFunc(comp.Right) {
-		comp.Right = NewStrVal([]byte("${date}"))
-		return
+	const blanks = " \n\r\t"
+	for start < end && strings.IndexByte(";"+blanks, sql[start]) >= 0 {
+		start++
 	}
-
-	if isMaxPtFunc(comp.Left) && isDateColumn(comp.Right) {
-		comp.Left = NewStrVal([]byte("${date}"))
+	for end > start && strings.IndexByte(blanks, sql[end-1]) >= 0 {
+		end--
 	}
+	return StatementSource{Index: index, Start: start, End: end}
 }
 
-func isDateColumn(expr Expr) bool {
-	col, ok := expr.(*ColName)
-	if !ok {
-		return false
+// rewriteSource returns the select statement that feeds the rewrite.
+// INSERT ... SELECT statements, such as the trailing INSERT OVERWRITE
+// of a Hive script, are rewritten through their source query.
+func rewriteSource(stmt Statement) (SelectStatement, bool) {
+	switch node := stmt.(type) {
+	case SelectStatement:
+		return node, true
+	case *Insert:
+		sel, ok := node.Rows.(SelectStatement)
+		return sel, ok
 	}
-
-	return col.Name.EqualString("date")
+	return nil, false
 }
 
-func isMaxPtFunc(expr Expr) bool {
-	fn, ok := expr.(*FuncExpr)
-	if !ok {
-		return false
+func rewriteSql(sel *Select, options *RewriteOptions, resolver *projectionResolver, trace *RewriteTrace) (string, *GraphMapping, error) {
+	if err := resolver.resolveProjection(sel); err != nil {
+		return "", nil, err
+	}
+	for _, mapping := range options.Schema.Mappings {
+		selectTrace := &SelectTrace{Mapping: mapping.Name}
+		if key, rewritten, err := rewriteMappedSql(sel, mapping, options, resolver.ctes, selectTrace); err != nil {
+			return "", nil, err
+		} else if rewritten {
+			trace.Selects = append(trace.Selects, selectTrace)
+			return key, mapping, nil
+		}
 	}
 
-	return fn.Name.EqualString("max_pt")
+	var expected []string
+	for _, mapping := range options.Schema.Mappings {
+		var columns []string
+		for _, col := range mapping.Columns {
+			if col.Required {
+				columns = append(columns, col.Input)
+			}
+		}
+		expected = append(expected, fmt.Sprintf("%s for %s statements", strings.Join(columns, ", "), mapping.Name))
+	}
+	return "", nil, newRewriteError(ErrUnrecognizedColumns, "", "select "+strings.Join(expected, ", or "),
+		"select does not contain recognizable point or edge columns")
 }
 
-func rewriteSelectStatement(stmt SelectStatement, typeMap map[string]map[string]string) (string, []string, *Select, error) {
+func rewriteSelectStatement(stmt SelectStatement, options *RewriteOptions, resolver *projectionResolver, trace *RewriteTrace) (string, *GraphMapping, *Select, error) {
 	switch node := stmt.(type) {
 	case *Select:
-		key, dedupCols, err := rewriteSql(node, typeMap)
+		key, mapping, err := rewriteSql(node, options, resolver, trace)
 		if err != nil {
 			return "", nil, nil, err
 		}
-		return key, dedupCols, node, nil
+		return key, mapping, node, nil
 	case *ParenSelect:
-		return rewriteSelectStatement(node.Select, typeMap)
+		return rewriteSelectStatement(node.Select, options, resolver, trace)
 	case *Union:
-		leftKey, leftDedup, leftSelect, err := rewriteSelectStatement(node.Left, typeMap)
+		leftKey, leftMapping, leftSelect, err := rewriteSelectStatement(node.Left, options, resolver, trace)
 		if err != nil {
 			return "", nil, nil, err
 		}
-		rightKey, rightDedup, _, err := rewriteSelectStatement(node.Right, typeMap)
+		rightKey, rightMapping, _, err := rewriteSelectStatement(node.Right, options, resolver, trace)
 		if err != nil {
 			return "", nil, nil, err
 		}
 		if leftKey == "" || rightKey == "" {
-			return "", nil, nil, fmt.Errorf("missing rewrite key for union branch")
+			return "", nil, nil, newRewriteError(ErrMissingLabel, "", "", "missing rewrite key for union branch")
 		}
 		if leftKey != rightKey {
-			return "", nil, nil, fmt.Errorf("mismatched rewrite keys for union branches: %s vs %s", leftKey, rightKey)
+			return "", nil, nil, newRewriteError(ErrLabelMismatch, rightKey, "the branches of a UNION must select the same label; split them into separate statements",
+				"mismatched rewrite keys for union branches: %s vs %s", leftKey, rightKey)
 		}
-		if !stringSlicesEqual(leftDedup, rightDedup) {
-			return "", nil, nil, fmt.Errorf("inconsistent dedup columns within union branches")
+		if !stringSlicesEqual(leftMapping.DedupKeys, rightMapping.DedupKeys) {
+			return "", nil, nil, newRewriteError(ErrInconsistentDedup, "", "the branches of a UNION must be all points or all edges",
+				"inconsistent dedup columns within union branches")
 		}
-		return leftKey, leftDedup, leftSelect, nil
+		return leftKey, leftMapping, leftSelect, nil
 	case *With:
-		key, dedupCols, baseSelect, err := rewriteSelectStatement(node.Stmt, typeMap)
+		key, mapping, baseSelect, err := rewriteSelectStatement(node.Stmt, options, resolver.withCTEs(node.CTEs), trace)
 		if err != nil {
 			return "", nil, nil, err
 		}
-		return key, dedupCols, baseSelect, nil
+		return key, mapping, baseSelect, nil
 	default:
-		return "", nil, nil, fmt.Errorf("unexpected statement type %T", stmt)
+		return "", nil, nil, newRewriteError(ErrUnsupportedStmt, "", "", "unexpected statement type %T", stmt)
 	}
 }
 
-func rewriteEdgeSql(sel *Select, typeMap map[string]map[string]string) (string, []string, bool, error) {
-	edgeTypeLiteral, _ := findStringLiteralForAliasInSelect(sel, "edge_type")
-
+// rewriteMappedSql rewrites the columns of sel according to mapping. It
+// returns false if sel selects none of the required columns.
+func rewriteMappedSql(sel *Select, mapping *GraphMapping, options *RewriteOptions, ctes map[string]*CommonTableExpr, trace *SelectTrace) (string, bool, error) {
 	var (
-		point1ID   *AliasedExpr
-		point2ID   *AliasedExpr
-		point1Type *AliasedExpr
-		point2Type *AliasedExpr
-		edgeType   *AliasedExpr
-		remaining  SelectExprs
+		required  = make([]*AliasedExpr, len(mapping.Columns))
+		found     = 0
+		labelExpr Expr
+		remaining SelectExprs
 	)
 
 	for _, expr := range sel.SelectExprs {
@@ -228,178 +580,160 @@ func rewriteEdgeSql(sel *Select, typeMap map[string]map[string]string) (string,
 			continue
 		}
 
-		switch strings.ToLower(aliasOrColumnName(aliased)) {
-		case "point1_id":
-			point1ID = aliased
-		case "point2_id":
-			point2ID = aliased
-		case "point1_type":
-			point1Type = aliased
-		case "point2_type":
-			point2Type = aliased
-		case "value":
-			// value is deprecated and should be dropped.
-		default:
+		name := aliasOrColumnName(aliased)
+		if strings.EqualFold(name, mapping.Label.Column) && labelExpr == nil {
+			labelExpr = aliased.Expr
+		}
+		if mapping.isDeprecated(name) {
+			trace.addColumn(name, "", ColumnDropped)
+			continue
+		}
+		i := mapping.columnIndex(name)
+		if i < 0 || !mapping.Columns[i].Required {
 			remaining = append(remaining, aliased)
+			continue
 		}
+		if required[i] == nil {
+			found++
+		}
+		required[i] = aliased
 	}
 
-	if point1ID == nil && point2ID == nil && point1Type == nil && point2Type == nil {
-		return "", nil, false, nil
-	}
-
-	if point1ID == nil || point2ID == nil || point1Type == nil || point2Type == nil {
-		return "", nil, false, fmt.Errorf("missing required columns: p1=%t p2=%t p1Type=%t p2Type=%t", point1ID != nil, point2ID != nil, point1Type != nil, point2Type != nil)
+	if found == 0 {
+		return "", false, nil
 	}
 
-	point1Expr := &AliasedExpr{
-		Expr: &FuncExpr{
-			Name: NewColIdent("named_struct"),
-			Exprs: SelectExprs{
-				&AliasedExpr{Expr: NewStrVal([]byte("id"))},
-				&AliasedExpr{Expr: ensureStringCast(point1ID.Expr)},
-			},
-		},
-		As: NewColIdent("outv_pk_prop"),
+	label, labelSource, labelErr := findLabelLiteral(sel, labelExpr, mapping.Label, options.ConstFunctions, ctes)
+	trace.Label, trace.LabelSource = label, labelSource
+	if labelExpr != nil {
+		trace.LabelExpr = String(labelExpr, false)
 	}
-	selectExprs := SelectExprs{point1Expr}
-
-	point2Expr := &AliasedExpr{
-		Expr: ensureStringCast(point2ID.Expr),
-		As:   NewColIdent("bg__id"),
+	var labels []string
+	if label == "" && labelExpr != nil && mapping.Label.Enumerate {
+		var err error
+		if labels, err = enumerateLabels(sel, labelExpr, mapping.Label, options.ConstFunctions, ctes); err != nil {
+			labelErr = err
+		}
 	}
-	selectExprs = append(selectExprs, point2Expr)
-
-	point1Type.As = NewColIdent("outv_label")
-	selectExprs = append(selectExprs, point1Type)
 
-	point2Type.As = NewColIdent("bg__bg__label")
-	selectExprs = append(selectExprs, point2Type)
-
-	for _, expr := range remaining {
-		aliased, ok := expr.(*AliasedExpr)
-		if !ok {
-			selectExprs = append(selectExprs, expr)
+	var (
+		selectExprs SelectExprs
+		missing     []string
+		near        string
+	)
+	for i, col := range mapping.Columns {
+		if !col.Required {
 			continue
 		}
+		if required[i] == nil {
+			missing = append(missing, col.Input)
+			continue
+		}
+		if near == "" {
+			near = aliasOrColumnName(required[i])
+		}
+		trace.addColumn(aliasOrColumnName(required[i]), col.Output, ColumnRequired)
+		col.traceCast(required[i].Expr, trace)
+		required[i].Expr = col.mapExpr(required[i].Expr)
+		required[i].As = NewColIdent(col.Output)
+		selectExprs = append(selectExprs, required[i])
+	}
+	if len(missing) != 0 {
+		return "", false, newRewriteError(ErrMissingColumns, near, fmt.Sprintf("add %s to the select list", strings.Join(missing, ", ")),
+			"missing required %s columns: %s", mapping.Name, strings.Join(missing, ", "))
+	}
 
-		switch strings.ToLower(aliasOrColumnName(aliased)) {
-		case "edge_type":
-			edgeType = aliased
-			aliased.As = NewColIdent("label")
-			if edgeTypeLiteral == "" {
-				if literal, err := extractStringLiteral(aliased.Expr); err == nil {
-					edgeTypeLiteral = literal
-				}
+	for _, expr := range remaining {
+		if aliased, ok := expr.(*AliasedExpr); ok {
+			name := aliasOrColumnName(aliased)
+			if i := mapping.columnIndex(name); i >= 0 {
+				col := mapping.Columns[i]
+				trace.addColumn(name, col.Output, ColumnMapped)
+				col.traceCast(aliased.Expr, trace)
+				aliased.Expr = col.mapExpr(aliased.Expr)
+				aliased.As = NewColIdent(col.Output)
+			} else {
+				trace.addColumn(name, name, ColumnKept)
 			}
-			selectExprs = append(selectExprs, aliased)
-		case "ts_us":
-			aliased.As = NewColIdent("tsUs")
-			selectExprs = append(selectExprs, aliased)
-		default:
-			selectExprs = append(selectExprs, aliased)
 		}
+		selectExprs = append(selectExprs, expr)
 	}
 
 	sel.SelectExprs = selectExprs
 
-	if edgeTypeLiteral == "" && edgeType != nil {
-		if literal, ok := deriveEdgeTypeLiteralFromExpr(sel, edgeType.Expr); ok {
-			edgeTypeLiteral = literal
-		}
+	if label == "" && len(labels) != 0 {
+		return "", false, &multiLabelError{mapping: mapping, labels: labels, labelExpr: trace.LabelExpr}
 	}
-	if edgeTypeLiteral == "" {
-		return "", nil, false, fmt.Errorf("edge sql missing literal edge_type column")
+	if label == "" {
+		return "", false, newRewriteError(ErrMissingLabel, mapping.Label.Column, fmt.Sprintf("select the label as a string literal, e.g. 'label' AS %s", mapping.Label.Column),
+			"%s sql missing literal %s column: %w", mapping.Name, mapping.Label.Column, labelErr)
 	}
-	if err := applyTypeAnnotations(sel.SelectExprs, typeMap[edgeTypeLiteral]); err != nil {
-		return "", nil, false, err
+	if err := applyTypeAnnotations(sel.SelectExprs, options.TypeMap[label], trace); err != nil {
+		return "", false, err
 	}
 
-	return edgeTypeLiteral, []string{"outv_pk_prop", "bg__id", "outv_label", "bg__bg__label"}, true, nil
+	return label, true, nil
 }
 
-func rewritePointSql(sel *Select, typeMap map[string]map[string]string) (string, []string, bool, error) {
-	var (
-		pointID          *AliasedExpr
-		pointType        *AliasedExpr
-		pointTypeLiteral string
-		remaining        SelectExprs
-	)
-
-	for _, expr := range sel.SelectExprs {
-		aliased, ok := expr.(*AliasedExpr)
-		if !ok {
-			remaining = append(remaining, expr)
-			continue
-		}
-
-		switch strings.ToLower(aliasOrColumnName(aliased)) {
-		case "point_id":
-			pointID = aliased
-		case "point_type":
-			pointType = aliased
-		case "point_value":
-			// deprecated
-		default:
-			remaining = append(remaining, aliased)
-		}
-	}
-
-	if pointID == nil && pointType == nil {
-		return "", nil, false, nil
+// labelEvaluator returns the evaluator that folds the label expressions
+// of labelMapping with the built-in functions and the given ones.
+func labelEvaluator(labelMapping LabelMapping, functions map[string]ConstFunc) *ConstEvaluator {
+	evaluator := NewConstEvaluator()
+	evaluator.WhereBindings = labelMapping.FromWhere
+	evaluator.DerivedTables = labelMapping.FromSubquery
+	for name, fn := range functions {
+		evaluator.Register(name, fn)
 	}
+	return evaluator
+}
 
-	if pointID == nil || pointType == nil {
-		return "", nil, false, fmt.Errorf("missing required point columns: point_id=%t point_type=%t", pointID != nil, pointType != nil)
+// findLabelLiteral returns the label literal selected by sel as
+// labelExpr, or why it couldn't be determined.
+func findLabelLiteral(sel *Select, labelExpr Expr, labelMapping LabelMapping, functions map[string]ConstFunc, ctes map[string]*CommonTableExpr) (string, LabelSource, error) {
+	if labelExpr == nil {
+		return "", "", fmt.Errorf("%s is not selected", labelMapping.Column)
 	}
-
-	literal, err := extractStringLiteral(pointType.Expr)
+	evaluator := labelEvaluator(labelMapping, functions)
+	evaluator.firstBinding = !labelMapping.Enumerate
+	value, err := evaluator.evalIn(labelExpr, sel, ctes)
 	if err != nil {
-		if fallback, ok := findStringLiteralForAliasInSelect(sel, "point_type"); ok {
-			pointTypeLiteral = fallback
-		} else {
-			return "", nil, false, err
-		}
-	} else {
-		pointTypeLiteral = literal
+		return "", "", err
 	}
-
-	pointType.As = NewColIdent("label")
-
-	pointIDExpr := &AliasedExpr{
-		Expr: ensureStringCast(pointID.Expr),
-		As:   NewColIdent("id"),
+	if value.Null || value.Value == "" {
+		return "", "", constError(labelExpr, "the label is empty")
 	}
-	selectExprs := SelectExprs{pointType, pointIDExpr}
 
-	for _, expr := range remaining {
-		selectExprs = append(selectExprs, expr)
-	}
-
-	sel.SelectExprs = selectExprs
-
-	if pointTypeLiteral == "" {
-		return "", nil, false, fmt.Errorf("point sql missing literal point_type column")
-	}
-	if err := applyTypeAnnotations(sel.SelectExprs, typeMap[pointTypeLiteral]); err != nil {
-		return "", nil, false, err
+	source := LabelFromEvaluation
+	switch labelExpr.(type) {
+	case *SQLVal:
+		source = LabelFromLiteral
+	case *ColName:
+		if evaluator.usedDerivedTable {
+			source = LabelFromDerivedTable
+		} else {
+			source = LabelFromWhere
+		}
 	}
-
-	return pointTypeLiteral, []string{"id", "label"}, true, nil
+	return value.Value, source, nil
 }
 
-func finalizeRewriteGroup(results []*rewriteResult) (Statement, error) {
+func finalizeRewriteGroup(results []*rewriteResult, policy *DedupPolicy, typeMap map[string]string, catalog *Catalog) (*Select, []ProjectionPadding, error) {
 	dedupCols := results[0].dedupColumns
 
 	for _, res := range results {
 		if !stringSlicesEqual(res.dedupColumns, dedupCols) {
-			return nil, fmt.Errorf("inconsistent dedup columns within rewrite group")
+			return nil, nil, newRewriteError(ErrInconsistentDedup, "", "a label can't be used by both points and edges",
+				"inconsistent dedup columns within rewrite group")
 		}
 	}
 
+	paddings, err := alignUnionBranches(results, typeMap, catalog)
+	if err != nil {
+		return nil, nil, err
+	}
 	unionStmt, projection, ok := buildUnionForResults(results)
 	if !ok {
-		return nil, fmt.Errorf("build union statement failed")
+		return nil, nil, fmt.Errorf("build union statement failed")
 	}
 	outerSelect := &Select{
 		SelectExprs: projection,
@@ -409,8 +743,10 @@ func finalizeRewriteGroup(results []*rewriteResult) (Statement, error) {
 			},
 		},
 	}
-	applyDeduplication(outerSelect, columnNamesToExprs(dedupCols))
-	return outerSelect, nil
+	if err := dedupe(outerSelect, dedupCols, policy); err != nil {
+		return nil, nil, err
+	}
+	return outerSelect, paddings, nil
 }
 
 func buildUnionForResults(results []*rewriteResult) (SelectStatement, SelectExprs, bool) {
@@ -499,7 +835,21 @@ func stringSlicesEqual(a, b []string) bool {
 	return true
 }
 
-func applyTypeAnnotations(selectExprs SelectExprs, typeMap map[string]string) error {
+// mappedType returns the type typeMap gives column, matching its name
+// case-insensitively as Hive does.
+func mappedType(typeMap map[string]string, column string) (string, bool) {
+	if typ, ok := typeMap[column]; ok {
+		return typ, true
+	}
+	for name, typ := range typeMap {
+		if strings.EqualFold(name, column) {
+			return typ, true
+		}
+	}
+	return "", false
+}
+
+func applyTypeAnnotations(selectExprs SelectExprs, typeMap map[string]string, trace *SelectTrace) error {
 	if len(typeMap) == 0 {
 		return nil
 	}
@@ -510,11 +860,17 @@ func applyTypeAnnotations(selectExprs SelectExprs, typeMap map[string]string) er
 		}
 
 		name := aliasOrColumnName(aliased)
-		targetType, ok := typeMap[strings.ToLower(name)]
+		targetType, ok := mappedType(typeMap, name)
 		if !ok {
 			continue
 		}
 
+		convertType, err := ParseConvertType(targetType)
+		if err != nil {
+			return newRewriteError(ErrInvalidType, name, "use a Hive type such as string, bigint, double, array<string> or map<string,double>",
+				"column %s: %w", name, err)
+		}
+
 		baseExpr := aliased.Expr
 		if convert, ok := baseExpr.(*ConvertExpr); ok {
 			baseExpr = convert.Expr
@@ -522,14 +878,15 @@ func applyTypeAnnotations(selectExprs SelectExprs, typeMap map[string]string) er
 
 		aliased.Expr = &ConvertExpr{
 			Expr: baseExpr,
-			Type: &ConvertType{Type: targetType},
+			Type: convertType,
 			Cast: true,
 		}
+		trace.addCast(name, String(convertType, false), "type map")
 	}
 	return nil
 }
 
-func applyDeduplication(sel *Select, partitionExprs Exprs) {
+func applyDeduplication(sel *Select, partitionExprs Exprs, orderBy OrderBy) {
 	if len(partitionExprs) == 0 {
 		return
 	}
@@ -557,11 +914,7 @@ func applyDeduplication(sel *Select, partitionExprs Exprs) {
 					Name: NewColIdent("row_number"),
 					Over: &WindowSpecification{
 						PartitionBy: partitionExprs,
-						OrderBy: OrderBy{
-							&Order{
-								Expr: NewIntVal([]byte("1")),
-							},
-						},
+						OrderBy:     orderBy,
 					},
 				},
 				As: NewColIdent("rn"),
@@ -596,87 +949,9 @@ func extractStringLiteral(expr Expr) (string, error) {
 	return string(sqlVal.Val), nil
 }
 
-func findStringLiteralForAliasInSelect(sel *Select, alias string) (string, bool) {
-	for _, expr := range sel.SelectExprs {
-		aliased, ok := expr.(*AliasedExpr)
-		if !ok {
-			continue
-		}
-		if !strings.EqualFold(aliasOrColumnName(aliased), alias) {
-			continue
-		}
-		if literal, err := extractStringLiteral(aliased.Expr); err == nil {
-			return literal, true
-		}
-	}
-
-	for _, tableExpr := range sel.From {
-		if literal, ok := findStringLiteralForAliasInTableExpr(tableExpr, alias); ok {
-			return literal, true
-		}
-	}
-
-	return "", false
-}
-
-func findStringLiteralForAliasInTableExpr(tableExpr TableExpr, alias string) (string, bool) {
-	switch expr := tableExpr.(type) {
-	case *AliasedTableExpr:
-		if subquery, ok := expr.Expr.(*Subquery); ok {
-			if literal, ok := findStringLiteralForAliasInSelectStatement(subquery.Select, alias); ok {
-				return literal, true
-			}
-		}
-	case *ParenTableExpr:
-		for _, innerExpr := range expr.Exprs {
-			if literal, ok := findStringLiteralForAliasInTableExpr(innerExpr, alias); ok {
-				return literal, true
-			}
-		}
-	case *JoinTableExpr:
-		if literal, ok := findStringLiteralForAliasInTableExpr(expr.LeftExpr, alias); ok {
-			return literal, true
-		}
-		if literal, ok := findStringLiteralForAliasInTableExpr(expr.RightExpr, alias); ok {
-			return literal, true
-		}
-	}
-	return "", false
-}
-
-func findStringLiteralForAliasInSelectStatement(stmt SelectStatement, alias string) (string, bool) {
-	switch s := stmt.(type) {
-	case *Select:
-		return findStringLiteralForAliasInSelect(s, alias)
-	case *Union:
-		if literal, ok := findStringLiteralForAliasInSelectStatement(s.Left, alias); ok {
-			return literal, true
-		}
-		return findStringLiteralForAliasInSelectStatement(s.Right, alias)
-	}
-	return "", false
-}
-
-func deriveEdgeTypeLiteralFromExpr(sel *Select, expr Expr) (string, bool) {
-	if sel == nil || sel.Where == nil || expr == nil {
-		return "", false
-	}
-
-	bindings := make(map[string]string)
-	collectStringColumnBindings(sel.Where.Expr, bindings)
-	if len(bindings) == 0 {
-		return "", false
-	}
-
-	literal, err := evaluateStringExpr(expr, bindings)
-	if err != nil || literal == "" {
-		return "", false
-	}
-
-	return literal, true
-}
-
-func collectStringColumnBindings(expr Expr, bindings map[string]string) {
+// collectStringColumnBindings records the string literals that the
+// conjunction expr binds a column to with = or IN.
+func collectStringColumnBindings(expr Expr, bindings map[string][]string) {
 	switch e := expr.(type) {
 	case *AndExpr:
 		collectStringColumnBindings(e.Left, bindings)
@@ -688,7 +963,7 @@ func collectStringColumnBindings(expr Expr, bindings map[string]string) {
 	}
 }
 
-func recordComparisonBinding(expr *ComparisonExpr, bindings map[string]string) {
+func recordComparisonBinding(expr *ComparisonExpr, bindings map[string][]string) {
 	if expr == nil {
 		return
 	}
@@ -703,15 +978,18 @@ func recordComparisonBinding(expr *ComparisonExpr, bindings map[string]string) {
 		if !ok {
 			return
 		}
+		literals := make([]string, 0, len(tuple))
 		for _, valExpr := range tuple {
-			if literal, err := extractStringLiteral(valExpr); err == nil {
-				recordStringBinding(col, literal, bindings)
-				break
+			literal, err := extractStringLiteral(valExpr)
+			if err != nil {
+				return
 			}
+			literals = append(literals, literal)
 		}
+		recordStringBinding(col, literals, bindings)
 	case EqualStr:
 		if col, literal, ok := resolveEqualityBinding(expr.Left, expr.Right); ok {
-			recordStringBinding(col, literal, bindings)
+			recordStringBinding(col, []string{literal}, bindings)
 		}
 	}
 }
@@ -730,61 +1008,38 @@ func resolveEqualityBinding(left Expr, right Expr) (*ColName, string, bool) {
 	return nil, "", false
 }
 
-func recordStringBinding(col *ColName, value string, bindings map[string]string) {
-	if col == nil || value == "" {
+func recordStringBinding(col *ColName, values []string, bindings map[string][]string) {
+	if col == nil {
 		return
 	}
 	key := col.Name.Lowered()
 	if key == "" {
 		return
 	}
-	if _, exists := bindings[key]; !exists {
-		bindings[key] = value
-	}
-}
-
-func evaluateStringExpr(expr Expr, bindings map[string]string) (string, error) {
-	switch e := expr.(type) {
-	case *SQLVal:
-		if e.Type != StrVal {
-			return "", fmt.Errorf("unsupported sql value type %d", e.Type)
+	var distinct []string
+	seen := make(map[string]bool, len(values))
+	for _, value := range values {
+		if value == "" {
+			return
 		}
-		return string(e.Val), nil
-	case *ColName:
-		key := e.Name.Lowered()
-		if key == "" {
-			return "", fmt.Errorf("column name is empty")
+		if !seen[value] {
+			seen[value] = true
+			distinct = append(distinct, value)
 		}
-		value, ok := bindings[key]
-		if !ok {
-			return "", fmt.Errorf("missing binding for column %s", key)
-		}
-		return value, nil
-	case *FuncExpr:
-		switch e.Name.Lowered() {
-		case "concat":
-			var builder strings.Builder
-			for _, arg := range e.Exprs {
-				aliased, ok := arg.(*AliasedExpr)
-				if !ok {
-					return "", fmt.Errorf("unsupported concat argument type %T", arg)
-				}
-				part, err := evaluateStringExpr(aliased.Expr, bindings)
-				if err != nil {
-					return "", err
-				}
-				builder.WriteString(part)
+	}
+	if bound, exists := bindings[key]; exists {
+		// A column bound twice, e.g. by IN and by =, can only take the
+		// values of both.
+		distinct = distinct[:0]
+		for _, value := range bound {
+			if seen[value] {
+				distinct = append(distinct, value)
 			}
-			return builder.String(), nil
-		default:
-			return "", fmt.Errorf("unsupported function %s", e.Name.String())
 		}
-	case *ConvertExpr:
-		return evaluateStringExpr(e.Expr, bindings)
-	case *ParenExpr:
-		return evaluateStringExpr(e.Expr, bindings)
 	}
-	return "", fmt.Errorf("unsupported expression type %T", expr)
+	if len(distinct) != 0 {
+		bindings[key] = distinct
+	}
 }
 
 func aliasOrColumnName(ae *AliasedExpr) string {
@@ -820,6 +1075,8 @@ func deriveAliasFromExpr(expr Expr) string {
 	switch e := expr.(type) {
 	case *ColName:
 		return e.Name.String()
+	case *FieldAccessExpr:
+		return e.Field.String()
 	case *ConvertExpr:
 		return deriveAliasFromExpr(e.Expr)
 	case *ParenExpr:
@@ -0,0 +1,221 @@
+package sqlparser
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// RewriteErrorCode classifies a RewriteError.
+type RewriteErrorCode string
+
+// RewriteErrorCode values.
+const (
+	ErrSyntax               RewriteErrorCode = "syntax_error"
+	ErrUnsupportedStmt      RewriteErrorCode = "unsupported_statement"
+	ErrUnrecognizedColumns  RewriteErrorCode = "unrecognized_columns"
+	ErrMissingColumns       RewriteErrorCode = "missing_columns"
+	ErrMissingLabel         RewriteErrorCode = "missing_label"
+	ErrLabelMismatch        RewriteErrorCode = "label_mismatch"
+	ErrInconsistentDedup    RewriteErrorCode = "inconsistent_dedup"
+	ErrInvalidType          RewriteErrorCode = "invalid_type"
+	ErrInvalidDedup         RewriteErrorCode = "invalid_dedup"
+	ErrUnresolvedProjection RewriteErrorCode = "unresolved_projection"
+	ErrDuplicateColumn      RewriteErrorCode = "duplicate_column"
+	ErrTypeConflict         RewriteErrorCode = "type_conflict"
+	ErrInvalidReverse       RewriteErrorCode = "invalid_reverse"
+	ErrUnknownLabel         RewriteErrorCode = "unknown_label"
+	ErrUnknownColumn        RewriteErrorCode = "unknown_column"
+	ErrKeyColumnType        RewriteErrorCode = "key_column_type"
+	ErrRewriteFailed        RewriteErrorCode = "rewrite_failed"
+)
+
+// RewriteError describes why a statement of a script couldn't be
+// rewritten.
+type RewriteError struct {
+	Code    RewriteErrorCode `json:"code"`
+	Message string           `json:"message"`
+	Hint    string           `json:"hint,omitempty"`
+	// Statement is the failing statement.
+	Statement StatementSource `json:"statement"`
+	// Start and End are the byte range in the script of the offending
+	// column or token, or of the statement when it can't be narrowed down.
+	// Columns are found by name, so the range is approximate.
+	Start int `json:"start"`
+	End   int `json:"end"`
+	// Line and Column are the 1-based position of Start.
+	Line   int `json:"line"`
+	Column int `json:"column"`
+
+	// near is the text of the offending column or literal, used to
+	// locate it in the statement.
+	near string
+	// source, if set, overrides the statement the error is located in.
+	source *StatementSource
+	err    error
+}
+
+func newRewriteError(code RewriteErrorCode, near, hint, format string, args ...interface{}) *RewriteError {
+	err := fmt.Errorf(format, args...)
+	return &RewriteError{
+		Code:    code,
+		Message: err.Error(),
+		Hint:    hint,
+		near:    near,
+		err:     errors.Unwrap(err),
+	}
+}
+
+// Error implements the error interface. Statements are numbered from 1.
+func (e *RewriteError) Error() string {
+	return fmt.Sprintf("statement %d (line %d:%d): %s", e.Statement.Index+1, e.Line, e.Column, e.Message)
+}
+
+// Unwrap returns the underlying error, if any.
+func (e *RewriteError) Unwrap() error {
+	return e.err
+}
+
+// RewriteErrors is returned when RewriteOptions.CollectErrors is set
+// and one or more statements failed.
+type RewriteErrors []*RewriteError
+
+// Error implements the error interface.
+func (errs RewriteErrors) Error() string {
+	messages := make([]string, 0, len(errs))
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	return fmt.Sprintf("%d statements failed to rewrite:\n%s", len(errs), strings.Join(messages, "\n"))
+}
+
+// sourcedRewriteError locates err in the given statement rather than
+// in the statement that toRewriteError is given.
+func sourcedRewriteError(source StatementSource, err *RewriteError) *RewriteError {
+	err.source = &source
+	return err
+}
+
+// toRewriteError converts err into a RewriteError located in the given
+// statement of sql. The AST doesn't record positions, so the error is
+// narrowed down to the first token of the statement that reads as the
+// offending column or literal. Comments are skipped, but the position is
+// approximate: an alias that is also used earlier in the statement, e.g.
+// in a derived table, is located there.
+func toRewriteError(err error, sql string, source StatementSource) *RewriteError {
+	var rewriteErr *RewriteError
+	if !errors.As(err, &rewriteErr) {
+		rewriteErr = &RewriteError{
+			Code:    ErrRewriteFailed,
+			Message: err.Error(),
+			err:     err,
+		}
+	}
+	if rewriteErr.source != nil {
+		source = *rewriteErr.source
+	}
+	rewriteErr.Statement = source
+	rewriteErr.Start, rewriteErr.End = source.Start, source.End
+	if i := indexToken(sql[source.Start:source.End], rewriteErr.near); i >= 0 {
+		rewriteErr.Start = source.Start + i
+		rewriteErr.End = rewriteErr.Start + len(rewriteErr.near)
+	}
+	rewriteErr.Line, rewriteErr.Column = lineColumn(sql, rewriteErr.Start)
+	return rewriteErr
+}
+
+var syntaxErrorPosition = regexp.MustCompile(`at position (\d+)(?: near '(.*)')?$`)
+
+// syntaxError converts a parse error of the given statement of sql.
+func syntaxError(err error, sql string, source StatementSource) *RewriteError {
+	rewriteErr := &RewriteError{
+		Code:      ErrSyntax,
+		Message:   err.Error(),
+		Hint:      "fix the syntax of the statement; statements are separated by semicolons",
+		Statement: source,
+		Start:     source.Start,
+		End:       source.End,
+		err:       err,
+	}
+	if match := syntaxErrorPosition.FindStringSubmatch(err.Error()); match != nil {
+		// The tokenizer reports the position just past the token
+		// it failed on.
+		end, _ := strconv.Atoi(match[1])
+		end--
+		if end > len(sql) {
+			end = len(sql)
+		}
+		start := end - len(match[2])
+		if start < 0 {
+			start = 0
+		}
+		rewriteErr.Start, rewriteErr.End = start, end
+	}
+	rewriteErr.Line, rewriteErr.Column = lineColumn(sql, rewriteErr.Start)
+	return rewriteErr
+}
+
+// indexToken returns the index of the first identifier or literal token
+// of the statement s that reads as word, case-insensitively, or -1.
+func indexToken(s, word string) int {
+	if word == "" {
+		return -1
+	}
+	tokenizer := NewStringTokenizer(s)
+	for {
+		start := tokenizer.Position - 1
+		if start < 0 {
+			start = 0
+		}
+		typ, val := tokenizer.Scan()
+		switch typ {
+		case 0, LEX_ERROR:
+			return -1
+		case COMMENT:
+			continue
+		}
+		if !strings.EqualFold(string(val), word) {
+			continue
+		}
+		if i := indexWord(s[start:tokenizer.Position-1], word); i >= 0 {
+			return start + i
+		}
+	}
+}
+
+// indexWord returns the index of the first case-insensitive occurrence of
+// word in s that isn't part of a longer identifier, or -1.
+func indexWord(s, word string) int {
+	if word == "" {
+		return -1
+	}
+	lowered, word := strings.ToLower(s), strings.ToLower(word)
+	for offset := 0; ; {
+		i := strings.Index(lowered[offset:], word)
+		if i < 0 {
+			return -1
+		}
+		i += offset
+		end := i + len(word)
+		if (i == 0 || !isIdentifierByte(lowered[i-1])) && (end == len(lowered) || !isIdentifierByte(lowered[end])) {
+			return i
+		}
+		offset = i + 1
+	}
+}
+
+func isIdentifierByte(ch byte) bool {
+	return isLetter(uint16(ch)) || isDigit(uint16(ch))
+}
+
+// lineColumn returns the 1-based line and column of offset in sql.
+func lineColumn(sql string, offset int) (int, int) {
+	if offset > len(sql) {
+		offset = len(sql)
+	}
+	before := sql[:offset]
+	line := strings.Count(before, "\n") + 1
+	return line, offset - strings.LastIndexByte(before, '\n')
+}
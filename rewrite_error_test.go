@@ -0,0 +1,117 @@
+package sqlparser
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+const rewriteErrorScript = `SELECT src AS point1_id, tgt AS point2_id, 'shop' AS point1_type, 'sim' AS point2_type, 'shop_sim' AS edge_type FROM t1;
+SELECT src AS point1_id,
+       'shop' AS point1_type,
+       'sim' AS point2_type,
+       'shop_sim' AS edge_type
+FROM   t2;
+SELECT FROM t3;
+SELECT 'leaf' AS point_type, id AS point_id, tags FROM t4;
+SELECT src AS point1_id, tgt AS point2_id, 'shop' AS point1_type, 'sim' AS point2_type, kind AS edge_type FROM t5`
+
+func TestRewriteError(t *testing.T) {
+	_, err := RewriteSqlsOrdered(rewriteErrorScript)
+	var rewriteErr *RewriteError
+	if !errors.As(err, &rewriteErr) {
+		t.Fatalf("RewriteSqlsOrdered err: %v, want a *RewriteError", err)
+	}
+	want := RewriteError{
+		Code:      ErrMissingColumns,
+		Message:   "missing required edge columns: point2_id",
+		Hint:      "add point2_id to the select list",
+		Statement: StatementSource{Index: 1, Start: 121, End: 245},
+		Start:     135,
+		End:       144,
+		Line:      2,
+		Column:    15,
+	}
+	if got := *rewriteErr; got.Code != want.Code || got.Message != want.Message || got.Hint != want.Hint ||
+		got.Statement != want.Statement || got.Start != want.Start || got.End != want.End ||
+		got.Line != want.Line || got.Column != want.Column {
+		t.Errorf("RewriteError: %+v, want %+v", got, want)
+	}
+	if got := rewriteErrorScript[rewriteErr.Start:rewriteErr.End]; got != "point1_id" {
+		t.Errorf("located %q, want point1_id", got)
+	}
+	if got, want := err.Error(), "statement 2 (line 2:15): missing required edge columns: point2_id"; got != want {
+		t.Errorf("Error(): %s, want %s", got, want)
+	}
+}
+
+func TestRewriteErrorsCollected(t *testing.T) {
+	results, err := RewriteSqlsOrdered(rewriteErrorScript, WithCollectErrors(true), WithTypeMap(map[string]map[string]string{
+		"leaf": {"tags": "map<string>"},
+	}))
+	var rewriteErrs RewriteErrors
+	if !errors.As(err, &rewriteErrs) {
+		t.Fatalf("RewriteSqlsOrdered err: %v, want RewriteErrors", err)
+	}
+	want := []struct {
+		code   RewriteErrorCode
+		index  int
+		line   int
+		column int
+	}{
+		{ErrMissingColumns, 1, 2, 15},
+		{ErrSyntax, 2, 7, 8},
+		{ErrInvalidType, 3, 8, 46},
+		{ErrMissingLabel, 4, 9, 97},
+	}
+	if len(rewriteErrs) != len(want) {
+		t.Fatalf("got %d errors, want %d: %v", len(rewriteErrs), len(want), err)
+	}
+	for i, w := range want {
+		got := rewriteErrs[i]
+		if got.Code != w.code || got.Statement.Index != w.index || got.Line != w.line || got.Column != w.column {
+			t.Errorf("error %d: %s %d %d:%d, want %s %d %d:%d", i, got.Code, got.Statement.Index, got.Line, got.Column, w.code, w.index, w.line, w.column)
+		}
+		if got.Hint == "" {
+			t.Errorf("error %d: no hint", i)
+		}
+	}
+	if !strings.HasPrefix(err.Error(), "4 statements failed to rewrite:\nstatement 2 (line 2:15): ") {
+		t.Errorf("Error(): %s", err.Error())
+	}
+	if len(results) != 1 || results[0].Label != "shop_sim" {
+		t.Errorf("expected the statements that rewrote to be returned, got %v", results)
+	}
+}
+
+func TestIndexToken(t *testing.T) {
+	testcases := []struct {
+		in, word string
+		out      int
+	}{{
+		in:   "select a as point1_id from t",
+		word: "point1_id",
+		out:  12,
+	}, {
+		in:   "select /* point1_id */ a as `Point1_ID` -- point1_id\nfrom t",
+		word: "point1_id",
+		out:  29,
+	}, {
+		in:   "select 'shop' as point_type from t",
+		word: "shop",
+		out:  8,
+	}, {
+		in:   "select point1_idx, a.point1_id from a",
+		word: "point1_id",
+		out:  21,
+	}, {
+		in:   "select a from t # point1_id",
+		word: "point1_id",
+		out:  -1,
+	}}
+	for _, tcase := range testcases {
+		if got := indexToken(tcase.in, tcase.word); got != tcase.out {
+			t.Errorf("indexToken(%q, %s): %d, want %d", tcase.in, tcase.word, got, tcase.out)
+		}
+	}
+}
@@ -1356,7 +1356,7 @@ FROM    product_sim t1
 JOIN    valid_product t2
 ON      t1.product_id = CAST(t2.prod_id AS STRING)`
 
-	rewritten, err := RewriteSqls(sql)
+	rewritten, err := RewriteSqls(sql, WithReplaceMaxPt(true))
 	if err != nil {
 		t.Fatalf("RewriteSqls error: %v", err)
 	}
@@ -1374,3 +1374,229 @@ ON      t1.product_id = CAST(t2.prod_id AS STRING)`
 		t.Fatalf("expected 4 date placeholders, got %d in %s", got, def.Sql)
 	}
 }
+
+func TestRewriteSqlsOutputReparses(t *testing.T) {
+	rewritten, err := RewriteSqls(`SELECT  src AS point1_id,
+        tgt AS point2_id,
+        'shop' AS point1_type,
+        'sim' AS point2_type,
+        (UNIX_TIMESTAMP() * 1000000) AS ts_us,
+        'shop_sim' AS edge_type,
+        row_number() OVER (PARTITION BY src ORDER BY ratio_src DESC) AS src_rank
+FROM    dm_temai.shop_gandalf_v1_3_graph_structure_di
+WHERE   date = max_pt('dm_temai.shop_gandalf_v1_3_graph_structure_di')`)
+	if err != nil {
+		t.Fatalf("RewriteSqls error: %v", err)
+	}
+
+	def, ok := rewritten["shop_sim"]
+	if !ok {
+		t.Fatalf("expected rewritten sql for shop_sim edge type")
+	}
+	stmt, err := Parse(def.Sql)
+	if err != nil {
+		t.Fatalf("Parse(%q) error: %v", def.Sql, err)
+	}
+	var windows int
+	_ = Walk(func(node SQLNode) (bool, error) {
+		if fn, ok := node.(*FuncExpr); ok && fn.Over != nil {
+			windows++
+		}
+		return true, nil
+	}, stmt)
+	if windows != 2 {
+		t.Fatalf("expected 2 window functions after reparse, got %d in %s", windows, def.Sql)
+	}
+}
+
+func TestRewriteEdgeSqlLateralView(t *testing.T) {
+	rewritten, err := RewriteSqls(`SELECT  shop_id AS point1_id,
+        nid AS point2_id,
+        'shop' AS point1_type,
+        'shop' AS point2_type,
+        'shop_neighbor' AS edge_type
+FROM    dm_temai.shop_neighbors
+LATERAL VIEW explode(split(neighbor_ids, ',')) x AS nid
+WHERE   date = '${date}'`)
+	if err != nil {
+		t.Fatalf("RewriteSqls error: %v", err)
+	}
+
+	def, ok := rewritten["shop_neighbor"]
+	if !ok {
+		t.Fatalf("expected rewritten sql for shop_neighbor edge type")
+	}
+	if !strings.Contains(def.Sql, "lateral view explode(split(neighbor_ids, \",\")) x as nid") {
+		t.Fatalf("expected lateral view to be preserved, got %s", def.Sql)
+	}
+}
+
+func TestRewriteSqlsInsertOverwrite(t *testing.T) {
+	rewritten, err := RewriteSqls(`INSERT OVERWRITE TABLE dm_temai.shop_graph PARTITION (date = '${date}', edge_type)
+SELECT  src AS point1_id,
+        tgt AS point2_id,
+        'shop' AS point1_type,
+        'sim' AS point2_type,
+        'shop_sim' AS edge_type
+FROM    dm_temai.shop_sim_di
+WHERE   date = '${date}'`)
+	if err != nil {
+		t.Fatalf("RewriteSqls error: %v", err)
+	}
+
+	def, ok := rewritten["shop_sim"]
+	if !ok {
+		t.Fatalf("expected rewritten sql for shop_sim edge type")
+	}
+	if strings.Contains(def.Sql, "insert") {
+		t.Fatalf("expected only the source query to be rewritten, got %s", def.Sql)
+	}
+}
+
+func TestRewriteSqlsNestedTypeMap(t *testing.T) {
+	sql := `SELECT  src AS point1_id,
+        tgt AS point2_id,
+        'shop' AS point1_type,
+        'sim' AS point2_type,
+        tags,
+        'shop_sim' AS edge_type
+FROM    dm_temai.shop_sim_di
+WHERE   date = '${date}'`
+	rewritten, err := RewriteSqls(sql, WithTypeMap(map[string]map[string]string{
+		"shop_sim": {
+			"tags": "map<string, array<decimal(10,2)>>",
+		},
+	}))
+	if err != nil {
+		t.Fatalf("RewriteSqls error: %v", err)
+	}
+	def, ok := rewritten["shop_sim"]
+	if !ok {
+		t.Fatalf("expected rewritten sql for shop_sim edge type")
+	}
+	if !strings.Contains(def.Sql, "cast(tags as map<string,array<decimal(10, 2)>>)") {
+		t.Fatalf("expected nested cast, got %s", def.Sql)
+	}
+
+	_, err = RewriteSqls(sql, WithTypeMap(map[string]map[string]string{
+		"shop_sim": {
+			"tags": "map<string>",
+		},
+	}))
+	if err == nil || !strings.Contains(err.Error(), `column tags: invalid type "map<string>"`) {
+		t.Fatalf("expected invalid type error, got %v", err)
+	}
+}
+
+func TestRewriteSqlsFieldAccessAlias(t *testing.T) {
+	rewritten, err := RewriteSqls(`SELECT  s.src AS point1_id,
+        s.tgt AS point2_id,
+        'shop' AS point1_type,
+        'sim' AS point2_type,
+        s.props.weight,
+        'shop_sim' AS edge_type
+FROM    dm_temai.shop_sim_di s
+WHERE   date = '${date}'`, WithTypeMap(map[string]map[string]string{
+		"shop_sim": {
+			"weight": "double",
+		},
+	}))
+	if err != nil {
+		t.Fatalf("RewriteSqls error: %v", err)
+	}
+	def, ok := rewritten["shop_sim"]
+	if !ok {
+		t.Fatalf("expected rewritten sql for shop_sim edge type")
+	}
+	if !strings.HasPrefix(def.Sql, "select outv_pk_prop, bg__id, outv_label, bg__bg__label, weight, label from") {
+		t.Fatalf("expected weight to be projected by its field name, got %s", def.Sql)
+	}
+	if !strings.Contains(def.Sql, "cast(s.props.weight as double)") {
+		t.Fatalf("expected weight to be typed, got %s", def.Sql)
+	}
+}
+
+func TestRewriteSqlsOrdered(t *testing.T) {
+	sql := `SELECT src AS point1_id, tgt AS point2_id, 'shop' AS point1_type, 'sim' AS point2_type, 'shop_sim' AS edge_type FROM t1;
+SELECT 'leaf' AS point_type, id AS point_id FROM t2 ;
+
+  SELECT src AS point1_id, tgt AS point2_id, 'shop' AS point1_type, 'sim' AS point2_type, 'shop_sim' AS edge_type FROM t3
+  UNION ALL
+  SELECT src AS point1_id, tgt AS point2_id, 'shop' AS point1_type, 'sim' AS point2_type, 'shop_sim' AS edge_type FROM t4;
+SELECT 'author' AS point_type, id AS point_id FROM t5`
+	results, err := RewriteSqlsOrdered(sql)
+	if err != nil {
+		t.Fatalf("RewriteSqlsOrdered error: %v", err)
+	}
+	var labels []string
+	for _, result := range results {
+		labels = append(labels, result.Label)
+	}
+	if want := []string{"shop_sim", "leaf", "author"}; !stringSlicesEqual(labels, want) {
+		t.Fatalf("labels: %v, want %v", labels, want)
+	}
+
+	sources := results[0].Sources
+	if len(sources) != 2 || sources[0].Index != 0 || sources[1].Index != 2 {
+		t.Fatalf("shop_sim sources: %+v, want statements 0 and 2", sources)
+	}
+	if got := sql[sources[0].Start:sources[0].End]; !strings.HasPrefix(got, "SELECT src") || !strings.HasSuffix(got, "FROM t1") {
+		t.Errorf("statement 0 source: %q", got)
+	}
+	if got := sql[sources[1].Start:sources[1].End]; !strings.HasPrefix(got, "SELECT src") || !strings.HasSuffix(got, "FROM t4") {
+		t.Errorf("statement 2 source: %q", got)
+	}
+	if got := sql[results[1].Sources[0].Start:results[1].Sources[0].End]; got != "SELECT 'leaf' AS point_type, id AS point_id FROM t2" {
+		t.Errorf("statement 1 source: %q", got)
+	}
+	if got := sql[results[2].Sources[0].Start:results[2].Sources[0].End]; got != "SELECT 'author' AS point_type, id AS point_id FROM t5" {
+		t.Errorf("statement 3 source: %q", got)
+	}
+	if from := strings.Index(results[0].Sql, "from t1"); from < 0 || from > strings.Index(results[0].Sql, "from t3") {
+		t.Errorf("expected statements to be combined in source order, got %s", results[0].Sql)
+	}
+
+	for i := 0; i < 10; i++ {
+		again, err := RewriteSqlsOrdered(sql)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for j := range again {
+			if again[j].Label != results[j].Label || again[j].Sql != results[j].Sql {
+				t.Fatalf("run %d differs: %s vs %s", i, again[j].Label, results[j].Label)
+			}
+		}
+	}
+}
+
+func TestRewriteSqlsQuoting(t *testing.T) {
+	sql := `SELECT  /* shop's neighbours */ src AS point1_id,
+        tgt AS point2_id,
+        'shop' AS point1_type,
+        'sim' AS point2_type,
+        'shop_sim' AS edge_type,
+        'O''Brien "Bob" \\ 中文' AS note
+FROM    t`
+	rewritten, err := RewriteSqls(sql)
+	if err != nil {
+		t.Fatalf("RewriteSqls error: %v", err)
+	}
+	def := rewritten["shop_sim"]
+	for _, part := range []string{
+		`/* shop's neighbours */`,
+		`"sim" as bg__bg__label`,
+		`"O'Brien \"Bob\" \\ 中文" as note`,
+	} {
+		if !strings.Contains(def.Sql, part) {
+			t.Errorf("expected %s in %s", part, def.Sql)
+		}
+	}
+
+	rewritten, err = RewriteSqls(sql, WithQuoteMode(SingleQuote))
+	if err != nil {
+		t.Fatalf("RewriteSqls error: %v", err)
+	}
+	if part := `'O\'Brien \"Bob\" \\ 中文' as note`; !strings.Contains(rewritten["shop_sim"].Sql, part) {
+		t.Errorf("expected %s in %s", part, rewritten["shop_sim"].Sql)
+	}
+}
@@ -31,6 +31,12 @@ func decNesting(yylex interface{}) {
 	yylex.(*Tokenizer).nesting--
 }
 
+// openType tells the lexer that the next '<' opens the parameters of
+// a complex type.
+func openType(yylex interface{}) {
+	yylex.(*Tokenizer).openingType = true
+}
+
 // forceEOF forces the lexer to end prematurely. Not all SQL statements
 // are supported by the Parser, thus calling forceEOF will make the lexer
 // return EOF early.
@@ -38,7 +44,7 @@ func forceEOF(yylex interface{}) {
 	yylex.(*Tokenizer).ForceEOF = true
 }
 
-//line sql.y:53
+//line sql.y:59
 type yySymType struct {
 	yys               int
 	empty             struct{}
@@ -102,238 +108,290 @@ type yySymType struct {
 	vindexParam       VindexParam
 	vindexParams      []VindexParam
 	showFilter        *ShowFilter
+	windowSpec        *WindowSpecification
+	windowFrame       *WindowFrame
+	frameBound        *FrameBound
+	namedWindow       *NamedWindow
+	namedWindows      NamedWindows
+	lateralView       *LateralView
+	lateralViews      LateralViews
+	partVal           *PartitionValue
+	partVals          PartitionValues
+	columnDefinitions []*ColumnDefinition
+	clusteredBy       *ClusteredBy
+	rowFormat         *RowFormat
+	storedAs          *StoredAs
+	tableProps        TableProperties
+	tableProp         *TableProperty
+	structField       *StructField
+	structFields      []*StructField
 }
 
 const LEX_ERROR = 57346
 const UNION = 57347
-const SELECT = 57348
-const STREAM = 57349
-const INSERT = 57350
-const UPDATE = 57351
-const DELETE = 57352
-const FROM = 57353
-const WHERE = 57354
-const GROUP = 57355
-const HAVING = 57356
-const ORDER = 57357
-const BY = 57358
-const LIMIT = 57359
-const OFFSET = 57360
-const FOR = 57361
-const ALL = 57362
-const DISTINCT = 57363
-const AS = 57364
-const EXISTS = 57365
-const ASC = 57366
-const DESC = 57367
-const INTO = 57368
-const DUPLICATE = 57369
-const KEY = 57370
-const DEFAULT = 57371
-const SET = 57372
-const LOCK = 57373
-const KEYS = 57374
-const VALUES = 57375
-const LAST_INSERT_ID = 57376
-const NEXT = 57377
-const VALUE = 57378
-const SHARE = 57379
-const MODE = 57380
-const SQL_NO_CACHE = 57381
-const SQL_CACHE = 57382
-const JOIN = 57383
-const STRAIGHT_JOIN = 57384
-const LEFT = 57385
-const RIGHT = 57386
-const INNER = 57387
-const OUTER = 57388
-const CROSS = 57389
-const NATURAL = 57390
-const USE = 57391
-const FORCE = 57392
-const ON = 57393
-const USING = 57394
-const ID = 57395
-const HEX = 57396
-const STRING = 57397
-const STRINGKW = 57398
-const INTEGRAL = 57399
-const FLOAT = 57400
-const HEXNUM = 57401
-const VALUE_ARG = 57402
-const LIST_ARG = 57403
-const COMMENT = 57404
-const COMMENT_KEYWORD = 57405
-const BIT_LITERAL = 57406
-const NULL = 57407
-const TRUE = 57408
-const FALSE = 57409
-const OR = 57410
-const AND = 57411
-const NOT = 57412
-const BETWEEN = 57413
-const CASE = 57414
-const WHEN = 57415
-const THEN = 57416
-const ELSE = 57417
-const END = 57418
-const LE = 57419
-const GE = 57420
-const NE = 57421
-const NULL_SAFE_EQUAL = 57422
-const IS = 57423
-const LIKE = 57424
-const REGEXP = 57425
-const IN = 57426
-const SHIFT_LEFT = 57427
-const SHIFT_RIGHT = 57428
-const DIV = 57429
-const MOD = 57430
-const UNARY = 57431
-const COLLATE = 57432
-const BINARY = 57433
-const UNDERSCORE_BINARY = 57434
-const INTERVAL = 57435
-const JSON_EXTRACT_OP = 57436
-const JSON_UNQUOTE_EXTRACT_OP = 57437
-const CREATE = 57438
-const ALTER = 57439
-const DROP = 57440
-const RENAME = 57441
-const ANALYZE = 57442
-const ADD = 57443
-const SCHEMA = 57444
-const TABLE = 57445
-const INDEX = 57446
-const VIEW = 57447
-const TO = 57448
-const IGNORE = 57449
-const IF = 57450
-const UNIQUE = 57451
-const PRIMARY = 57452
-const COLUMN = 57453
-const CONSTRAINT = 57454
-const SPATIAL = 57455
-const FULLTEXT = 57456
-const FOREIGN = 57457
-const KEY_BLOCK_SIZE = 57458
-const SHOW = 57459
-const DESCRIBE = 57460
-const EXPLAIN = 57461
-const DATE = 57462
-const ESCAPE = 57463
-const REPAIR = 57464
-const OPTIMIZE = 57465
-const TRUNCATE = 57466
-const MAXVALUE = 57467
-const PARTITION = 57468
-const REORGANIZE = 57469
-const LESS = 57470
-const THAN = 57471
-const PROCEDURE = 57472
-const TRIGGER = 57473
-const VINDEX = 57474
-const VINDEXES = 57475
-const STATUS = 57476
-const VARIABLES = 57477
-const BEGIN = 57478
-const START = 57479
-const TRANSACTION = 57480
-const COMMIT = 57481
-const ROLLBACK = 57482
-const BIT = 57483
-const TINYINT = 57484
-const SMALLINT = 57485
-const MEDIUMINT = 57486
-const INT = 57487
-const INTEGER = 57488
-const BIGINT = 57489
-const INTNUM = 57490
-const REAL = 57491
-const DOUBLE = 57492
-const FLOAT_TYPE = 57493
-const DECIMAL = 57494
-const NUMERIC = 57495
-const TIME = 57496
-const TIMESTAMP = 57497
-const DATETIME = 57498
-const YEAR = 57499
-const CHAR = 57500
-const VARCHAR = 57501
-const BOOL = 57502
-const CHARACTER = 57503
-const VARBINARY = 57504
-const NCHAR = 57505
-const TEXT = 57506
-const TINYTEXT = 57507
-const MEDIUMTEXT = 57508
-const LONGTEXT = 57509
-const BLOB = 57510
-const TINYBLOB = 57511
-const MEDIUMBLOB = 57512
-const LONGBLOB = 57513
-const JSON = 57514
-const ENUM = 57515
-const GEOMETRY = 57516
-const POINT = 57517
-const LINESTRING = 57518
-const POLYGON = 57519
-const GEOMETRYCOLLECTION = 57520
-const MULTIPOINT = 57521
-const MULTILINESTRING = 57522
-const MULTIPOLYGON = 57523
-const NULLX = 57524
-const AUTO_INCREMENT = 57525
-const APPROXNUM = 57526
-const SIGNED = 57527
-const UNSIGNED = 57528
-const ZEROFILL = 57529
-const DATABASES = 57530
-const TABLES = 57531
-const VITESS_KEYSPACES = 57532
-const VITESS_SHARDS = 57533
-const VITESS_TABLETS = 57534
-const VSCHEMA_TABLES = 57535
-const EXTENDED = 57536
-const FULL = 57537
-const PROCESSLIST = 57538
-const NAMES = 57539
-const CHARSET = 57540
-const GLOBAL = 57541
-const SESSION = 57542
-const ISOLATION = 57543
-const LEVEL = 57544
-const READ = 57545
-const WRITE = 57546
-const ONLY = 57547
-const REPEATABLE = 57548
-const COMMITTED = 57549
-const UNCOMMITTED = 57550
-const SERIALIZABLE = 57551
-const CURRENT_TIMESTAMP = 57552
-const DATABASE = 57553
-const CURRENT_DATE = 57554
-const CURRENT_TIME = 57555
-const LOCALTIME = 57556
-const LOCALTIMESTAMP = 57557
-const UTC_DATE = 57558
-const UTC_TIME = 57559
-const UTC_TIMESTAMP = 57560
-const REPLACE = 57561
-const CONVERT = 57562
-const CAST = 57563
-const SUBSTR = 57564
-const SUBSTRING = 57565
-const GROUP_CONCAT = 57566
-const SEPARATOR = 57567
-const MATCH = 57568
-const AGAINST = 57569
-const BOOLEAN = 57570
-const LANGUAGE = 57571
-const WITH = 57572
-const QUERY = 57573
-const EXPANSION = 57574
-const UNUSED = 57575
+const WINDOW = 57348
+const LATERAL = 57349
+const SELECT = 57350
+const STREAM = 57351
+const INSERT = 57352
+const UPDATE = 57353
+const DELETE = 57354
+const FROM = 57355
+const WHERE = 57356
+const GROUP = 57357
+const HAVING = 57358
+const ORDER = 57359
+const BY = 57360
+const LIMIT = 57361
+const OFFSET = 57362
+const FOR = 57363
+const ALL = 57364
+const DISTINCT = 57365
+const AS = 57366
+const EXISTS = 57367
+const ASC = 57368
+const DESC = 57369
+const INTO = 57370
+const DUPLICATE = 57371
+const KEY = 57372
+const DEFAULT = 57373
+const SET = 57374
+const LOCK = 57375
+const KEYS = 57376
+const VALUES = 57377
+const LAST_INSERT_ID = 57378
+const NEXT = 57379
+const VALUE = 57380
+const SHARE = 57381
+const MODE = 57382
+const SQL_NO_CACHE = 57383
+const SQL_CACHE = 57384
+const JOIN = 57385
+const STRAIGHT_JOIN = 57386
+const LEFT = 57387
+const RIGHT = 57388
+const INNER = 57389
+const OUTER = 57390
+const CROSS = 57391
+const NATURAL = 57392
+const USE = 57393
+const FORCE = 57394
+const ON = 57395
+const USING = 57396
+const ID = 57397
+const HEX = 57398
+const STRING = 57399
+const STRINGKW = 57400
+const INTEGRAL = 57401
+const FLOAT = 57402
+const HEXNUM = 57403
+const VALUE_ARG = 57404
+const LIST_ARG = 57405
+const COMMENT = 57406
+const COMMENT_KEYWORD = 57407
+const BIT_LITERAL = 57408
+const TEMPLATE_VAR = 57409
+const NULL = 57410
+const TRUE = 57411
+const FALSE = 57412
+const OR = 57413
+const AND = 57414
+const NOT = 57415
+const BETWEEN = 57416
+const CASE = 57417
+const WHEN = 57418
+const THEN = 57419
+const ELSE = 57420
+const END = 57421
+const LE = 57422
+const GE = 57423
+const NE = 57424
+const NULL_SAFE_EQUAL = 57425
+const IS = 57426
+const LIKE = 57427
+const REGEXP = 57428
+const IN = 57429
+const SHIFT_LEFT = 57430
+const SHIFT_RIGHT = 57431
+const DIV = 57432
+const MOD = 57433
+const UNARY = 57434
+const COLLATE = 57435
+const BINARY = 57436
+const UNDERSCORE_BINARY = 57437
+const INTERVAL = 57438
+const JSON_EXTRACT_OP = 57439
+const JSON_UNQUOTE_EXTRACT_OP = 57440
+const CREATE = 57441
+const ALTER = 57442
+const DROP = 57443
+const RENAME = 57444
+const ANALYZE = 57445
+const ADD = 57446
+const SCHEMA = 57447
+const TABLE = 57448
+const INDEX = 57449
+const VIEW = 57450
+const TO = 57451
+const IGNORE = 57452
+const IF = 57453
+const UNIQUE = 57454
+const PRIMARY = 57455
+const COLUMN = 57456
+const CONSTRAINT = 57457
+const SPATIAL = 57458
+const FULLTEXT = 57459
+const FOREIGN = 57460
+const KEY_BLOCK_SIZE = 57461
+const SHOW = 57462
+const DESCRIBE = 57463
+const EXPLAIN = 57464
+const DATE = 57465
+const ESCAPE = 57466
+const REPAIR = 57467
+const OPTIMIZE = 57468
+const TRUNCATE = 57469
+const MAXVALUE = 57470
+const PARTITION = 57471
+const REORGANIZE = 57472
+const LESS = 57473
+const THAN = 57474
+const PROCEDURE = 57475
+const TRIGGER = 57476
+const VINDEX = 57477
+const VINDEXES = 57478
+const STATUS = 57479
+const VARIABLES = 57480
+const BEGIN = 57481
+const START = 57482
+const TRANSACTION = 57483
+const COMMIT = 57484
+const ROLLBACK = 57485
+const BIT = 57486
+const TINYINT = 57487
+const SMALLINT = 57488
+const MEDIUMINT = 57489
+const INT = 57490
+const INTEGER = 57491
+const BIGINT = 57492
+const INTNUM = 57493
+const REAL = 57494
+const DOUBLE = 57495
+const FLOAT_TYPE = 57496
+const DECIMAL = 57497
+const NUMERIC = 57498
+const TIME = 57499
+const TIMESTAMP = 57500
+const DATETIME = 57501
+const YEAR = 57502
+const CHAR = 57503
+const VARCHAR = 57504
+const BOOL = 57505
+const CHARACTER = 57506
+const VARBINARY = 57507
+const NCHAR = 57508
+const TEXT = 57509
+const TINYTEXT = 57510
+const MEDIUMTEXT = 57511
+const LONGTEXT = 57512
+const BLOB = 57513
+const TINYBLOB = 57514
+const MEDIUMBLOB = 57515
+const LONGBLOB = 57516
+const JSON = 57517
+const ENUM = 57518
+const GEOMETRY = 57519
+const POINT = 57520
+const LINESTRING = 57521
+const POLYGON = 57522
+const GEOMETRYCOLLECTION = 57523
+const MULTIPOINT = 57524
+const MULTILINESTRING = 57525
+const MULTIPOLYGON = 57526
+const NULLX = 57527
+const AUTO_INCREMENT = 57528
+const APPROXNUM = 57529
+const SIGNED = 57530
+const UNSIGNED = 57531
+const ZEROFILL = 57532
+const DATABASES = 57533
+const TABLES = 57534
+const VITESS_KEYSPACES = 57535
+const VITESS_SHARDS = 57536
+const VITESS_TABLETS = 57537
+const VSCHEMA_TABLES = 57538
+const EXTENDED = 57539
+const FULL = 57540
+const PROCESSLIST = 57541
+const NAMES = 57542
+const CHARSET = 57543
+const GLOBAL = 57544
+const SESSION = 57545
+const ISOLATION = 57546
+const LEVEL = 57547
+const READ = 57548
+const WRITE = 57549
+const ONLY = 57550
+const REPEATABLE = 57551
+const COMMITTED = 57552
+const UNCOMMITTED = 57553
+const SERIALIZABLE = 57554
+const CURRENT_TIMESTAMP = 57555
+const DATABASE = 57556
+const CURRENT_DATE = 57557
+const CURRENT_TIME = 57558
+const LOCALTIME = 57559
+const LOCALTIMESTAMP = 57560
+const UTC_DATE = 57561
+const UTC_TIME = 57562
+const UTC_TIMESTAMP = 57563
+const REPLACE = 57564
+const CONVERT = 57565
+const CAST = 57566
+const SUBSTR = 57567
+const SUBSTRING = 57568
+const GROUP_CONCAT = 57569
+const SEPARATOR = 57570
+const ROWS = 57571
+const RANGE = 57572
+const ROW = 57573
+const CURRENT = 57574
+const OVER = 57575
+const UNBOUNDED = 57576
+const PRECEDING = 57577
+const FOLLOWING = 57578
+const OVERWRITE = 57579
+const PARTITIONED = 57580
+const CLUSTERED = 57581
+const SORTED = 57582
+const BUCKETS = 57583
+const STORED = 57584
+const LOCATION = 57585
+const TBLPROPERTIES = 57586
+const INPUTFORMAT = 57587
+const OUTPUTFORMAT = 57588
+const FORMAT = 57589
+const DELIMITED = 57590
+const FIELDS = 57591
+const TERMINATED = 57592
+const ESCAPED = 57593
+const COLLECTION = 57594
+const ITEMS = 57595
+const MAP = 57596
+const LINES = 57597
+const DEFINED = 57598
+const SERDE = 57599
+const SERDEPROPERTIES = 57600
+const ARRAY = 57601
+const STRUCT = 57602
+const MATCH = 57603
+const AGAINST = 57604
+const BOOLEAN = 57605
+const LANGUAGE = 57606
+const WITH = 57607
+const QUERY = 57608
+const EXPANSION = 57609
+const UNUSED = 57610
 
 var yyToknames = [...]string{
 	"$end",
@@ -341,6 +399,8 @@ var yyToknames = [...]string{
 	"$unk",
 	"LEX_ERROR",
 	"UNION",
+	"WINDOW",
+	"LATERAL",
 	"SELECT",
 	"STREAM",
 	"INSERT",
@@ -403,6 +463,7 @@ var yyToknames = [...]string{
 	"COMMENT",
 	"COMMENT_KEYWORD",
 	"BIT_LITERAL",
+	"TEMPLATE_VAR",
 	"NULL",
 	"TRUE",
 	"FALSE",
@@ -578,6 +639,38 @@ var yyToknames = [...]string{
 	"SUBSTRING",
 	"GROUP_CONCAT",
 	"SEPARATOR",
+	"ROWS",
+	"RANGE",
+	"ROW",
+	"CURRENT",
+	"OVER",
+	"UNBOUNDED",
+	"PRECEDING",
+	"FOLLOWING",
+	"OVERWRITE",
+	"PARTITIONED",
+	"CLUSTERED",
+	"SORTED",
+	"BUCKETS",
+	"STORED",
+	"LOCATION",
+	"TBLPROPERTIES",
+	"INPUTFORMAT",
+	"OUTPUTFORMAT",
+	"FORMAT",
+	"DELIMITED",
+	"FIELDS",
+	"TERMINATED",
+	"ESCAPED",
+	"COLLECTION",
+	"ITEMS",
+	"MAP",
+	"LINES",
+	"DEFINED",
+	"SERDE",
+	"SERDEPROPERTIES",
+	"ARRAY",
+	"STRUCT",
 	"MATCH",
 	"AGAINST",
 	"BOOLEAN",
@@ -589,6 +682,7 @@ var yyToknames = [...]string{
 	"';'",
 	"'['",
 	"']'",
+	"':'",
 }
 
 var yyStatenames = [...]string{}
@@ -606,1175 +700,1744 @@ var yyExca = [...]int16{
 	5, 29,
 	-2, 23,
 	-1, 35,
-	151, 270,
-	152, 270,
-	-2, 260,
-	-1, 222,
+	154, 325,
+	155, 325,
+	-2, 315,
+	-1, 271,
 	5, 29,
 	-2, 22,
-	-1, 234,
-	110, 607,
-	-2, 603,
-	-1, 235,
-	110, 608,
-	-2, 604,
-	-1, 295,
-	1, 319,
-	5, 319,
-	12, 319,
-	13, 319,
-	14, 319,
-	15, 319,
-	17, 319,
-	19, 319,
-	30, 319,
-	31, 319,
-	41, 319,
-	42, 319,
-	43, 319,
-	44, 319,
-	45, 319,
-	47, 319,
-	48, 319,
-	51, 319,
-	52, 319,
-	54, 319,
-	55, 319,
-	212, 319,
-	251, 319,
-	-2, 337,
-	-1, 305,
-	81, 766,
-	-2, 65,
-	-1, 306,
-	81, 727,
-	-2, 66,
-	-1, 311,
-	81, 711,
-	-2, 569,
-	-1, 313,
-	81, 748,
-	-2, 571,
-	-1, 577,
-	52, 48,
-	54, 48,
-	-2, 50,
-	-1, 709,
-	110, 610,
-	-2, 606,
-	-1, 926,
+	-1, 283,
+	113, 712,
+	-2, 707,
+	-1, 284,
+	113, 713,
+	-2, 619,
+	-1, 285,
+	113, 714,
+	-2, 708,
+	-1, 286,
+	113, 715,
+	-2, 709,
+	-1, 357,
+	84, 882,
+	-2, 75,
+	-1, 358,
+	84, 836,
+	-2, 76,
+	-1, 363,
+	84, 818,
+	-2, 673,
+	-1, 365,
+	84, 858,
+	-2, 675,
+	-1, 653,
+	54, 50,
+	56, 50,
+	-2, 60,
+	-1, 805,
+	113, 717,
+	-2, 711,
+	-1, 1050,
 	5, 30,
-	-2, 405,
-	-1, 1197,
+	-2, 470,
+	-1, 1383,
 	5, 30,
-	-2, 545,
-	-1, 1298,
+	-2, 649,
+	-1, 1539,
 	5, 30,
-	-2, 548,
+	-2, 652,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 10879
+const yyLast = 16747
 
 var yyAct = [...]int16{
-	265, 51, 859, 1288, 648, 1248, 264, 1101, 522, 773,
-	239, 521, 3, 1201, 793, 1128, 569, 1102, 954, 815,
-	571, 1013, 1098, 450, 839, 849, 811, 774, 853, 814,
-	971, 1066, 466, 216, 833, 917, 736, 685, 1016, 680,
-	743, 1004, 587, 959, 459, 310, 211, 407, 746, 762,
-	712, 573, 51, 586, 304, 770, 51, 825, 225, 691,
-	557, 301, 292, 221, 237, 291, 299, 912, 54, 158,
-	499, 1319, 1309, 473, 536, 497, 498, 490, 491, 492,
-	493, 494, 495, 496, 489, 1317, 1296, 499, 1315, 212,
-	213, 214, 215, 860, 160, 161, 162, 163, 488, 487,
-	497, 498, 490, 491, 492, 493, 494, 495, 496, 489,
-	1308, 482, 499, 485, 489, 1295, 1093, 499, 220, 500,
-	501, 502, 503, 504, 505, 506, 297, 483, 484, 481,
-	488, 487, 497, 498, 490, 491, 492, 493, 494, 495,
-	496, 489, 1191, 411, 499, 487, 497, 498, 490, 491,
-	492, 493, 494, 495, 496, 489, 48, 1257, 499, 588,
-	187, 589, 296, 56, 1271, 488, 487, 497, 498, 490,
-	491, 492, 493, 494, 495, 496, 489, 48, 290, 499,
-	235, 48, 490, 491, 492, 493, 494, 495, 496, 489,
-	1123, 1124, 499, 1122, 438, 438, 438, 438, 806, 438,
-	1187, 463, 59, 52, 1238, 949, 438, 226, 950, 979,
-	176, 677, 978, 447, 59, 980, 486, 59, 678, 455,
-	1134, 995, 1135, 1136, 52, 807, 808, 46, 52, 1139,
-	1137, 832, 241, 486, 508, 1213, 840, 510, 488, 487,
-	497, 498, 490, 491, 492, 493, 494, 495, 496, 489,
-	1228, 413, 499, 1188, 185, 181, 182, 183, 486, 920,
-	1180, 1178, 210, 486, 520, 177, 524, 525, 526, 527,
-	528, 529, 530, 531, 532, 1316, 535, 537, 537, 537,
-	537, 537, 537, 537, 537, 545, 546, 547, 548, 300,
-	486, 443, 444, 1314, 410, 1289, 420, 570, 1037, 771,
-	876, 421, 414, 179, 486, 418, 178, 419, 179, 1255,
-	656, 647, 1294, 426, 875, 417, 428, 794, 796, 827,
-	1272, 789, 970, 563, 564, 486, 488, 487, 497, 498,
-	490, 491, 492, 493, 494, 495, 496, 489, 486, 969,
-	499, 880, 59, 59, 176, 827, 1249, 745, 59, 432,
-	176, 874, 538, 539, 540, 541, 542, 543, 544, 59,
-	1251, 59, 968, 184, 584, 578, 409, 59, 189, 180,
-	59, 1059, 840, 989, 176, 176, 176, 176, 812, 176,
-	492, 493, 494, 495, 496, 489, 176, 464, 499, 1138,
-	511, 512, 795, 1055, 295, 886, 934, 21, 486, 871,
-	868, 869, 910, 867, 59, 710, 176, 1256, 1254, 176,
-	696, 438, 826, 434, 477, 436, 552, 427, 21, 438,
-	1143, 889, 21, 415, 416, 577, 289, 1250, 878, 881,
-	438, 438, 438, 438, 438, 438, 438, 438, 826, 472,
-	433, 435, 471, 470, 438, 438, 454, 1041, 931, 1097,
-	1280, 559, 562, 563, 564, 560, 51, 561, 566, 472,
-	470, 1184, 463, 873, 462, 1095, 510, 688, 1034, 509,
-	59, 1144, 1153, 665, 1036, 887, 472, 59, 59, 59,
-	423, 424, 425, 176, 957, 872, 486, 687, 713, 176,
-	263, 827, 565, 590, 763, 663, 941, 471, 470, 488,
-	487, 497, 498, 490, 491, 492, 493, 494, 495, 496,
-	489, 51, 829, 499, 472, 763, 719, 830, 651, 408,
-	174, 431, 877, 709, 1040, 524, 689, 993, 1283, 295,
-	717, 718, 716, 549, 486, 879, 750, 471, 470, 1299,
-	755, 758, 52, 653, 654, 707, 764, 657, 705, 1300,
-	660, 439, 1219, 715, 472, 296, 296, 296, 296, 296,
-	1218, 232, 1008, 1007, 775, 996, 930, 1035, 929, 1033,
-	1281, 570, 737, 797, 738, 679, 740, 741, 1235, 1216,
-	296, 1161, 750, 1005, 826, 471, 470, 767, 1278, 824,
-	822, 176, 760, 823, 1131, 697, 801, 59, 59, 176,
-	1130, 59, 472, 990, 59, 907, 908, 909, 59, 981,
-	176, 176, 176, 176, 176, 176, 176, 176, 777, 778,
-	776, 780, 565, 779, 176, 176, 798, 790, 862, 59,
-	1303, 463, 463, 799, 739, 841, 842, 843, 803, 804,
-	835, 836, 837, 838, 662, 819, 894, 895, 661, 59,
-	652, 438, 891, 438, 309, 176, 846, 847, 848, 486,
-	412, 438, 1241, 1286, 701, 703, 704, 851, 852, 702,
-	1261, 254, 253, 855, 256, 257, 258, 259, 772, 1241,
-	463, 255, 260, 650, 309, 309, 309, 309, 890, 309,
-	1241, 1242, 1260, 471, 470, 645, 309, 429, 693, 422,
-	176, 1210, 1209, 1119, 463, 471, 470, 802, 408, 911,
-	472, 896, 695, 463, 714, 307, 467, 1150, 1149, 475,
-	1146, 1147, 472, 713, 1146, 1145, 924, 463, 554, 463,
-	748, 463, 59, 48, 898, 59, 59, 59, 59, 59,
-	597, 596, 1166, 559, 562, 563, 564, 560, 59, 561,
-	566, 59, 1140, 960, 961, 59, 709, 581, 22, 1099,
-	59, 59, 955, 217, 176, 748, 800, 913, 580, 955,
-	936, 952, 953, 956, 858, 1195, 933, 176, 554, 956,
-	52, 223, 951, 882, 1152, 924, 883, 295, 295, 295,
-	295, 295, 553, 309, 1148, 982, 228, 805, 582, 592,
-	580, 296, 924, 295, 513, 514, 515, 516, 517, 518,
-	519, 940, 295, 935, 222, 554, 554, 974, 973, 932,
-	975, 955, 924, 964, 892, 583, 52, 1223, 59, 834,
-	850, 176, 854, 176, 983, 1113, 456, 59, 985, 845,
-	59, 176, 960, 961, 967, 844, 165, 649, 976, 857,
-	1133, 1099, 1009, 963, 307, 659, 448, 787, 785, 438,
-	997, 998, 788, 786, 904, 966, 999, 783, 1001, 1002,
-	1003, 176, 784, 751, 752, 965, 176, 176, 782, 759,
-	781, 987, 988, 52, 438, 460, 461, 1006, 1313, 1307,
-	1163, 1044, 692, 766, 1312, 768, 769, 1053, 1052, 681,
-	1000, 309, 595, 430, 992, 1285, 690, 1029, 1193, 309,
-	1284, 682, 1236, 1015, 565, 1024, 986, 1224, 864, 658,
-	309, 309, 309, 309, 309, 309, 309, 309, 568, 465,
-	1047, 694, 457, 458, 309, 309, 1048, 692, 1051, 451,
-	1292, 452, 1291, 1022, 217, 1265, 1050, 956, 468, 714,
-	1060, 1104, 1058, 51, 1273, 1214, 888, 1103, 219, 1100,
-	775, 159, 579, 1087, 1105, 475, 775, 1086, 309, 1115,
-	1116, 1117, 1094, 1065, 53, 1, 861, 1012, 176, 870,
-	709, 59, 1287, 1109, 1107, 1106, 747, 749, 1108, 1247,
-	1127, 821, 813, 1121, 406, 176, 164, 1279, 820, 1253,
-	1212, 828, 765, 994, 1126, 831, 1132, 1120, 1023, 1282,
-	742, 991, 1125, 1028, 1025, 1018, 1019, 1026, 1021, 1020,
-	756, 756, 602, 600, 1141, 1142, 756, 601, 478, 708,
-	1027, 599, 604, 295, 792, 603, 1030, 598, 176, 176,
-	197, 176, 711, 302, 756, 720, 721, 722, 723, 724,
-	725, 726, 727, 728, 729, 730, 731, 732, 733, 734,
-	735, 567, 523, 1154, 176, 591, 856, 59, 59, 469,
-	166, 534, 1032, 1031, 309, 866, 1156, 1039, 676, 1159,
-	885, 446, 199, 507, 1049, 977, 308, 309, 55, 224,
-	893, 176, 923, 684, 1189, 1290, 1264, 939, 1175, 1176,
-	1174, 533, 1171, 1172, 761, 1173, 240, 700, 938, 252,
-	249, 251, 250, 899, 948, 480, 1177, 238, 1179, 230,
-	294, 550, 558, 1194, 556, 555, 962, 958, 293, 1203,
-	1204, 1205, 1200, 176, 176, 307, 1151, 1165, 1190, 1206,
-	1270, 309, 903, 309, 1208, 983, 24, 218, 816, 288,
-	19, 309, 438, 897, 18, 17, 20, 437, 1158, 16,
-	15, 14, 28, 176, 1211, 176, 176, 296, 13, 12,
-	1215, 11, 1217, 1222, 1221, 10, 1164, 9, 8, 7,
-	6, 900, 5, 1226, 1225, 4, 905, 906, 453, 47,
-	59, 2, 0, 0, 0, 0, 1104, 1227, 176, 1240,
-	0, 309, 1103, 0, 921, 0, 0, 0, 922, 0,
-	1239, 176, 59, 1237, 0, 926, 927, 928, 176, 0,
-	0, 1246, 0, 0, 937, 1263, 1252, 0, 0, 943,
-	59, 944, 945, 946, 947, 0, 176, 1262, 0, 1104,
-	176, 51, 1258, 0, 1259, 1103, 1274, 0, 683, 686,
-	0, 1054, 1275, 1277, 0, 0, 0, 0, 0, 0,
-	0, 0, 708, 0, 0, 0, 0, 698, 699, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 914,
-	915, 916, 1024, 1297, 775, 0, 0, 0, 972, 0,
-	0, 1301, 0, 0, 176, 0, 176, 176, 176, 59,
-	176, 1306, 0, 0, 1310, 309, 176, 1311, 0, 0,
-	1022, 0, 0, 0, 0, 0, 0, 1318, 0, 0,
-	0, 523, 0, 0, 753, 754, 0, 0, 0, 0,
-	0, 0, 176, 176, 176, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 59, 1010, 309,
-	0, 309, 440, 441, 442, 0, 445, 0, 0, 0,
-	0, 0, 0, 449, 0, 0, 816, 0, 0, 0,
-	0, 0, 0, 0, 309, 1023, 479, 0, 0, 0,
-	1028, 1025, 1018, 1019, 1026, 1021, 1020, 810, 1064, 0,
-	0, 176, 0, 0, 0, 0, 0, 1027, 57, 295,
-	0, 309, 0, 1017, 176, 0, 0, 0, 0, 0,
-	188, 0, 1014, 209, 0, 0, 0, 0, 0, 0,
-	0, 0, 176, 0, 0, 309, 0, 0, 0, 1061,
-	0, 0, 0, 0, 0, 0, 1118, 0, 0, 0,
-	756, 0, 0, 467, 972, 0, 756, 0, 0, 488,
-	487, 497, 498, 490, 491, 492, 493, 494, 495, 496,
-	489, 0, 1057, 499, 0, 0, 0, 0, 0, 0,
-	176, 0, 0, 309, 0, 309, 1129, 0, 0, 0,
-	1062, 1063, 0, 0, 0, 0, 1090, 0, 0, 0,
-	0, 0, 0, 0, 1088, 1089, 0, 1091, 1092, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1155, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1157, 0, 1168, 0, 0, 0, 0, 1160, 0,
-	0, 1170, 0, 0, 816, 229, 816, 0, 188, 188,
-	0, 0, 0, 0, 188, 925, 1167, 0, 0, 0,
-	309, 1181, 1182, 1183, 1185, 188, 1186, 188, 0, 0,
-	942, 0, 0, 188, 0, 0, 188, 0, 646, 1196,
-	1197, 1198, 1199, 0, 0, 0, 655, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 666, 667, 668,
-	669, 670, 671, 672, 673, 0, 0, 0, 0, 0,
-	57, 674, 675, 0, 1202, 0, 1202, 1202, 1202, 486,
-	1207, 1057, 0, 0, 0, 0, 309, 0, 0, 0,
-	0, 0, 0, 1169, 0, 0, 0, 488, 487, 497,
-	498, 490, 491, 492, 493, 494, 495, 496, 489, 0,
-	0, 499, 309, 309, 309, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 188, 0, 1243, 1244,
-	1245, 0, 0, 188, 575, 188, 0, 816, 463, 0,
-	0, 0, 0, 0, 0, 0, 0, 1045, 1046, 686,
-	0, 0, 0, 0, 1266, 1267, 1268, 1269, 0, 0,
-	0, 1129, 0, 0, 1014, 816, 0, 0, 0, 0,
-	0, 0, 0, 0, 1202, 488, 487, 497, 498, 490,
-	491, 492, 493, 494, 495, 496, 489, 0, 0, 499,
-	0, 0, 1276, 0, 0, 1293, 0, 0, 1096, 0,
-	1298, 0, 0, 0, 0, 0, 1229, 1230, 0, 1231,
-	1232, 1233, 1302, 1110, 1111, 0, 0, 1112, 0, 0,
-	1114, 0, 0, 0, 756, 0, 0, 488, 487, 497,
-	498, 490, 491, 492, 493, 494, 495, 496, 489, 0,
-	1304, 499, 0, 0, 0, 1322, 1323, 486, 0, 0,
-	0, 0, 0, 188, 188, 0, 0, 188, 0, 0,
-	188, 0, 0, 0, 664, 0, 0, 0, 863, 0,
-	865, 0, 0, 918, 0, 0, 0, 0, 884, 0,
-	0, 0, 0, 0, 0, 188, 0, 0, 0, 0,
-	0, 48, 23, 49, 25, 26, 0, 919, 1162, 0,
-	0, 0, 0, 0, 0, 188, 0, 0, 0, 0,
-	41, 0, 0, 0, 664, 27, 0, 488, 487, 497,
-	498, 490, 491, 492, 493, 494, 495, 496, 489, 0,
-	0, 499, 0, 0, 36, 486, 0, 0, 52, 0,
-	1320, 0, 488, 487, 497, 498, 490, 491, 492, 493,
-	494, 495, 496, 489, 1192, 0, 499, 229, 0, 0,
-	0, 523, 229, 229, 0, 0, 757, 757, 229, 0,
-	0, 0, 757, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 229, 229, 229, 229, 0, 486, 188, 0,
-	757, 188, 188, 188, 188, 188, 0, 0, 29, 30,
-	32, 31, 34, 0, 791, 0, 0, 188, 0, 0,
-	0, 575, 0, 0, 0, 0, 188, 188, 0, 35,
-	42, 43, 0, 0, 44, 45, 33, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 37, 38,
-	0, 39, 40, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1085, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1011, 486, 0, 0,
-	0, 0, 0, 0, 188, 0, 0, 0, 0, 0,
-	108, 0, 0, 188, 474, 0, 188, 0, 0, 76,
-	0, 1038, 486, 0, 90, 0, 92, 0, 0, 125,
-	101, 1067, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 50, 0, 0, 0, 0, 0, 0, 175, 0,
-	476, 128, 21, 0, 0, 0, 0, 0, 69, 0,
-	0, 1069, 0, 471, 470, 0, 0, 664, 1305, 523,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	472, 0, 0, 1074, 1075, 1076, 1077, 1078, 1079, 0,
-	0, 1073, 1072, 1071, 0, 1083, 0, 1070, 0, 1068,
-	0, 229, 0, 0, 1081, 0, 0, 0, 0, 0,
-	0, 0, 0, 1080, 148, 0, 0, 229, 0, 114,
-	0, 0, 129, 81, 80, 89, 1082, 1084, 0, 72,
-	0, 120, 110, 141, 0, 111, 119, 93, 133, 115,
-	140, 149, 150, 131, 147, 61, 130, 139, 70, 122,
-	63, 137, 127, 99, 85, 86, 62, 188, 118, 75,
-	79, 74, 107, 134, 135, 73, 156, 66, 146, 65,
-	67, 145, 106, 132, 138, 100, 97, 64, 136, 98,
-	96, 88, 77, 82, 112, 95, 113, 83, 103, 102,
-	104, 0, 619, 0, 126, 143, 157, 0, 0, 151,
-	152, 153, 154, 0, 0, 0, 105, 68, 84, 123,
-	87, 94, 117, 155, 109, 121, 71, 142, 124, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 60, 0, 91, 0,
-	116, 78, 144, 1042, 1043, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	229, 0, 0, 0, 0, 0, 0, 0, 229, 607,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 1220,
-	0, 664, 0, 0, 0, 0, 0, 0, 0, 620,
-	0, 0, 0, 0, 0, 0, 757, 0, 0, 0,
-	0, 0, 757, 0, 0, 0, 0, 0, 0, 0,
-	633, 634, 635, 636, 637, 638, 639, 0, 640, 641,
-	642, 643, 644, 621, 622, 623, 624, 605, 606, 0,
-	0, 608, 0, 609, 610, 611, 612, 613, 614, 615,
-	616, 617, 618, 625, 626, 627, 628, 629, 630, 631,
-	632, 0, 0, 0, 0, 0, 188, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 188, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 188, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 395, 385, 0, 357, 397, 335, 349,
-	405, 350, 351, 378, 321, 365, 108, 347, 0, 338,
-	316, 344, 317, 336, 359, 76, 362, 334, 387, 368,
-	90, 403, 92, 373, 0, 125, 101, 0, 0, 361,
-	389, 363, 383, 356, 379, 326, 372, 398, 348, 376,
-	399, 0, 0, 0, 175, 575, 817, 128, 818, 0,
-	0, 0, 0, 0, 69, 0, 375, 394, 346, 377,
-	315, 374, 0, 319, 322, 404, 392, 341, 342, 984,
-	0, 0, 0, 0, 0, 0, 360, 364, 380, 354,
-	0, 0, 0, 0, 0, 0, 0, 0, 339, 0,
-	371, 0, 0, 188, 323, 320, 0, 358, 0, 0,
-	0, 325, 0, 340, 381, 0, 314, 384, 390, 355,
-	148, 393, 353, 352, 396, 114, 0, 0, 129, 81,
-	80, 89, 388, 337, 345, 72, 343, 120, 110, 141,
-	370, 111, 119, 93, 133, 115, 140, 149, 150, 131,
-	147, 61, 130, 139, 70, 122, 63, 137, 127, 99,
-	85, 86, 62, 0, 118, 75, 79, 74, 107, 134,
-	135, 73, 156, 66, 146, 65, 67, 145, 106, 132,
-	138, 100, 97, 64, 136, 98, 96, 88, 77, 82,
-	112, 95, 113, 83, 103, 102, 104, 0, 318, 0,
-	126, 143, 157, 333, 391, 151, 152, 153, 154, 0,
-	757, 0, 105, 68, 84, 123, 87, 94, 117, 155,
-	109, 121, 71, 142, 124, 329, 332, 327, 328, 366,
-	367, 400, 401, 402, 382, 324, 0, 330, 331, 0,
-	386, 369, 60, 0, 91, 0, 116, 78, 144, 395,
-	385, 0, 357, 397, 335, 349, 405, 350, 351, 378,
-	321, 365, 108, 347, 0, 338, 316, 344, 317, 336,
-	359, 76, 362, 334, 387, 368, 90, 403, 92, 373,
-	0, 125, 101, 0, 0, 361, 389, 363, 383, 356,
-	379, 326, 372, 398, 348, 376, 399, 0, 0, 0,
-	175, 0, 817, 128, 818, 0, 0, 0, 0, 0,
-	69, 0, 375, 394, 346, 377, 315, 374, 0, 319,
-	322, 404, 392, 341, 342, 0, 0, 0, 0, 0,
-	0, 0, 360, 364, 380, 354, 0, 0, 0, 0,
-	0, 0, 0, 0, 339, 0, 371, 0, 0, 0,
-	323, 320, 0, 358, 0, 0, 0, 325, 0, 340,
-	381, 0, 314, 384, 390, 355, 148, 393, 353, 352,
-	396, 114, 0, 0, 129, 81, 80, 89, 388, 337,
-	345, 72, 343, 120, 110, 141, 370, 111, 119, 93,
-	133, 115, 140, 149, 150, 131, 147, 61, 130, 139,
-	70, 122, 63, 137, 127, 99, 85, 86, 62, 0,
-	118, 75, 79, 74, 107, 134, 135, 73, 156, 66,
-	146, 65, 67, 145, 106, 132, 138, 100, 97, 64,
-	136, 98, 96, 88, 77, 82, 112, 95, 113, 83,
-	103, 102, 104, 0, 318, 0, 126, 143, 157, 333,
-	391, 151, 152, 153, 154, 0, 0, 0, 105, 68,
-	84, 123, 87, 94, 117, 155, 109, 121, 71, 142,
-	124, 329, 332, 327, 328, 366, 367, 400, 401, 402,
-	382, 324, 0, 330, 331, 0, 386, 369, 60, 0,
-	91, 0, 116, 78, 144, 395, 385, 0, 357, 397,
-	335, 349, 405, 350, 351, 378, 321, 365, 108, 347,
-	0, 338, 316, 344, 317, 336, 359, 76, 362, 334,
-	387, 368, 90, 403, 92, 373, 0, 125, 101, 0,
-	0, 361, 389, 363, 383, 356, 379, 326, 372, 398,
-	348, 376, 399, 52, 0, 0, 175, 0, 0, 128,
-	0, 0, 0, 0, 0, 0, 69, 0, 375, 394,
-	346, 377, 315, 374, 0, 319, 322, 404, 392, 341,
-	342, 0, 0, 0, 0, 0, 0, 0, 360, 364,
-	380, 354, 0, 0, 0, 0, 0, 0, 0, 0,
-	339, 0, 371, 0, 0, 0, 323, 320, 0, 358,
-	0, 0, 0, 325, 0, 340, 381, 0, 314, 384,
-	390, 355, 148, 393, 353, 352, 396, 114, 0, 0,
-	129, 81, 80, 89, 388, 337, 345, 72, 343, 120,
-	110, 141, 370, 111, 119, 93, 133, 115, 140, 149,
-	150, 131, 147, 61, 130, 139, 70, 122, 63, 137,
-	127, 99, 85, 86, 62, 0, 118, 75, 79, 74,
-	107, 134, 135, 73, 156, 66, 146, 65, 67, 145,
-	106, 132, 138, 100, 97, 64, 136, 98, 96, 88,
-	77, 82, 112, 95, 113, 83, 103, 102, 104, 0,
-	318, 0, 126, 143, 157, 333, 391, 151, 152, 153,
-	154, 0, 0, 0, 105, 68, 84, 123, 87, 94,
-	117, 155, 109, 121, 71, 142, 124, 329, 332, 327,
-	328, 366, 367, 400, 401, 402, 382, 324, 0, 330,
-	331, 0, 386, 369, 60, 0, 91, 0, 116, 78,
-	144, 395, 385, 0, 357, 397, 335, 349, 405, 350,
-	351, 378, 321, 365, 108, 347, 0, 338, 316, 344,
-	317, 336, 359, 76, 362, 334, 387, 368, 90, 403,
-	92, 373, 0, 125, 101, 0, 0, 361, 389, 363,
-	383, 356, 379, 326, 372, 398, 348, 376, 399, 0,
-	0, 0, 175, 0, 0, 128, 0, 0, 0, 0,
-	0, 0, 69, 0, 375, 394, 346, 377, 315, 374,
-	0, 319, 322, 404, 392, 341, 342, 0, 0, 0,
-	0, 0, 0, 0, 360, 364, 380, 354, 0, 0,
-	0, 0, 0, 0, 1056, 0, 339, 0, 371, 0,
-	0, 0, 323, 320, 0, 358, 0, 0, 0, 325,
-	0, 340, 381, 0, 314, 384, 390, 355, 148, 393,
-	353, 352, 396, 114, 0, 0, 129, 81, 80, 89,
-	388, 337, 345, 72, 343, 120, 110, 141, 370, 111,
-	119, 93, 133, 115, 140, 149, 150, 131, 147, 61,
-	130, 139, 70, 122, 63, 137, 127, 99, 85, 86,
-	62, 0, 118, 75, 79, 74, 107, 134, 135, 73,
-	156, 66, 146, 65, 67, 145, 106, 132, 138, 100,
-	97, 64, 136, 98, 96, 88, 77, 82, 112, 95,
-	113, 83, 103, 102, 104, 0, 318, 0, 126, 143,
-	157, 333, 391, 151, 152, 153, 154, 0, 0, 0,
-	105, 68, 84, 123, 87, 94, 117, 155, 109, 121,
-	71, 142, 124, 329, 332, 327, 328, 366, 367, 400,
-	401, 402, 382, 324, 0, 330, 331, 0, 386, 369,
-	60, 0, 91, 0, 116, 78, 144, 395, 385, 0,
-	357, 397, 335, 349, 405, 350, 351, 378, 321, 365,
-	108, 347, 0, 338, 316, 344, 317, 336, 359, 76,
-	362, 334, 387, 368, 90, 403, 92, 373, 0, 125,
-	101, 0, 0, 361, 389, 363, 383, 356, 379, 326,
-	372, 398, 348, 376, 399, 0, 0, 0, 234, 0,
-	0, 128, 0, 0, 0, 0, 0, 0, 69, 0,
-	375, 394, 346, 377, 315, 374, 0, 319, 322, 404,
-	392, 341, 342, 0, 0, 0, 0, 0, 0, 0,
-	360, 364, 380, 354, 0, 0, 0, 0, 0, 0,
-	706, 0, 339, 0, 371, 0, 0, 0, 323, 320,
-	0, 358, 0, 0, 0, 325, 0, 340, 381, 0,
-	314, 384, 390, 355, 148, 393, 353, 352, 396, 114,
-	0, 0, 129, 81, 80, 89, 388, 337, 345, 72,
-	343, 120, 110, 141, 370, 111, 119, 93, 133, 115,
-	140, 149, 150, 131, 147, 61, 130, 139, 70, 122,
-	63, 137, 127, 99, 85, 86, 62, 0, 118, 75,
-	79, 74, 107, 134, 135, 73, 156, 66, 146, 65,
-	67, 145, 106, 132, 138, 100, 97, 64, 136, 98,
-	96, 88, 77, 82, 112, 95, 113, 83, 103, 102,
-	104, 0, 318, 0, 126, 143, 157, 333, 391, 151,
-	152, 153, 154, 0, 0, 0, 105, 68, 84, 123,
-	87, 94, 117, 155, 109, 121, 71, 142, 124, 329,
-	332, 327, 328, 366, 367, 400, 401, 402, 382, 324,
-	0, 330, 331, 0, 386, 369, 60, 0, 91, 0,
-	116, 78, 144, 395, 385, 0, 357, 397, 335, 349,
-	405, 350, 351, 378, 321, 365, 108, 347, 0, 338,
-	316, 344, 317, 336, 359, 76, 362, 334, 387, 368,
-	90, 403, 92, 373, 0, 125, 101, 0, 0, 361,
-	389, 363, 383, 356, 379, 326, 372, 398, 348, 376,
-	399, 0, 0, 0, 175, 0, 0, 128, 0, 0,
-	0, 0, 0, 0, 69, 0, 375, 394, 346, 377,
-	315, 374, 0, 319, 322, 404, 392, 341, 342, 0,
-	0, 0, 0, 0, 0, 0, 360, 364, 380, 354,
-	0, 0, 0, 0, 0, 0, 0, 0, 339, 0,
-	371, 0, 0, 0, 323, 320, 0, 358, 0, 0,
-	0, 325, 0, 340, 381, 0, 314, 384, 390, 355,
-	148, 393, 353, 352, 396, 114, 0, 0, 129, 81,
-	80, 89, 388, 337, 345, 72, 343, 120, 110, 141,
-	370, 111, 119, 93, 133, 115, 140, 149, 150, 131,
-	147, 61, 130, 139, 70, 122, 63, 137, 127, 99,
-	85, 86, 62, 0, 118, 75, 79, 74, 107, 134,
-	135, 73, 156, 66, 146, 65, 67, 145, 106, 132,
-	138, 100, 97, 64, 136, 98, 96, 88, 77, 82,
-	112, 95, 113, 83, 103, 102, 104, 0, 318, 0,
-	126, 143, 157, 333, 391, 151, 152, 153, 154, 0,
-	0, 0, 105, 68, 84, 123, 87, 94, 117, 155,
-	109, 121, 71, 142, 124, 329, 332, 327, 328, 366,
-	367, 400, 401, 402, 382, 324, 0, 330, 331, 0,
-	386, 369, 60, 0, 91, 0, 116, 78, 144, 395,
-	385, 0, 357, 397, 335, 349, 405, 350, 351, 378,
-	321, 365, 108, 347, 0, 338, 316, 344, 317, 336,
-	359, 76, 362, 334, 387, 368, 90, 403, 92, 373,
-	0, 125, 101, 0, 0, 361, 389, 363, 383, 356,
-	379, 326, 372, 398, 348, 376, 399, 0, 0, 0,
-	234, 0, 0, 128, 0, 0, 0, 0, 0, 0,
-	69, 0, 375, 394, 346, 377, 315, 374, 0, 319,
-	322, 404, 392, 341, 342, 0, 0, 0, 0, 0,
-	0, 0, 360, 364, 380, 354, 0, 0, 0, 0,
-	0, 0, 0, 0, 339, 0, 371, 0, 0, 0,
-	323, 320, 0, 358, 0, 0, 0, 325, 0, 340,
-	381, 0, 314, 384, 390, 355, 148, 393, 353, 352,
-	396, 114, 0, 0, 129, 81, 80, 89, 388, 337,
-	345, 72, 343, 120, 110, 141, 370, 111, 119, 93,
-	133, 115, 140, 149, 150, 131, 147, 61, 130, 139,
-	70, 122, 63, 137, 127, 99, 85, 86, 62, 0,
-	118, 75, 79, 74, 107, 134, 135, 73, 156, 66,
-	146, 65, 67, 145, 106, 132, 138, 100, 97, 64,
-	136, 98, 96, 88, 77, 82, 112, 95, 113, 83,
-	103, 102, 104, 0, 318, 0, 126, 143, 157, 333,
-	391, 151, 152, 153, 154, 0, 0, 0, 105, 68,
-	84, 123, 87, 94, 117, 155, 109, 121, 71, 142,
-	124, 329, 332, 327, 328, 366, 367, 400, 401, 402,
-	382, 324, 0, 330, 331, 0, 386, 369, 60, 0,
-	91, 0, 116, 78, 144, 395, 385, 0, 357, 397,
-	335, 349, 405, 350, 351, 378, 321, 365, 108, 347,
-	0, 338, 316, 344, 317, 336, 359, 76, 362, 334,
-	387, 368, 90, 403, 92, 373, 0, 125, 101, 0,
-	0, 361, 389, 363, 383, 356, 379, 326, 372, 398,
-	348, 376, 399, 0, 0, 0, 175, 0, 0, 128,
-	0, 0, 0, 0, 0, 0, 69, 0, 375, 394,
-	346, 377, 315, 374, 0, 319, 322, 404, 392, 341,
-	342, 0, 0, 0, 0, 0, 0, 0, 360, 364,
-	380, 354, 0, 0, 0, 0, 0, 0, 0, 0,
-	339, 0, 371, 0, 0, 0, 323, 320, 0, 358,
-	0, 0, 0, 325, 0, 340, 381, 0, 314, 384,
-	390, 355, 148, 393, 353, 352, 396, 114, 0, 0,
-	129, 81, 80, 89, 388, 337, 345, 72, 343, 120,
-	110, 141, 370, 111, 119, 93, 133, 115, 140, 149,
-	150, 131, 147, 61, 130, 139, 70, 122, 63, 137,
-	127, 99, 85, 86, 62, 0, 118, 75, 79, 74,
-	107, 134, 135, 73, 156, 66, 146, 65, 312, 145,
-	106, 132, 138, 100, 97, 64, 136, 98, 96, 88,
-	77, 82, 112, 95, 113, 83, 103, 102, 104, 0,
-	318, 0, 126, 143, 157, 333, 391, 151, 152, 153,
-	154, 0, 0, 0, 313, 311, 84, 123, 87, 94,
-	117, 155, 109, 121, 71, 142, 124, 329, 332, 327,
-	328, 366, 367, 400, 401, 402, 382, 324, 0, 330,
-	331, 0, 386, 369, 60, 0, 91, 0, 116, 78,
-	144, 395, 385, 0, 357, 397, 335, 349, 405, 350,
-	351, 378, 321, 365, 108, 347, 0, 338, 316, 344,
-	317, 336, 359, 76, 362, 334, 387, 368, 90, 403,
-	92, 373, 0, 125, 101, 0, 0, 361, 389, 363,
-	383, 356, 379, 326, 372, 398, 348, 376, 399, 0,
-	0, 0, 58, 0, 0, 128, 0, 0, 0, 0,
-	0, 0, 69, 0, 375, 394, 346, 377, 315, 374,
-	0, 319, 322, 404, 392, 341, 342, 0, 0, 0,
-	0, 0, 0, 0, 360, 364, 380, 354, 0, 0,
-	0, 0, 0, 0, 0, 0, 339, 0, 371, 0,
-	0, 0, 323, 320, 0, 358, 0, 0, 0, 325,
-	0, 340, 381, 0, 314, 384, 390, 355, 148, 393,
-	353, 352, 396, 114, 0, 0, 129, 81, 80, 89,
-	388, 337, 345, 72, 343, 120, 110, 141, 370, 111,
-	119, 93, 133, 115, 140, 149, 150, 131, 147, 61,
-	130, 139, 70, 122, 63, 137, 127, 99, 85, 86,
-	62, 0, 118, 75, 79, 74, 107, 134, 135, 73,
-	156, 66, 146, 65, 67, 145, 106, 132, 138, 100,
-	97, 64, 136, 98, 96, 88, 77, 82, 112, 95,
-	113, 83, 103, 102, 104, 0, 318, 0, 126, 143,
-	157, 333, 391, 151, 152, 153, 154, 0, 0, 0,
-	105, 68, 84, 123, 87, 94, 117, 155, 109, 121,
-	71, 142, 124, 329, 332, 327, 328, 366, 367, 400,
-	401, 402, 382, 324, 0, 330, 331, 0, 386, 369,
-	60, 0, 91, 0, 116, 78, 144, 395, 385, 0,
-	357, 397, 335, 349, 405, 350, 351, 378, 321, 365,
-	108, 347, 0, 338, 316, 344, 317, 336, 359, 76,
-	362, 334, 387, 368, 90, 403, 92, 373, 0, 125,
-	101, 0, 0, 361, 389, 363, 383, 356, 379, 326,
-	372, 398, 348, 376, 399, 0, 0, 0, 175, 0,
-	0, 128, 0, 0, 0, 0, 0, 0, 69, 0,
-	375, 394, 346, 377, 315, 374, 0, 319, 322, 404,
-	392, 341, 342, 0, 0, 0, 0, 0, 0, 0,
-	360, 364, 380, 354, 0, 0, 0, 0, 0, 0,
-	0, 0, 339, 0, 371, 0, 0, 0, 323, 320,
-	0, 358, 0, 0, 0, 325, 0, 340, 381, 0,
-	314, 384, 390, 355, 148, 393, 353, 352, 396, 114,
-	0, 0, 129, 81, 80, 89, 388, 337, 345, 72,
-	343, 120, 110, 141, 370, 111, 119, 93, 133, 115,
-	140, 149, 150, 131, 147, 61, 130, 585, 70, 122,
-	63, 137, 127, 99, 85, 86, 62, 0, 118, 75,
-	79, 74, 107, 134, 135, 73, 156, 66, 146, 65,
-	312, 145, 106, 132, 138, 100, 97, 64, 136, 98,
-	96, 88, 77, 82, 112, 95, 113, 83, 103, 102,
-	104, 0, 318, 0, 126, 143, 157, 333, 391, 151,
-	152, 153, 154, 0, 0, 0, 313, 311, 84, 123,
-	87, 94, 117, 155, 109, 121, 71, 142, 124, 329,
-	332, 327, 328, 366, 367, 400, 401, 402, 382, 324,
-	0, 330, 331, 0, 386, 369, 60, 0, 91, 0,
-	116, 78, 144, 395, 385, 0, 357, 397, 335, 349,
-	405, 350, 351, 378, 321, 365, 108, 347, 0, 338,
-	316, 344, 317, 336, 359, 76, 362, 334, 387, 368,
-	90, 403, 92, 373, 0, 125, 101, 0, 0, 361,
-	389, 363, 383, 356, 379, 326, 372, 398, 348, 376,
-	399, 0, 0, 0, 175, 0, 0, 128, 0, 0,
-	0, 0, 0, 0, 69, 0, 375, 394, 346, 377,
-	315, 374, 0, 319, 322, 404, 392, 341, 342, 0,
-	0, 0, 0, 0, 0, 0, 360, 364, 380, 354,
-	0, 0, 0, 0, 0, 0, 0, 0, 339, 0,
-	371, 0, 0, 0, 323, 320, 0, 358, 0, 0,
-	0, 325, 0, 340, 381, 0, 314, 384, 390, 355,
-	148, 393, 353, 352, 396, 114, 0, 0, 129, 81,
-	80, 89, 388, 337, 345, 72, 343, 120, 110, 141,
-	370, 111, 119, 93, 133, 115, 140, 149, 150, 131,
-	147, 61, 130, 303, 70, 122, 63, 137, 127, 99,
-	85, 86, 62, 0, 118, 75, 79, 74, 107, 134,
-	135, 73, 156, 66, 146, 65, 312, 145, 106, 132,
-	138, 100, 97, 64, 136, 98, 96, 88, 77, 82,
-	112, 95, 113, 83, 103, 102, 104, 0, 318, 0,
-	126, 143, 157, 333, 391, 151, 152, 153, 154, 0,
-	0, 0, 313, 311, 306, 305, 87, 94, 117, 155,
-	109, 121, 71, 142, 124, 329, 332, 327, 328, 366,
-	367, 400, 401, 402, 382, 324, 0, 330, 331, 48,
-	386, 369, 60, 0, 91, 0, 116, 78, 144, 0,
-	0, 108, 0, 0, 0, 0, 236, 0, 0, 0,
-	76, 0, 233, 0, 0, 90, 275, 92, 0, 0,
-	125, 101, 0, 0, 0, 0, 266, 267, 0, 0,
-	0, 0, 0, 0, 0, 0, 52, 0, 0, 234,
-	254, 253, 128, 256, 257, 258, 259, 0, 0, 69,
-	255, 260, 261, 262, 0, 0, 231, 247, 0, 274,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 244,
-	245, 0, 0, 0, 0, 286, 0, 246, 0, 0,
-	242, 243, 248, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 148, 0, 0, 284, 0,
-	114, 0, 0, 129, 81, 80, 89, 0, 0, 0,
-	72, 0, 120, 110, 141, 0, 111, 119, 93, 133,
-	115, 140, 149, 150, 131, 147, 61, 130, 139, 70,
-	122, 63, 137, 127, 99, 85, 86, 62, 0, 118,
-	75, 79, 74, 107, 134, 135, 73, 156, 66, 146,
-	65, 67, 145, 106, 132, 138, 100, 97, 64, 136,
-	98, 96, 88, 77, 82, 112, 95, 113, 83, 103,
-	102, 104, 0, 0, 0, 126, 143, 157, 0, 0,
-	151, 152, 153, 154, 0, 0, 0, 105, 68, 84,
-	123, 87, 94, 117, 155, 109, 121, 71, 142, 124,
-	276, 285, 282, 283, 280, 281, 279, 278, 277, 287,
-	268, 269, 270, 271, 273, 0, 272, 60, 0, 91,
-	21, 116, 78, 144, 108, 0, 0, 744, 0, 236,
-	0, 0, 0, 76, 0, 233, 0, 0, 90, 275,
-	92, 0, 0, 125, 101, 0, 0, 0, 0, 266,
-	267, 0, 0, 0, 0, 0, 0, 0, 0, 52,
-	0, 0, 234, 254, 253, 128, 256, 257, 258, 259,
-	0, 0, 69, 255, 260, 261, 262, 0, 0, 231,
-	247, 0, 274, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 244, 245, 227, 0, 0, 0, 286, 0,
-	246, 0, 0, 242, 243, 248, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 148, 0,
-	0, 284, 0, 114, 0, 0, 129, 81, 80, 89,
-	0, 0, 0, 72, 0, 120, 110, 141, 0, 111,
-	119, 93, 133, 115, 140, 149, 150, 131, 147, 61,
-	130, 139, 70, 122, 63, 137, 127, 99, 85, 86,
-	62, 0, 118, 75, 79, 74, 107, 134, 135, 73,
-	156, 66, 146, 65, 67, 145, 106, 132, 138, 100,
-	97, 64, 136, 98, 96, 88, 77, 82, 112, 95,
-	113, 83, 103, 102, 104, 0, 0, 0, 126, 143,
-	157, 0, 0, 151, 152, 153, 154, 0, 0, 0,
-	105, 68, 84, 123, 87, 94, 117, 155, 109, 121,
-	71, 142, 124, 276, 285, 282, 283, 280, 281, 279,
-	278, 277, 287, 268, 269, 270, 271, 273, 108, 272,
-	60, 0, 91, 236, 116, 78, 144, 76, 0, 233,
-	0, 0, 90, 275, 92, 0, 0, 125, 101, 0,
-	0, 0, 0, 266, 267, 0, 0, 0, 0, 0,
-	0, 0, 0, 52, 0, 463, 234, 254, 253, 128,
-	256, 257, 258, 259, 0, 0, 69, 255, 260, 261,
-	262, 0, 0, 231, 247, 0, 274, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 244, 245, 0, 0,
-	0, 0, 286, 0, 246, 0, 0, 242, 243, 248,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 148, 0, 0, 284, 0, 114, 0, 0,
-	129, 81, 80, 89, 0, 0, 0, 72, 0, 120,
-	110, 141, 0, 111, 119, 93, 133, 115, 140, 149,
-	150, 131, 147, 61, 130, 139, 70, 122, 63, 137,
-	127, 99, 85, 86, 62, 0, 118, 75, 79, 74,
-	107, 134, 135, 73, 156, 66, 146, 65, 67, 145,
-	106, 132, 138, 100, 97, 64, 136, 98, 96, 88,
-	77, 82, 112, 95, 113, 83, 103, 102, 104, 0,
-	0, 0, 126, 143, 157, 0, 0, 151, 152, 153,
-	154, 0, 0, 0, 105, 68, 84, 123, 87, 94,
-	117, 155, 109, 121, 71, 142, 124, 276, 285, 282,
-	283, 280, 281, 279, 278, 277, 287, 268, 269, 270,
-	271, 273, 108, 272, 60, 0, 91, 236, 116, 78,
-	144, 76, 0, 233, 0, 0, 90, 275, 92, 0,
-	0, 125, 101, 0, 0, 0, 0, 266, 267, 0,
+	285, 1571, 1569, 1432, 1527, 592, 744, 983, 975, 290,
+	1451, 873, 780, 1318, 1462, 1257, 733, 265, 914, 1312,
+	1289, 316, 60, 896, 1258, 1161, 1072, 970, 949, 841,
+	224, 1217, 1213, 647, 60, 362, 293, 60, 922, 1254,
+	645, 260, 1080, 518, 920, 277, 1264, 1099, 874, 759,
+	962, 1041, 832, 776, 534, 844, 1164, 734, 781, 966,
+	1134, 679, 1240, 969, 591, 3, 935, 663, 208, 207,
+	943, 206, 1085, 808, 860, 527, 959, 202, 662, 468,
+	868, 356, 638, 628, 261, 262, 263, 264, 649, 843,
+	640, 343, 787, 194, 288, 541, 353, 351, 606, 344,
+	1412, 559, 560, 561, 562, 563, 564, 565, 558, 54,
+	1596, 568, 1561, 1592, 48, 1537, 270, 1587, 196, 197,
+	198, 199, 984, 558, 568, 1150, 568, 1560, 1536, 1249,
+	1323, 738, 1328, 1325, 46, 1522, 1421, 1420, 1073, 1327,
+	737, 1074, 269, 1324, 475, 1156, 551, 56, 554, 48,
+	1518, 1321, 1214, 703, 569, 570, 571, 572, 573, 574,
+	575, 52, 552, 553, 550, 557, 556, 566, 567, 559,
+	560, 561, 562, 563, 564, 565, 558, 1584, 1155, 568,
+	1582, 1583, 696, 557, 556, 566, 567, 559, 560, 561,
+	562, 563, 564, 565, 558, 48, 52, 568, 60, 60,
+	224, 1377, 48, 479, 224, 566, 567, 559, 560, 561,
+	562, 563, 564, 565, 558, 1471, 60, 568, 224, 1556,
+	1557, 664, 1447, 665, 1295, 909, 1296, 1297, 60, 1074,
+	60, 690, 1282, 1300, 347, 1298, 60, 515, 342, 60,
+	488, 1125, 52, 224, 224, 224, 224, 773, 224, 52,
+	275, 1283, 1284, 1107, 774, 224, 1106, 281, 942, 1108,
+	1403, 704, 234, 230, 231, 232, 458, 910, 911, 1364,
+	481, 464, 463, 60, 462, 224, 1436, 889, 224, 634,
+	635, 507, 720, 721, 722, 723, 724, 725, 726, 555,
+	727, 728, 729, 730, 731, 705, 706, 707, 708, 688,
+	689, 950, 555, 691, 555, 692, 693, 694, 695, 697,
+	698, 699, 700, 701, 702, 709, 710, 711, 712, 713,
+	714, 715, 716, 226, 578, 1362, 547, 259, 561, 562,
+	563, 564, 565, 558, 1588, 460, 568, 511, 512, 1578,
+	1585, 1586, 60, 201, 1528, 1493, 1265, 1185, 60, 60,
+	60, 60, 869, 1463, 1389, 224, 489, 555, 1535, 482,
+	500, 224, 227, 593, 228, 228, 752, 1465, 892, 897,
+	899, 233, 604, 1151, 203, 555, 1152, 937, 1153, 1154,
+	743, 1098, 1097, 1469, 1096, 347, 477, 204, 21, 872,
+	1371, 485, 718, 238, 1299, 555, 229, 717, 719, 950,
+	1347, 684, 522, 1212, 1499, 557, 556, 566, 567, 559,
+	560, 561, 562, 563, 564, 565, 558, 1373, 531, 568,
+	532, 1207, 937, 21, 1203, 502, 505, 504, 608, 609,
+	610, 611, 612, 613, 614, 1464, 580, 581, 1058, 1034,
+	630, 633, 634, 635, 631, 898, 632, 637, 654, 636,
+	1119, 660, 501, 503, 806, 792, 557, 556, 566, 567,
+	559, 560, 561, 562, 563, 564, 565, 558, 582, 21,
+	568, 936, 546, 491, 492, 493, 21, 224, 495, 224,
+	915, 359, 1470, 1468, 1304, 60, 60, 224, 1013, 60,
+	483, 484, 60, 815, 538, 1010, 60, 1055, 224, 224,
+	224, 224, 224, 224, 224, 224, 1517, 813, 814, 812,
+	540, 1189, 224, 224, 555, 212, 936, 60, 540, 1182,
+	341, 1408, 461, 211, 672, 1184, 213, 465, 466, 539,
+	538, 761, 685, 499, 539, 538, 1305, 60, 1139, 783,
+	1054, 1253, 1053, 224, 1138, 1512, 540, 539, 538, 1137,
+	1506, 540, 583, 584, 585, 586, 587, 588, 589, 1332,
+	539, 538, 1083, 1411, 540, 779, 782, 212, 1018, 1019,
+	789, 974, 809, 666, 205, 211, 1011, 540, 213, 209,
+	210, 1251, 805, 224, 794, 795, 861, 810, 784, 1188,
+	224, 1454, 1500, 1410, 747, 1591, 803, 555, 556, 566,
+	567, 559, 560, 561, 562, 563, 564, 565, 558, 853,
+	856, 568, 636, 1319, 619, 862, 539, 538, 785, 1183,
+	1123, 1181, 60, 861, 52, 1065, 60, 60, 60, 60,
+	60, 1564, 939, 540, 875, 811, 359, 940, 801, 1543,
+	593, 1479, 1414, 851, 852, 60, 865, 60, 555, 1413,
+	1141, 60, 1140, 797, 799, 800, 60, 60, 798, 848,
+	224, 1015, 347, 347, 347, 347, 347, 1126, 1000, 1524,
+	508, 509, 510, 1523, 513, 836, 838, 1513, 925, 224,
+	1486, 517, 999, 347, 1485, 849, 850, 1031, 1032, 1033,
+	1482, 857, 347, 917, 735, 858, 904, 1014, 833, 1444,
+	834, 1148, 1415, 1406, 1340, 864, 1329, 866, 867, 1004,
+	1146, 848, 913, 926, 1135, 539, 538, 876, 470, 998,
+	879, 890, 951, 952, 953, 893, 877, 878, 979, 880,
+	891, 977, 540, 224, 902, 675, 673, 224, 472, 901,
+	907, 906, 1434, 1510, 60, 1548, 531, 224, 1292, 224,
+	1291, 286, 1120, 60, 1109, 919, 60, 224, 929, 945,
+	946, 947, 948, 1016, 1544, 1148, 1525, 531, 995, 992,
+	993, 986, 991, 61, 973, 1520, 956, 957, 958, 964,
+	965, 225, 1505, 531, 1478, 61, 459, 224, 61, 555,
+	835, 968, 224, 224, 758, 960, 961, 1002, 1005, 1148,
+	531, 1148, 1455, 1400, 1399, 1279, 531, 807, 791, 531,
+	816, 817, 818, 819, 820, 821, 822, 823, 824, 825,
+	826, 827, 828, 829, 830, 831, 757, 1020, 804, 748,
+	460, 746, 997, 741, 809, 1369, 531, 630, 633, 634,
+	635, 631, 805, 632, 637, 1316, 1315, 1086, 1087, 810,
+	497, 1307, 1308, 1477, 996, 490, 1036, 1307, 1306, 1143,
+	1285, 1022, 1048, 531, 839, 1148, 1147, 1143, 1142, 973,
+	1111, 60, 1345, 60, 557, 556, 566, 567, 559, 560,
+	561, 562, 563, 564, 565, 558, 1037, 1301, 568, 973,
+	972, 1001, 1049, 625, 531, 846, 531, 678, 677, 1255,
+	1082, 224, 1081, 1060, 60, 742, 48, 1066, 903, 1579,
+	656, 624, 846, 751, 1081, 1048, 1082, 224, 266, 657,
+	1381, 925, 1057, 625, 762, 763, 764, 765, 766, 767,
+	768, 769, 1047, 1075, 1064, 625, 1003, 1409, 770, 771,
+	347, 359, 625, 1331, 1102, 1101, 1059, 1103, 1062, 61,
+	61, 225, 1090, 52, 272, 225, 926, 1048, 1081, 1113,
+	658, 1314, 656, 1374, 1112, 1056, 908, 61, 1129, 225,
+	1131, 1132, 1133, 1048, 1127, 1128, 1104, 1016, 659, 61,
+	1110, 61, 224, 224, 52, 224, 1480, 61, 464, 463,
+	61, 462, 1427, 1418, 225, 225, 225, 225, 22, 225,
+	1117, 1118, 944, 524, 963, 967, 225, 1272, 224, 636,
+	212, 60, 60, 1115, 1086, 1087, 1136, 461, 211, 955,
+	954, 213, 465, 466, 61, 916, 225, 736, 732, 225,
+	676, 1145, 1163, 1144, 685, 224, 473, 557, 556, 566,
+	567, 559, 560, 561, 562, 563, 564, 565, 558, 1177,
+	52, 568, 745, 981, 271, 1294, 1255, 1157, 1089, 755,
+	1193, 1194, 782, 516, 887, 1206, 555, 1095, 1028, 888,
+	1093, 1038, 1039, 1040, 1196, 1195, 885, 224, 224, 883,
+	1250, 886, 1092, 875, 884, 1256, 1091, 1242, 804, 882,
+	1208, 875, 881, 61, 805, 224, 1259, 1576, 1267, 61,
+	61, 61, 61, 1559, 1216, 1342, 225, 1241, 1246, 528,
+	529, 1252, 225, 925, 1192, 925, 224, 1202, 1567, 1280,
+	788, 1201, 1200, 1326, 777, 1263, 1266, 1130, 1269, 1270,
+	1268, 1262, 1271, 671, 786, 1273, 778, 498, 1122, 1516,
+	224, 1261, 1515, 1445, 224, 1281, 1302, 1303, 926, 224,
+	926, 1116, 1379, 1287, 1428, 1416, 1507, 1286, 60, 988,
+	754, 1580, 1563, 1423, 533, 476, 224, 525, 526, 1309,
+	1310, 1317, 788, 987, 1199, 989, 519, 1545, 1533, 224,
+	60, 1531, 1198, 1008, 306, 305, 224, 308, 309, 310,
+	311, 1484, 1483, 1422, 307, 837, 312, 1419, 60, 1417,
+	674, 520, 474, 471, 224, 266, 1333, 1530, 224, 1490,
+	1082, 536, 1501, 224, 1404, 224, 1012, 641, 1551, 1335,
+	268, 195, 1338, 655, 53, 1, 985, 1160, 225, 555,
+	225, 994, 1526, 1461, 1288, 1341, 61, 61, 225, 931,
+	61, 918, 1149, 61, 1521, 978, 1320, 61, 1353, 225,
+	225, 225, 225, 225, 225, 225, 225, 467, 200, 1511,
+	930, 1467, 1402, 225, 225, 938, 1162, 1124, 61, 941,
+	1293, 1121, 683, 224, 1359, 1360, 224, 224, 224, 60,
+	224, 1391, 1358, 681, 1393, 1394, 1395, 925, 61, 1355,
+	1356, 1386, 1357, 682, 225, 680, 1380, 687, 686, 1378,
+	1210, 1211, 1311, 1361, 246, 1363, 593, 354, 1365, 642,
+	291, 1390, 1396, 667, 1243, 1244, 1205, 1247, 1248, 980,
+	537, 214, 926, 1180, 1398, 1113, 1179, 990, 1187, 1075,
+	772, 224, 224, 925, 225, 1405, 1009, 1407, 514, 248,
+	1245, 225, 576, 1197, 1105, 360, 60, 55, 224, 273,
+	1017, 1529, 1555, 1554, 1433, 1568, 1172, 1425, 1550, 1492,
+	1489, 1401, 1063, 603, 859, 292, 796, 304, 926, 301,
+	303, 1426, 224, 61, 302, 1023, 549, 61, 61, 61,
+	61, 61, 347, 289, 1170, 1435, 1430, 1429, 224, 279,
+	1450, 1388, 1094, 1562, 639, 346, 61, 620, 61, 629,
+	627, 224, 61, 1259, 626, 1088, 1446, 61, 61, 1159,
+	1084, 225, 224, 345, 1344, 1376, 1498, 1027, 1172, 24,
+	1460, 1466, 1456, 267, 1472, 340, 1473, 19, 224, 18,
+	225, 1475, 17, 20, 1186, 1481, 1487, 16, 15, 14,
+	28, 1474, 13, 12, 1476, 11, 1170, 10, 9, 1448,
+	1171, 8, 7, 1453, 6, 1176, 1173, 1166, 1167, 1174,
+	1169, 1168, 5, 1502, 4, 521, 47, 1349, 2, 1259,
+	0, 0, 1175, 0, 0, 0, 1509, 0, 1178, 0,
+	0, 1514, 0, 0, 225, 0, 0, 0, 225, 1205,
+	0, 0, 0, 1519, 1350, 61, 0, 0, 225, 0,
+	225, 0, 0, 0, 61, 0, 224, 61, 225, 60,
+	1532, 875, 1171, 1538, 1503, 0, 1540, 1176, 1173, 1166,
+	1167, 1174, 1169, 1168, 0, 0, 782, 0, 0, 224,
+	0, 0, 1546, 0, 1175, 0, 0, 1553, 225, 1558,
+	1165, 0, 0, 225, 225, 0, 0, 0, 0, 0,
+	0, 0, 224, 1566, 1565, 0, 0, 0, 0, 0,
+	1370, 0, 0, 0, 224, 48, 23, 49, 25, 26,
+	0, 0, 1577, 0, 1581, 0, 1552, 593, 0, 593,
+	224, 0, 1589, 0, 41, 0, 0, 0, 0, 27,
+	0, 0, 0, 1595, 1594, 557, 556, 566, 567, 559,
+	560, 561, 562, 563, 564, 565, 558, 0, 36, 568,
+	0, 0, 52, 1162, 0, 0, 0, 0, 0, 0,
+	0, 0, 61, 0, 61, 0, 0, 1437, 1438, 1431,
+	0, 1440, 1441, 1442, 557, 556, 566, 567, 559, 560,
+	561, 562, 563, 564, 565, 558, 0, 0, 568, 0,
+	0, 0, 225, 1439, 0, 61, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 225, 0,
+	317, 51, 0, 29, 30, 32, 31, 34, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1236, 0,
+	0, 0, 0, 0, 35, 42, 43, 0, 0, 44,
+	45, 33, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 37, 38, 0, 39, 40, 0, 0,
+	0, 0, 51, 0, 0, 0, 51, 0, 274, 0,
+	0, 0, 0, 225, 225, 530, 225, 1218, 0, 0,
+	0, 0, 0, 0, 0, 349, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1424, 0, 225,
+	0, 0, 61, 61, 1541, 0, 0, 1220, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 236,
+	0, 0, 0, 0, 0, 0, 225, 555, 1044, 1225,
+	1226, 1227, 1228, 1229, 1230, 0, 50, 1224, 1223, 1222,
+	0, 1234, 1238, 1221, 0, 1219, 1237, 0, 0, 0,
+	1232, 0, 0, 1575, 531, 0, 0, 0, 0, 1231,
+	0, 0, 0, 0, 0, 0, 555, 0, 225, 225,
+	1575, 0, 1233, 1235, 0, 0, 0, 0, 0, 21,
+	0, 0, 0, 0, 0, 0, 225, 0, 0, 1575,
+	0, 1597, 557, 556, 566, 567, 559, 560, 561, 562,
+	563, 564, 565, 558, 0, 0, 568, 225, 348, 0,
+	0, 0, 51, 557, 556, 566, 567, 559, 560, 561,
+	562, 563, 564, 565, 558, 0, 0, 568, 0, 0,
+	0, 225, 0, 0, 0, 225, 0, 0, 718, 0,
+	225, 0, 0, 717, 719, 0, 0, 1239, 0, 61,
+	0, 0, 0, 506, 506, 506, 506, 225, 506, 1042,
+	0, 0, 0, 0, 0, 506, 0, 0, 0, 0,
+	225, 61, 0, 0, 0, 0, 0, 225, 523, 0,
+	0, 0, 0, 0, 352, 0, 0, 315, 0, 61,
+	0, 0, 0, 577, 0, 225, 0, 0, 579, 225,
+	0, 478, 0, 0, 225, 0, 225, 0, 0, 0,
+	0, 0, 0, 486, 0, 487, 0, 222, 0, 0,
+	0, 494, 0, 0, 496, 0, 590, 0, 594, 595,
+	596, 597, 598, 599, 600, 601, 602, 0, 605, 607,
+	607, 607, 607, 607, 607, 607, 607, 615, 616, 617,
+	618, 0, 1209, 0, 0, 0, 0, 0, 0, 646,
+	0, 0, 0, 0, 225, 0, 0, 225, 225, 225,
+	61, 225, 557, 556, 566, 567, 559, 560, 561, 562,
+	563, 564, 565, 558, 555, 0, 568, 1043, 557, 556,
+	566, 567, 559, 560, 561, 562, 563, 564, 565, 558,
+	0, 0, 568, 0, 0, 555, 0, 557, 556, 566,
+	567, 559, 560, 561, 562, 563, 564, 565, 558, 0,
+	0, 568, 225, 225, 0, 0, 0, 623, 0, 0,
+	0, 0, 0, 0, 0, 0, 653, 61, 0, 225,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 225, 0, 0, 0, 0, 0, 0,
+	51, 0, 0, 0, 0, 0, 0, 0, 0, 225,
+	0, 0, 0, 0, 0, 0, 0, 361, 0, 506,
+	0, 469, 225, 0, 0, 0, 0, 506, 0, 0,
+	0, 0, 244, 225, 0, 480, 0, 0, 506, 506,
+	506, 506, 506, 506, 506, 506, 0, 0, 0, 225,
+	0, 0, 506, 506, 0, 0, 254, 0, 0, 0,
+	361, 361, 361, 361, 51, 361, 0, 0, 0, 0,
+	0, 0, 361, 0, 579, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 535, 0, 555, 543, 0, 0, 0, 0,
+	749, 750, 0, 0, 753, 0, 0, 756, 239, 0,
+	555, 0, 0, 0, 241, 0, 0, 0, 0, 0,
+	51, 247, 243, 0, 0, 0, 0, 225, 0, 555,
+	61, 0, 775, 0, 0, 594, 0, 0, 0, 0,
+	790, 0, 0, 0, 0, 0, 0, 0, 245, 0,
+	225, 249, 793, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 348, 348, 348, 348,
+	348, 0, 361, 225, 0, 0, 0, 0, 668, 240,
+	0, 0, 0, 0, 0, 225, 0, 646, 0, 900,
+	0, 0, 0, 0, 0, 0, 348, 845, 847, 0,
+	0, 225, 0, 0, 0, 0, 242, 0, 250, 251,
+	252, 253, 257, 863, 0, 0, 0, 256, 255, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 871, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 895, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 905, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 506, 0, 506,
+	0, 0, 0, 0, 739, 0, 361, 506, 0, 0,
+	0, 0, 0, 0, 361, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 361, 361, 361, 361, 361,
+	361, 361, 361, 0, 0, 0, 0, 0, 0, 361,
+	361, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1035, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 982,
+	543, 0, 0, 0, 361, 0, 0, 0, 1006, 0,
+	0, 1007, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1021, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	361, 0, 0, 0, 0, 0, 0, 840, 0, 1076,
+	1077, 0, 0, 0, 0, 0, 0, 854, 854, 0,
+	0, 0, 0, 854, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1045, 854, 0, 348, 0, 0, 1046, 0, 0,
+	0, 0, 0, 0, 1050, 1051, 1052, 0, 0, 0,
+	0, 0, 0, 1061, 0, 0, 0, 0, 1067, 0,
+	1068, 1069, 1070, 1071, 0, 0, 0, 361, 0, 0,
+	0, 0, 0, 0, 0, 0, 1078, 0, 1079, 0,
+	0, 0, 0, 0, 0, 0, 469, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 506, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 506, 0,
+	971, 0, 0, 0, 976, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 361, 0, 361, 0, 0, 0,
+	0, 0, 0, 0, 361, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1024, 0, 0, 0, 0, 1029,
+	1030, 0, 0, 0, 0, 1260, 0, 51, 0, 0,
+	0, 0, 0, 0, 361, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1275, 1276, 1277,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1215, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 548, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1278, 0, 57, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1100, 237,
+	0, 0, 258, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 971, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1351, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1330, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1375, 0, 0, 0, 0, 1337, 0, 0, 0, 1158,
+	361, 0, 361, 0, 0, 1076, 1387, 0, 0, 0,
+	0, 0, 1348, 1343, 0, 1392, 0, 0, 0, 0,
+	0, 0, 0, 1354, 0, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1366, 1367, 1368,
+	0, 0, 361, 1372, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1382, 1383, 1384, 1385,
+	0, 506, 0, 0, 0, 0, 361, 0, 0, 0,
+	278, 0, 0, 237, 237, 0, 348, 0, 0, 0,
+	0, 854, 0, 0, 535, 1100, 0, 0, 0, 854,
+	0, 237, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1274, 237, 0, 237, 0, 0, 0, 0,
+	0, 237, 1260, 0, 237, 1449, 0, 0, 0, 51,
+	0, 0, 0, 1290, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1313, 57, 0,
+	0, 971, 0, 0, 0, 0, 1322, 0, 0, 1488,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1443, 1334, 0, 0, 0, 0, 1260, 0,
+	51, 0, 0, 0, 0, 0, 1336, 0, 0, 1457,
+	1458, 1459, 0, 1339, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1346, 0, 0, 0, 361, 0, 237, 0, 0,
+	361, 0, 1352, 643, 237, 651, 237, 0, 1491, 0,
+	0, 0, 0, 1494, 1495, 0, 1496, 1497, 0, 0,
+	0, 0, 0, 0, 0, 0, 1504, 0, 0, 0,
+	0, 0, 1508, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	976, 0, 0, 976, 976, 976, 0, 1397, 0, 0,
+	0, 0, 0, 0, 1534, 0, 0, 0, 0, 1539,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1590, 0, 0, 0, 0, 0, 0, 0, 1593,
+	0, 0, 1547, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 361, 361,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 361, 0, 0, 0, 0,
+	237, 237, 0, 0, 237, 0, 0, 237, 0, 0,
+	0, 760, 0, 0, 0, 0, 0, 0, 0, 361,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1599, 0, 237, 1600, 1601, 1452, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1290, 0,
+	0, 0, 237, 0, 0, 0, 0, 0, 0, 1313,
+	0, 0, 760, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 976, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 278, 0, 0, 0,
+	0, 278, 278, 0, 0, 855, 855, 278, 0, 0,
+	0, 855, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 278, 278, 278, 278, 0, 0, 237, 0, 0,
+	855, 237, 237, 237, 237, 237, 0, 0, 0, 854,
+	0, 0, 0, 1452, 0, 0, 0, 0, 0, 0,
+	894, 0, 237, 0, 0, 0, 651, 0, 0, 0,
+	0, 237, 237, 0, 0, 0, 1549, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1570,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 976, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1570, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 237,
+	0, 0, 0, 0, 0, 0, 0, 0, 237, 0,
+	0, 237, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 760, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 278, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 278, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 237, 0, 237, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 237,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1190, 1191, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 278, 0, 0, 0, 0, 0, 0,
+	0, 278, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 278, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 760, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 855,
+	0, 0, 0, 0, 0, 0, 0, 855, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 237, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 651, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 237, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 278, 447,
+	167, 97, 437, 0, 409, 449, 387, 401, 457, 402,
+	403, 430, 373, 417, 113, 399, 0, 390, 368, 396,
+	369, 388, 411, 79, 414, 386, 439, 420, 94, 455,
+	96, 425, 0, 136, 106, 0, 0, 413, 441, 415,
+	435, 408, 431, 378, 424, 450, 400, 428, 451, 0,
+	0, 0, 283, 0, 0, 139, 0, 0, 0, 0,
+	0, 0, 71, 0, 59, 427, 446, 398, 429, 367,
+	426, 0, 371, 374, 456, 444, 393, 394, 0, 0,
+	0, 0, 0, 0, 0, 412, 416, 432, 406, 0,
+	0, 0, 0, 0, 0, 802, 0, 391, 0, 423,
+	0, 0, 0, 375, 372, 0, 410, 855, 0, 0,
+	377, 0, 392, 433, 1542, 366, 436, 442, 407, 160,
+	445, 405, 404, 448, 122, 0, 0, 140, 85, 84,
+	93, 440, 389, 397, 75, 395, 129, 115, 152, 422,
+	118, 128, 98, 144, 123, 151, 161, 162, 142, 159,
+	63, 141, 150, 72, 131, 65, 148, 138, 104, 89,
+	90, 64, 0, 127, 78, 82, 77, 112, 145, 146,
+	76, 169, 68, 158, 67, 69, 157, 111, 143, 149,
+	105, 102, 66, 147, 103, 101, 92, 80, 86, 119,
+	100, 120, 87, 108, 107, 109, 0, 370, 0, 137,
+	155, 170, 385, 443, 163, 164, 165, 166, 0, 0,
+	0, 110, 70, 88, 134, 91, 99, 126, 168, 114,
+	130, 73, 154, 135, 381, 384, 379, 380, 418, 419,
+	452, 453, 454, 434, 376, 0, 382, 383, 0, 438,
+	133, 125, 132, 74, 116, 153, 121, 83, 117, 186,
+	173, 189, 172, 190, 183, 192, 180, 185, 179, 176,
+	178, 193, 177, 174, 181, 184, 182, 175, 187, 188,
+	171, 191, 421, 62, 0, 95, 0, 124, 81, 156,
+	447, 167, 97, 437, 0, 409, 449, 387, 401, 457,
+	402, 403, 430, 373, 417, 113, 399, 0, 390, 368,
+	396, 369, 388, 411, 79, 414, 386, 439, 420, 94,
+	455, 96, 425, 0, 136, 106, 0, 0, 413, 441,
+	415, 435, 408, 431, 378, 424, 450, 400, 428, 451,
+	52, 0, 0, 223, 0, 0, 139, 0, 0, 0,
+	0, 0, 0, 71, 0, 0, 427, 446, 398, 429,
+	367, 426, 0, 371, 374, 456, 444, 393, 394, 0,
+	0, 0, 0, 0, 0, 0, 412, 416, 432, 406,
+	0, 0, 0, 0, 0, 0, 0, 0, 391, 0,
+	423, 0, 0, 0, 375, 372, 0, 410, 0, 0,
+	0, 377, 0, 392, 433, 0, 366, 436, 442, 407,
+	160, 445, 405, 404, 448, 122, 0, 0, 140, 85,
+	84, 93, 440, 389, 397, 75, 395, 129, 115, 152,
+	422, 118, 128, 98, 144, 123, 151, 161, 162, 142,
+	159, 63, 141, 150, 72, 131, 65, 148, 138, 104,
+	89, 90, 64, 0, 127, 78, 82, 77, 112, 145,
+	146, 76, 169, 68, 158, 67, 69, 157, 111, 143,
+	149, 105, 102, 66, 147, 103, 101, 92, 80, 86,
+	119, 100, 120, 87, 108, 107, 109, 0, 370, 0,
+	137, 155, 170, 385, 443, 163, 164, 165, 166, 0,
+	0, 0, 110, 70, 88, 134, 91, 99, 126, 168,
+	114, 130, 73, 154, 135, 381, 384, 379, 380, 418,
+	419, 452, 453, 454, 434, 376, 0, 382, 383, 0,
+	438, 133, 125, 132, 74, 116, 153, 121, 83, 117,
+	186, 173, 189, 172, 190, 183, 192, 180, 185, 179,
+	176, 178, 193, 177, 174, 181, 184, 182, 175, 187,
+	188, 171, 191, 421, 62, 0, 95, 0, 124, 81,
+	156, 447, 167, 97, 437, 0, 409, 449, 387, 401,
+	457, 402, 403, 430, 373, 417, 113, 399, 0, 390,
+	368, 396, 369, 388, 411, 79, 414, 386, 439, 420,
+	94, 455, 96, 425, 0, 136, 106, 0, 0, 413,
+	441, 415, 435, 408, 431, 378, 424, 450, 400, 428,
+	451, 0, 0, 0, 283, 0, 0, 139, 0, 0,
+	0, 0, 0, 0, 71, 0, 59, 427, 446, 398,
+	429, 367, 426, 0, 371, 374, 456, 444, 393, 394,
+	0, 0, 0, 0, 0, 0, 0, 412, 416, 432,
+	406, 0, 0, 0, 0, 0, 0, 0, 0, 391,
+	0, 423, 0, 0, 0, 375, 372, 0, 410, 0,
+	0, 0, 377, 0, 392, 433, 0, 366, 436, 442,
+	407, 160, 445, 405, 404, 448, 122, 0, 0, 140,
+	85, 84, 93, 440, 389, 397, 75, 395, 129, 115,
+	152, 422, 118, 128, 98, 144, 123, 151, 161, 162,
+	142, 159, 63, 141, 150, 72, 131, 65, 148, 138,
+	104, 89, 90, 64, 0, 127, 78, 82, 77, 112,
+	145, 146, 76, 169, 68, 158, 67, 69, 157, 111,
+	143, 149, 105, 102, 66, 147, 103, 101, 92, 80,
+	86, 119, 100, 120, 87, 108, 107, 109, 0, 370,
+	0, 137, 155, 170, 385, 443, 163, 164, 165, 166,
+	0, 0, 0, 110, 70, 88, 134, 91, 99, 126,
+	168, 114, 130, 73, 154, 135, 381, 384, 379, 380,
+	418, 419, 452, 453, 454, 434, 376, 0, 382, 383,
+	0, 438, 133, 125, 132, 74, 116, 153, 121, 83,
+	117, 186, 173, 189, 172, 190, 183, 192, 180, 185,
+	179, 176, 178, 193, 177, 174, 181, 184, 182, 175,
+	187, 188, 171, 191, 421, 62, 0, 95, 0, 124,
+	81, 156, 447, 167, 97, 437, 0, 409, 449, 387,
+	401, 457, 402, 403, 430, 373, 417, 113, 399, 0,
+	390, 368, 396, 369, 388, 411, 79, 414, 386, 439,
+	420, 94, 455, 96, 425, 0, 136, 106, 0, 0,
+	413, 441, 415, 435, 408, 431, 378, 424, 450, 400,
+	428, 451, 0, 0, 0, 223, 0, 0, 139, 0,
+	0, 0, 0, 0, 0, 71, 0, 0, 427, 446,
+	398, 429, 367, 426, 0, 371, 374, 456, 444, 393,
+	394, 0, 0, 0, 0, 0, 0, 0, 412, 416,
+	432, 406, 0, 0, 0, 0, 0, 0, 1204, 0,
+	391, 0, 423, 0, 0, 0, 375, 372, 0, 410,
+	0, 0, 0, 377, 0, 392, 433, 0, 366, 436,
+	442, 407, 160, 445, 405, 404, 448, 122, 0, 0,
+	140, 85, 84, 93, 440, 389, 397, 75, 395, 129,
+	115, 152, 422, 118, 128, 98, 144, 123, 151, 161,
+	162, 142, 159, 63, 141, 150, 72, 131, 65, 148,
+	138, 104, 89, 90, 64, 0, 127, 78, 82, 77,
+	112, 145, 146, 76, 169, 68, 158, 67, 69, 157,
+	111, 143, 149, 105, 102, 66, 147, 103, 101, 92,
+	80, 86, 119, 100, 120, 87, 108, 107, 109, 0,
+	370, 0, 137, 155, 170, 385, 443, 163, 164, 165,
+	166, 0, 0, 0, 110, 70, 88, 134, 91, 99,
+	126, 168, 114, 130, 73, 154, 135, 381, 384, 379,
+	380, 418, 419, 452, 453, 454, 434, 376, 0, 382,
+	383, 0, 438, 133, 125, 132, 74, 116, 153, 121,
+	83, 117, 186, 173, 189, 172, 190, 183, 192, 180,
+	185, 179, 176, 178, 193, 177, 174, 181, 184, 182,
+	175, 187, 188, 171, 191, 421, 62, 0, 95, 0,
+	124, 81, 156, 447, 167, 97, 437, 0, 409, 449,
+	387, 401, 457, 402, 403, 430, 373, 417, 113, 399,
+	0, 390, 368, 396, 369, 388, 411, 79, 414, 386,
+	439, 420, 94, 455, 96, 425, 0, 136, 106, 0,
+	0, 413, 441, 415, 435, 408, 431, 378, 424, 450,
+	400, 428, 451, 0, 0, 0, 58, 0, 0, 139,
+	0, 0, 0, 0, 0, 0, 71, 0, 59, 427,
+	446, 398, 429, 367, 426, 0, 371, 374, 456, 444,
+	393, 394, 0, 0, 0, 0, 0, 0, 0, 412,
+	416, 432, 406, 0, 0, 0, 0, 0, 0, 0,
+	0, 391, 0, 423, 0, 0, 0, 375, 372, 0,
+	410, 0, 0, 0, 377, 0, 392, 433, 0, 366,
+	436, 442, 407, 160, 445, 405, 404, 448, 122, 0,
+	0, 140, 85, 84, 93, 440, 389, 397, 75, 395,
+	129, 115, 152, 422, 118, 128, 98, 144, 123, 151,
+	161, 162, 142, 159, 63, 141, 150, 72, 131, 65,
+	148, 138, 104, 89, 90, 64, 0, 127, 78, 82,
+	77, 112, 145, 146, 76, 169, 68, 158, 67, 69,
+	157, 111, 143, 149, 105, 102, 66, 147, 103, 101,
+	92, 80, 86, 119, 100, 120, 87, 108, 107, 109,
+	0, 370, 0, 137, 155, 170, 385, 443, 163, 164,
+	165, 166, 0, 0, 0, 110, 70, 88, 134, 91,
+	99, 126, 168, 114, 130, 73, 154, 135, 381, 384,
+	379, 380, 418, 419, 452, 453, 454, 434, 376, 0,
+	382, 383, 0, 438, 133, 125, 132, 74, 116, 153,
+	121, 83, 117, 186, 173, 189, 172, 190, 183, 192,
+	180, 185, 179, 176, 178, 193, 177, 174, 181, 184,
+	182, 175, 187, 188, 171, 191, 421, 62, 0, 95,
+	0, 124, 81, 156, 447, 167, 97, 437, 0, 409,
+	449, 387, 401, 457, 402, 403, 430, 373, 417, 113,
+	399, 0, 390, 368, 396, 369, 388, 411, 79, 414,
+	386, 439, 420, 94, 455, 96, 425, 0, 136, 106,
+	0, 0, 413, 441, 415, 435, 408, 431, 378, 424,
+	450, 400, 428, 451, 0, 0, 0, 223, 0, 0,
+	139, 0, 0, 0, 0, 0, 0, 71, 0, 0,
+	427, 446, 398, 429, 367, 426, 0, 371, 374, 456,
+	444, 393, 394, 0, 0, 0, 0, 0, 0, 0,
+	412, 416, 432, 406, 0, 0, 0, 0, 0, 0,
+	0, 0, 391, 0, 423, 0, 0, 0, 375, 372,
+	0, 410, 0, 0, 0, 377, 0, 392, 433, 0,
+	366, 436, 442, 407, 160, 445, 405, 404, 448, 122,
+	0, 0, 140, 85, 84, 93, 440, 389, 397, 75,
+	395, 129, 115, 152, 422, 118, 128, 98, 144, 123,
+	151, 161, 162, 142, 159, 63, 141, 150, 72, 131,
+	65, 148, 138, 104, 89, 90, 64, 0, 127, 78,
+	82, 77, 112, 145, 146, 76, 169, 68, 158, 67,
+	69, 157, 111, 143, 149, 105, 102, 66, 147, 103,
+	101, 92, 80, 86, 119, 100, 120, 87, 108, 107,
+	109, 0, 370, 0, 137, 155, 170, 385, 443, 163,
+	164, 165, 166, 0, 0, 0, 110, 70, 88, 134,
+	91, 99, 126, 168, 114, 130, 73, 154, 135, 381,
+	384, 379, 380, 418, 419, 452, 453, 454, 434, 376,
+	0, 382, 383, 0, 438, 133, 125, 132, 74, 116,
+	153, 121, 83, 117, 186, 173, 189, 172, 190, 183,
+	192, 180, 185, 179, 176, 178, 193, 177, 174, 181,
+	184, 182, 175, 187, 188, 171, 191, 421, 62, 0,
+	95, 0, 124, 81, 156, 447, 167, 97, 437, 0,
+	409, 449, 387, 401, 457, 402, 403, 430, 373, 417,
+	113, 399, 0, 390, 368, 396, 369, 388, 411, 79,
+	414, 386, 439, 420, 94, 455, 96, 425, 0, 136,
+	106, 0, 0, 413, 441, 415, 435, 408, 431, 378,
+	424, 450, 400, 428, 451, 0, 0, 0, 223, 0,
+	0, 139, 0, 0, 0, 0, 0, 0, 71, 0,
+	0, 427, 446, 398, 429, 367, 426, 0, 371, 374,
+	456, 444, 393, 394, 0, 0, 0, 0, 0, 0,
+	0, 412, 416, 432, 406, 0, 0, 0, 0, 0,
+	0, 0, 0, 391, 0, 423, 0, 0, 0, 375,
+	372, 0, 410, 0, 0, 0, 377, 0, 392, 433,
+	0, 366, 436, 442, 407, 160, 445, 405, 404, 448,
+	122, 0, 0, 140, 85, 84, 93, 440, 389, 397,
+	75, 395, 129, 115, 152, 422, 118, 128, 98, 144,
+	123, 151, 161, 162, 142, 159, 63, 141, 150, 72,
+	131, 65, 148, 138, 104, 89, 90, 64, 0, 127,
+	78, 82, 77, 112, 145, 146, 76, 169, 68, 158,
+	67, 364, 157, 111, 143, 149, 105, 102, 66, 147,
+	103, 101, 92, 80, 86, 119, 100, 120, 87, 108,
+	107, 109, 0, 370, 0, 137, 155, 170, 385, 443,
+	163, 164, 165, 166, 0, 0, 0, 365, 363, 88,
+	134, 91, 99, 126, 168, 114, 130, 73, 154, 135,
+	381, 384, 379, 380, 418, 419, 452, 453, 454, 434,
+	376, 0, 382, 383, 0, 438, 133, 125, 132, 74,
+	116, 153, 121, 83, 117, 186, 173, 189, 172, 190,
+	183, 192, 180, 185, 179, 176, 178, 193, 177, 174,
+	181, 184, 182, 175, 187, 188, 171, 191, 421, 62,
+	0, 95, 0, 124, 81, 156, 447, 167, 97, 437,
+	0, 409, 449, 387, 401, 457, 402, 403, 430, 373,
+	417, 113, 399, 0, 390, 368, 396, 369, 388, 411,
+	79, 414, 386, 439, 420, 94, 455, 96, 425, 0,
+	136, 106, 0, 0, 413, 441, 415, 435, 408, 431,
+	378, 424, 450, 400, 428, 451, 0, 0, 0, 223,
+	0, 0, 139, 0, 0, 0, 0, 0, 0, 71,
+	0, 0, 427, 446, 398, 429, 367, 426, 0, 371,
+	374, 456, 444, 393, 394, 0, 0, 0, 0, 0,
+	0, 0, 412, 416, 432, 406, 0, 0, 0, 0,
+	0, 0, 0, 0, 391, 0, 423, 0, 0, 0,
+	375, 372, 0, 410, 0, 0, 0, 377, 0, 392,
+	433, 0, 366, 436, 442, 407, 160, 445, 405, 404,
+	448, 122, 0, 0, 140, 85, 84, 93, 440, 389,
+	397, 75, 395, 129, 115, 152, 422, 118, 128, 98,
+	144, 123, 151, 161, 162, 142, 159, 63, 141, 661,
+	72, 131, 65, 148, 138, 104, 89, 90, 64, 0,
+	127, 78, 82, 77, 112, 145, 146, 76, 169, 68,
+	158, 67, 364, 157, 111, 143, 149, 105, 102, 66,
+	147, 103, 101, 92, 80, 86, 119, 100, 120, 87,
+	108, 107, 109, 0, 370, 0, 137, 155, 170, 385,
+	443, 163, 164, 165, 166, 0, 0, 0, 365, 363,
+	88, 134, 91, 99, 126, 168, 114, 130, 73, 154,
+	135, 381, 384, 379, 380, 418, 419, 452, 453, 454,
+	434, 376, 0, 382, 383, 0, 438, 133, 125, 132,
+	74, 116, 153, 121, 83, 117, 186, 173, 189, 172,
+	190, 183, 192, 180, 185, 179, 176, 178, 193, 177,
+	174, 181, 184, 182, 175, 187, 188, 171, 191, 421,
+	62, 0, 95, 0, 124, 81, 156, 447, 167, 97,
+	437, 0, 409, 449, 387, 401, 457, 402, 403, 430,
+	373, 417, 113, 399, 0, 390, 368, 396, 369, 388,
+	411, 79, 414, 386, 439, 420, 94, 455, 96, 425,
+	0, 136, 106, 0, 0, 413, 441, 415, 435, 408,
+	431, 378, 424, 450, 400, 428, 451, 0, 0, 0,
+	223, 0, 0, 139, 0, 0, 0, 0, 0, 0,
+	71, 0, 0, 427, 446, 398, 429, 367, 426, 0,
+	371, 374, 456, 444, 393, 394, 0, 0, 0, 0,
+	0, 0, 0, 412, 416, 432, 406, 0, 0, 0,
+	0, 0, 0, 0, 0, 391, 0, 423, 0, 0,
+	0, 375, 372, 0, 410, 0, 0, 0, 377, 0,
+	392, 433, 0, 366, 436, 442, 407, 160, 445, 405,
+	404, 448, 122, 0, 0, 140, 85, 84, 93, 440,
+	389, 397, 75, 395, 129, 115, 152, 422, 118, 128,
+	98, 144, 123, 151, 161, 162, 142, 159, 63, 141,
+	355, 72, 131, 65, 148, 138, 104, 89, 90, 64,
+	0, 127, 78, 82, 77, 112, 145, 146, 76, 169,
+	68, 158, 67, 364, 157, 111, 143, 149, 105, 102,
+	66, 147, 103, 101, 92, 80, 86, 119, 100, 120,
+	87, 108, 107, 109, 0, 370, 0, 137, 155, 170,
+	385, 443, 163, 164, 165, 166, 0, 0, 0, 365,
+	363, 358, 357, 91, 99, 126, 168, 114, 130, 73,
+	154, 135, 381, 384, 379, 380, 418, 419, 452, 453,
+	454, 434, 376, 0, 382, 383, 0, 438, 133, 125,
+	132, 74, 116, 153, 121, 83, 117, 186, 173, 189,
+	172, 190, 183, 192, 180, 185, 179, 176, 178, 193,
+	177, 174, 181, 184, 182, 175, 187, 188, 171, 191,
+	421, 62, 0, 95, 0, 124, 81, 156, 447, 167,
+	97, 437, 0, 409, 449, 387, 401, 457, 402, 403,
+	430, 373, 417, 113, 399, 0, 390, 368, 396, 369,
+	388, 411, 79, 414, 386, 439, 420, 94, 455, 96,
+	425, 0, 136, 106, 0, 0, 413, 441, 415, 435,
+	408, 431, 378, 424, 450, 400, 428, 451, 0, 0,
+	0, 924, 0, 927, 139, 928, 0, 0, 0, 0,
+	0, 921, 0, 0, 427, 446, 398, 429, 367, 426,
+	0, 371, 374, 456, 444, 393, 394, 0, 0, 0,
+	0, 0, 0, 0, 412, 416, 432, 406, 0, 0,
+	0, 0, 0, 0, 0, 0, 391, 0, 423, 0,
+	0, 0, 375, 372, 0, 410, 0, 0, 0, 377,
+	0, 392, 433, 0, 366, 436, 442, 407, 160, 445,
+	405, 404, 448, 122, 0, 0, 140, 85, 84, 93,
+	440, 389, 397, 75, 395, 129, 115, 152, 422, 118,
+	128, 98, 144, 123, 151, 161, 162, 142, 159, 63,
+	141, 150, 72, 131, 65, 148, 138, 104, 89, 90,
+	64, 0, 127, 78, 82, 77, 112, 145, 146, 76,
+	169, 68, 158, 67, 69, 157, 111, 143, 149, 105,
+	102, 66, 147, 103, 101, 92, 80, 86, 119, 100,
+	120, 87, 108, 107, 109, 0, 370, 0, 137, 155,
+	170, 385, 443, 163, 164, 165, 166, 0, 0, 0,
+	110, 70, 88, 134, 91, 99, 126, 168, 114, 130,
+	73, 154, 135, 381, 384, 379, 380, 418, 419, 452,
+	453, 454, 434, 376, 0, 382, 383, 0, 438, 133,
+	125, 923, 74, 116, 153, 121, 83, 117, 205, 211,
+	0, 0, 213, 209, 210, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 421, 62, 0, 95, 0, 124, 81, 156, 447,
+	167, 97, 437, 0, 409, 449, 387, 401, 457, 402,
+	403, 430, 373, 417, 113, 399, 0, 390, 368, 396,
+	369, 388, 411, 79, 414, 386, 439, 420, 94, 455,
+	96, 425, 0, 136, 106, 0, 0, 413, 441, 415,
+	435, 408, 431, 378, 424, 450, 400, 428, 451, 0,
+	0, 0, 924, 0, 927, 139, 928, 0, 0, 0,
+	0, 0, 71, 0, 0, 427, 446, 398, 429, 367,
+	426, 0, 371, 374, 456, 444, 393, 394, 1114, 0,
+	0, 0, 0, 0, 0, 412, 416, 432, 406, 0,
+	0, 0, 0, 0, 0, 0, 0, 391, 0, 423,
+	0, 0, 0, 375, 372, 0, 410, 0, 0, 0,
+	377, 0, 392, 433, 0, 366, 436, 442, 407, 160,
+	445, 405, 404, 448, 122, 0, 0, 140, 85, 84,
+	93, 440, 389, 397, 75, 395, 129, 115, 152, 422,
+	118, 128, 98, 144, 123, 151, 161, 162, 142, 159,
+	63, 141, 150, 72, 131, 65, 148, 138, 104, 89,
+	90, 64, 0, 127, 78, 82, 77, 112, 145, 146,
+	76, 169, 68, 158, 67, 69, 157, 111, 143, 149,
+	105, 102, 66, 147, 103, 101, 92, 80, 86, 119,
+	100, 120, 87, 108, 107, 109, 0, 370, 0, 137,
+	155, 170, 385, 443, 163, 164, 165, 166, 0, 0,
+	0, 110, 70, 88, 134, 91, 99, 126, 168, 114,
+	130, 73, 154, 135, 381, 384, 379, 380, 418, 419,
+	452, 453, 454, 434, 376, 0, 382, 383, 0, 438,
+	133, 125, 132, 74, 116, 153, 121, 83, 117, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 421, 62, 0, 95, 0, 124, 81, 156,
+	447, 167, 97, 437, 0, 409, 449, 387, 401, 457,
+	402, 403, 430, 373, 417, 113, 399, 0, 390, 368,
+	396, 369, 388, 411, 79, 414, 386, 439, 420, 94,
+	455, 96, 425, 0, 136, 106, 0, 0, 413, 441,
+	415, 435, 408, 431, 378, 424, 450, 400, 428, 451,
+	0, 0, 0, 924, 0, 927, 139, 928, 0, 0,
+	0, 0, 0, 71, 0, 0, 427, 446, 398, 429,
+	367, 426, 0, 371, 374, 456, 444, 393, 394, 0,
+	0, 0, 0, 0, 0, 0, 412, 416, 432, 406,
+	0, 0, 0, 0, 0, 0, 0, 0, 391, 0,
+	423, 0, 0, 0, 375, 372, 0, 410, 0, 0,
+	0, 377, 0, 392, 433, 0, 366, 436, 442, 407,
+	160, 445, 405, 404, 448, 122, 0, 0, 140, 85,
+	84, 93, 440, 389, 397, 75, 395, 129, 115, 152,
+	422, 118, 128, 98, 144, 123, 151, 161, 162, 142,
+	159, 63, 141, 150, 72, 131, 65, 148, 138, 104,
+	89, 90, 64, 0, 127, 78, 82, 77, 112, 145,
+	146, 76, 169, 68, 158, 67, 69, 157, 111, 143,
+	149, 105, 102, 66, 147, 103, 101, 92, 80, 86,
+	119, 100, 120, 87, 108, 107, 109, 0, 370, 0,
+	137, 155, 170, 385, 443, 163, 164, 165, 166, 0,
+	0, 0, 110, 70, 88, 134, 91, 99, 126, 168,
+	114, 130, 73, 154, 135, 381, 384, 379, 380, 418,
+	419, 452, 453, 454, 434, 376, 0, 382, 383, 0,
+	438, 133, 125, 132, 74, 116, 153, 121, 83, 117,
+	0, 0, 0, 0, 0, 0, 0, 0, 167, 97,
+	48, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 113, 421, 62, 0, 95, 287, 124, 81,
+	156, 79, 0, 282, 0, 0, 94, 327, 96, 0,
+	0, 136, 106, 0, 0, 0, 0, 318, 319, 0,
+	0, 0, 0, 0, 0, 0, 0, 52, 0, 0,
+	283, 306, 305, 139, 308, 309, 310, 311, 0, 0,
+	71, 307, 284, 312, 313, 314, 0, 0, 280, 299,
+	0, 326, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 296, 297, 0, 0, 0, 0, 338, 0, 298,
+	0, 0, 294, 295, 300, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 160, 0, 0,
+	336, 0, 122, 0, 0, 140, 85, 84, 93, 0,
+	0, 0, 75, 0, 129, 115, 152, 0, 118, 128,
+	98, 144, 123, 151, 161, 162, 142, 159, 63, 141,
+	150, 72, 131, 65, 148, 138, 104, 89, 90, 64,
+	0, 127, 78, 82, 77, 112, 145, 146, 76, 169,
+	68, 158, 67, 69, 157, 111, 143, 149, 105, 102,
+	66, 147, 103, 101, 92, 80, 86, 119, 100, 120,
+	87, 108, 107, 109, 0, 0, 0, 137, 155, 170,
+	0, 0, 163, 164, 165, 166, 0, 0, 0, 110,
+	70, 88, 134, 91, 99, 126, 168, 114, 130, 73,
+	154, 135, 328, 337, 334, 335, 332, 333, 331, 330,
+	329, 339, 320, 321, 322, 323, 325, 0, 133, 125,
+	132, 74, 116, 153, 121, 83, 117, 186, 173, 189,
+	172, 190, 183, 192, 180, 185, 179, 176, 178, 193,
+	177, 174, 181, 184, 182, 175, 187, 188, 171, 191,
+	324, 62, 0, 95, 21, 124, 81, 156, 167, 97,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 113, 0, 0, 842, 0, 287, 0, 0,
+	0, 79, 0, 282, 0, 0, 94, 327, 96, 0,
+	0, 136, 106, 0, 0, 0, 0, 318, 319, 0,
+	0, 0, 0, 0, 0, 0, 0, 52, 0, 0,
+	283, 306, 305, 139, 308, 309, 310, 311, 0, 0,
+	71, 307, 284, 312, 313, 314, 0, 0, 280, 299,
+	0, 326, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 296, 297, 276, 0, 0, 0, 338, 0, 298,
+	0, 0, 294, 295, 300, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 160, 0, 0,
+	336, 0, 122, 0, 0, 140, 85, 84, 93, 0,
+	0, 0, 75, 0, 129, 115, 152, 0, 118, 128,
+	98, 144, 123, 151, 161, 162, 142, 159, 63, 141,
+	150, 72, 131, 65, 148, 138, 104, 89, 90, 64,
+	0, 127, 78, 82, 77, 112, 145, 146, 76, 169,
+	68, 158, 67, 69, 157, 111, 143, 149, 105, 102,
+	66, 147, 103, 101, 92, 80, 86, 119, 100, 120,
+	87, 108, 107, 109, 0, 0, 0, 137, 155, 170,
+	0, 0, 163, 164, 165, 166, 0, 0, 0, 110,
+	70, 88, 134, 91, 99, 126, 168, 114, 130, 73,
+	154, 135, 328, 337, 334, 335, 332, 333, 331, 330,
+	329, 339, 320, 321, 322, 323, 325, 0, 133, 125,
+	132, 74, 116, 153, 121, 83, 117, 186, 173, 189,
+	172, 190, 183, 192, 180, 185, 179, 176, 178, 193,
+	177, 174, 181, 184, 182, 175, 187, 188, 171, 191,
+	324, 62, 0, 95, 0, 124, 81, 156, 167, 97,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 113, 0, 0, 0, 0, 287, 0, 0,
+	0, 79, 0, 282, 0, 0, 94, 327, 96, 0,
+	0, 136, 106, 0, 0, 0, 0, 318, 319, 0,
+	0, 0, 0, 0, 0, 0, 0, 52, 0, 0,
+	283, 306, 305, 139, 308, 309, 310, 311, 0, 0,
+	71, 307, 284, 312, 313, 314, 0, 0, 280, 299,
+	0, 326, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 296, 297, 276, 0, 0, 0, 338, 0, 298,
+	0, 0, 294, 295, 300, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 160, 0, 0,
+	336, 0, 122, 0, 0, 140, 85, 84, 93, 0,
+	0, 0, 75, 0, 129, 115, 152, 0, 118, 128,
+	98, 144, 123, 151, 161, 162, 142, 159, 63, 141,
+	150, 72, 131, 65, 148, 138, 104, 89, 90, 64,
+	0, 127, 78, 82, 77, 112, 145, 146, 76, 169,
+	68, 158, 67, 69, 157, 111, 143, 149, 105, 102,
+	66, 147, 103, 101, 92, 80, 86, 119, 100, 120,
+	87, 108, 107, 109, 0, 0, 0, 137, 155, 170,
+	0, 0, 163, 164, 165, 166, 0, 0, 0, 110,
+	70, 88, 134, 91, 99, 126, 168, 114, 130, 73,
+	154, 135, 328, 337, 334, 335, 332, 333, 331, 330,
+	329, 339, 320, 321, 322, 323, 325, 0, 133, 125,
+	132, 74, 116, 153, 121, 83, 117, 186, 173, 189,
+	172, 190, 183, 192, 180, 185, 179, 176, 178, 193,
+	177, 174, 181, 184, 182, 175, 187, 188, 171, 191,
+	324, 62, 0, 95, 0, 124, 81, 156, 167, 97,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 113, 0, 0, 0, 0, 287, 0, 0,
+	0, 79, 0, 282, 0, 0, 94, 327, 96, 0,
+	0, 136, 106, 0, 0, 0, 0, 318, 319, 0,
+	0, 0, 0, 0, 0, 0, 0, 52, 0, 531,
+	283, 306, 305, 139, 308, 309, 310, 311, 0, 0,
+	71, 307, 284, 312, 313, 314, 0, 0, 280, 299,
+	0, 326, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 296, 297, 0, 0, 0, 0, 338, 0, 298,
+	0, 0, 294, 295, 300, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 160, 0, 0,
+	336, 0, 122, 0, 0, 140, 85, 84, 93, 0,
+	0, 0, 75, 0, 129, 115, 152, 0, 118, 128,
+	98, 144, 123, 151, 161, 162, 142, 159, 63, 141,
+	150, 72, 131, 65, 148, 138, 104, 89, 90, 64,
+	0, 127, 78, 82, 77, 112, 145, 146, 76, 169,
+	68, 158, 67, 69, 157, 111, 143, 149, 105, 102,
+	66, 147, 103, 101, 92, 80, 86, 119, 100, 120,
+	87, 108, 107, 109, 0, 0, 0, 137, 155, 170,
+	0, 0, 163, 164, 165, 166, 0, 0, 0, 110,
+	70, 88, 134, 91, 99, 126, 168, 114, 130, 73,
+	154, 135, 328, 337, 334, 335, 332, 333, 331, 330,
+	329, 339, 320, 321, 322, 323, 325, 0, 133, 125,
+	132, 74, 116, 153, 121, 83, 117, 186, 173, 189,
+	172, 190, 183, 192, 180, 185, 179, 176, 178, 193,
+	177, 174, 181, 184, 182, 175, 187, 188, 171, 191,
+	324, 62, 0, 95, 0, 124, 81, 156, 167, 97,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 113, 0, 0, 0, 0, 287, 0, 0,
+	0, 79, 0, 282, 0, 0, 94, 327, 96, 0,
+	0, 136, 106, 0, 0, 0, 0, 318, 319, 0,
+	0, 0, 0, 0, 0, 912, 0, 52, 0, 0,
+	283, 306, 305, 139, 308, 309, 310, 311, 0, 0,
+	71, 307, 284, 312, 313, 314, 0, 0, 280, 299,
+	0, 326, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 296, 297, 0, 0, 0, 0, 338, 0, 298,
+	0, 0, 294, 295, 300, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 160, 0, 0,
+	336, 0, 122, 0, 0, 140, 85, 84, 93, 0,
+	0, 0, 75, 0, 129, 115, 152, 0, 118, 128,
+	98, 144, 123, 151, 161, 162, 142, 159, 63, 141,
+	150, 72, 131, 65, 148, 138, 104, 89, 90, 64,
+	0, 127, 78, 82, 77, 112, 145, 146, 76, 169,
+	68, 158, 67, 69, 157, 111, 143, 149, 105, 102,
+	66, 147, 103, 101, 92, 80, 86, 119, 100, 120,
+	87, 108, 107, 109, 0, 0, 0, 137, 155, 170,
+	0, 0, 163, 164, 165, 166, 0, 0, 0, 110,
+	70, 88, 134, 91, 99, 126, 168, 114, 130, 73,
+	154, 135, 328, 337, 334, 335, 332, 333, 331, 330,
+	329, 339, 320, 321, 322, 323, 325, 0, 133, 125,
+	132, 74, 116, 153, 121, 83, 117, 186, 173, 189,
+	172, 190, 183, 192, 180, 185, 179, 176, 178, 193,
+	177, 174, 181, 184, 182, 175, 187, 188, 171, 191,
+	324, 62, 0, 95, 0, 124, 81, 156, 167, 97,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 113, 0, 0, 0, 0, 287, 0, 0,
+	0, 79, 0, 282, 0, 0, 94, 327, 96, 0,
+	0, 136, 106, 0, 0, 0, 0, 318, 319, 0,
+	0, 0, 0, 0, 0, 0, 0, 52, 0, 0,
+	283, 306, 305, 139, 308, 309, 310, 311, 0, 0,
+	71, 307, 284, 312, 313, 314, 0, 0, 280, 299,
+	0, 326, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 296, 297, 0, 0, 0, 0, 338, 0, 298,
+	0, 0, 294, 295, 300, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 160, 0, 0,
+	336, 0, 122, 0, 0, 140, 85, 84, 93, 0,
+	0, 0, 75, 0, 129, 115, 152, 0, 118, 128,
+	98, 144, 123, 151, 161, 162, 142, 159, 63, 141,
+	150, 72, 131, 65, 148, 138, 104, 89, 90, 64,
+	0, 127, 78, 82, 77, 112, 145, 146, 76, 169,
+	68, 158, 67, 69, 157, 111, 143, 149, 105, 102,
+	66, 147, 103, 101, 92, 80, 86, 119, 100, 120,
+	87, 108, 107, 109, 0, 0, 0, 137, 155, 170,
+	0, 0, 163, 164, 165, 166, 0, 0, 0, 110,
+	70, 88, 134, 91, 99, 126, 168, 114, 130, 73,
+	154, 135, 328, 337, 334, 335, 332, 333, 331, 330,
+	329, 339, 320, 321, 322, 323, 325, 0, 133, 125,
+	132, 74, 116, 153, 121, 83, 117, 186, 173, 189,
+	172, 190, 183, 192, 180, 185, 179, 176, 178, 193,
+	177, 174, 181, 184, 182, 175, 187, 188, 171, 191,
+	324, 62, 0, 95, 0, 124, 81, 156, 167, 97,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 113, 0, 0, 0, 0, 0, 0, 0,
+	0, 79, 0, 0, 0, 0, 94, 327, 96, 0,
+	0, 136, 106, 0, 0, 0, 0, 318, 319, 0,
+	0, 0, 0, 0, 0, 0, 0, 52, 0, 0,
+	283, 306, 305, 139, 308, 309, 310, 311, 0, 0,
+	71, 307, 284, 312, 313, 314, 0, 0, 0, 299,
+	0, 326, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 296, 297, 0, 0, 0, 0, 338, 0, 298,
+	0, 0, 294, 295, 300, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 160, 0, 0,
+	336, 0, 122, 0, 0, 140, 85, 84, 93, 0,
+	0, 0, 75, 0, 129, 115, 152, 1598, 118, 128,
+	98, 144, 123, 151, 161, 162, 142, 159, 63, 141,
+	150, 72, 131, 65, 148, 138, 104, 89, 90, 64,
+	0, 127, 78, 82, 77, 112, 145, 146, 76, 169,
+	68, 158, 67, 69, 157, 111, 143, 149, 105, 102,
+	66, 147, 103, 101, 92, 80, 86, 119, 100, 120,
+	87, 108, 107, 109, 0, 0, 0, 137, 155, 170,
+	0, 0, 163, 164, 165, 166, 0, 0, 0, 110,
+	70, 88, 134, 91, 99, 126, 168, 114, 130, 73,
+	154, 135, 328, 337, 334, 335, 332, 333, 331, 330,
+	329, 339, 320, 321, 322, 323, 325, 0, 133, 125,
+	132, 74, 116, 153, 121, 83, 117, 186, 173, 189,
+	172, 190, 183, 192, 180, 185, 179, 176, 178, 193,
+	177, 174, 181, 184, 182, 175, 187, 188, 171, 191,
+	324, 62, 0, 95, 0, 124, 81, 156, 167, 97,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 113, 0, 0, 0, 0, 0, 0, 0,
+	0, 79, 0, 0, 0, 0, 94, 327, 96, 0,
+	0, 136, 106, 0, 0, 0, 0, 318, 319, 0,
 	0, 0, 0, 0, 0, 0, 0, 52, 0, 0,
-	234, 254, 253, 128, 256, 257, 258, 259, 0, 0,
-	69, 255, 260, 261, 262, 0, 0, 231, 247, 0,
-	274, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	244, 245, 227, 0, 0, 0, 286, 0, 246, 0,
-	0, 242, 243, 248, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 148, 0, 0, 284,
-	0, 114, 0, 0, 129, 81, 80, 89, 0, 0,
-	0, 72, 0, 120, 110, 141, 0, 111, 119, 93,
-	133, 115, 140, 149, 150, 131, 147, 61, 130, 139,
-	70, 122, 63, 137, 127, 99, 85, 86, 62, 0,
-	118, 75, 79, 74, 107, 134, 135, 73, 156, 66,
-	146, 65, 67, 145, 106, 132, 138, 100, 97, 64,
-	136, 98, 96, 88, 77, 82, 112, 95, 113, 83,
-	103, 102, 104, 0, 0, 0, 126, 143, 157, 0,
-	0, 151, 152, 153, 154, 0, 0, 0, 105, 68,
-	84, 123, 87, 94, 117, 155, 109, 121, 71, 142,
-	124, 276, 285, 282, 283, 280, 281, 279, 278, 277,
-	287, 268, 269, 270, 271, 273, 108, 272, 60, 0,
-	91, 236, 116, 78, 144, 76, 0, 233, 0, 0,
-	90, 275, 92, 0, 0, 125, 101, 0, 0, 0,
-	0, 266, 267, 0, 0, 0, 0, 0, 0, 809,
-	0, 52, 0, 0, 234, 254, 253, 128, 256, 257,
-	258, 259, 0, 0, 69, 255, 260, 261, 262, 0,
-	0, 231, 247, 0, 274, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 244, 245, 0, 0, 0, 0,
-	286, 0, 246, 0, 0, 242, 243, 248, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	148, 0, 0, 284, 0, 114, 0, 0, 129, 81,
-	80, 89, 0, 0, 0, 72, 0, 120, 110, 141,
-	0, 111, 119, 93, 133, 115, 140, 149, 150, 131,
-	147, 61, 130, 139, 70, 122, 63, 137, 127, 99,
-	85, 86, 62, 0, 118, 75, 79, 74, 107, 134,
-	135, 73, 156, 66, 146, 65, 67, 145, 106, 132,
-	138, 100, 97, 64, 136, 98, 96, 88, 77, 82,
-	112, 95, 113, 83, 103, 102, 104, 0, 0, 0,
-	126, 143, 157, 0, 0, 151, 152, 153, 154, 0,
-	0, 0, 105, 68, 84, 123, 87, 94, 117, 155,
-	109, 121, 71, 142, 124, 276, 285, 282, 283, 280,
-	281, 279, 278, 277, 287, 268, 269, 270, 271, 273,
-	108, 272, 60, 0, 91, 236, 116, 78, 144, 76,
-	0, 233, 0, 0, 90, 275, 92, 0, 0, 125,
-	101, 0, 0, 0, 0, 266, 267, 0, 0, 0,
-	0, 0, 0, 0, 0, 52, 0, 0, 234, 254,
-	253, 128, 256, 257, 258, 259, 0, 0, 69, 255,
-	260, 261, 262, 0, 0, 231, 247, 0, 274, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 244, 245,
-	0, 0, 0, 0, 286, 0, 246, 0, 0, 242,
-	243, 248, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 148, 0, 0, 284, 0, 114,
-	0, 0, 129, 81, 80, 89, 0, 0, 0, 72,
-	0, 120, 110, 141, 0, 111, 119, 93, 133, 115,
-	140, 149, 150, 131, 147, 61, 130, 139, 70, 122,
-	63, 137, 127, 99, 85, 86, 62, 0, 118, 75,
-	79, 74, 107, 134, 135, 73, 156, 66, 146, 65,
-	67, 145, 106, 132, 138, 100, 97, 64, 136, 98,
-	96, 88, 77, 82, 112, 95, 113, 83, 103, 102,
-	104, 0, 0, 0, 126, 143, 157, 0, 0, 151,
-	152, 153, 154, 0, 0, 0, 105, 68, 84, 123,
-	87, 94, 117, 155, 109, 121, 71, 142, 124, 276,
-	285, 282, 283, 280, 281, 279, 278, 277, 287, 268,
-	269, 270, 271, 273, 108, 272, 60, 0, 91, 0,
-	116, 78, 144, 76, 0, 0, 0, 0, 90, 275,
-	92, 0, 0, 125, 101, 0, 0, 0, 0, 266,
-	267, 0, 0, 0, 0, 0, 0, 0, 0, 52,
-	0, 0, 234, 254, 253, 128, 256, 257, 258, 259,
-	0, 0, 69, 255, 260, 261, 262, 0, 0, 0,
-	247, 0, 274, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 244, 245, 0, 0, 0, 0, 286, 0,
-	246, 0, 0, 242, 243, 248, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 148, 0,
-	0, 284, 0, 114, 0, 0, 129, 81, 80, 89,
-	0, 0, 0, 72, 0, 120, 110, 141, 1321, 111,
-	119, 93, 133, 115, 140, 149, 150, 131, 147, 61,
-	130, 139, 70, 122, 63, 137, 127, 99, 85, 86,
-	62, 0, 118, 75, 79, 74, 107, 134, 135, 73,
-	156, 66, 146, 65, 67, 145, 106, 132, 138, 100,
-	97, 64, 136, 98, 96, 88, 77, 82, 112, 95,
-	113, 83, 103, 102, 104, 0, 0, 0, 126, 143,
-	157, 0, 0, 151, 152, 153, 154, 0, 0, 0,
-	105, 68, 84, 123, 87, 94, 117, 155, 109, 121,
-	71, 142, 124, 276, 285, 282, 283, 280, 281, 279,
-	278, 277, 287, 268, 269, 270, 271, 273, 108, 272,
-	60, 0, 91, 0, 116, 78, 144, 76, 0, 0,
-	0, 0, 90, 275, 92, 0, 0, 125, 101, 0,
-	0, 0, 0, 266, 267, 0, 0, 0, 0, 0,
-	0, 0, 0, 52, 0, 0, 234, 254, 253, 128,
-	256, 257, 258, 259, 0, 0, 69, 255, 260, 261,
-	262, 0, 0, 0, 247, 0, 274, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 244, 245, 0, 0,
-	0, 0, 286, 0, 246, 0, 0, 242, 243, 248,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 148, 0, 0, 284, 0, 114, 0, 0,
-	129, 81, 80, 89, 0, 0, 0, 72, 0, 120,
-	110, 141, 0, 111, 119, 93, 133, 115, 140, 149,
-	150, 131, 147, 61, 130, 139, 70, 122, 63, 137,
-	127, 99, 85, 86, 62, 0, 118, 75, 79, 74,
-	107, 134, 135, 73, 156, 66, 146, 65, 67, 145,
-	106, 132, 138, 100, 97, 64, 136, 98, 96, 88,
-	77, 82, 112, 95, 113, 83, 103, 102, 104, 0,
-	0, 0, 126, 143, 157, 0, 0, 151, 152, 153,
-	154, 0, 0, 0, 105, 68, 84, 123, 87, 94,
-	117, 155, 109, 121, 71, 142, 124, 276, 285, 282,
-	283, 280, 281, 279, 278, 277, 287, 268, 269, 270,
-	271, 273, 108, 272, 60, 0, 91, 0, 116, 78,
-	144, 76, 0, 0, 0, 0, 90, 0, 92, 0,
-	0, 125, 101, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	175, 0, 0, 128, 0, 0, 0, 0, 0, 0,
-	69, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 488, 487, 497, 498,
-	490, 491, 492, 493, 494, 495, 496, 489, 0, 0,
-	499, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 148, 0, 0, 0,
-	0, 114, 0, 0, 129, 81, 80, 89, 0, 0,
-	0, 72, 0, 120, 110, 141, 0, 111, 119, 93,
-	133, 115, 140, 149, 150, 131, 147, 61, 130, 139,
-	70, 122, 63, 137, 127, 99, 85, 86, 62, 0,
-	118, 75, 79, 74, 107, 134, 135, 73, 156, 66,
-	146, 65, 67, 145, 106, 132, 138, 100, 97, 64,
-	136, 98, 96, 88, 77, 82, 112, 95, 113, 83,
-	103, 102, 104, 0, 0, 0, 126, 143, 157, 0,
-	0, 151, 152, 153, 154, 0, 0, 0, 105, 68,
-	84, 123, 87, 94, 117, 155, 109, 121, 71, 142,
-	124, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 108, 0, 60, 0,
-	91, 0, 116, 78, 144, 76, 486, 0, 0, 0,
-	90, 0, 92, 0, 0, 125, 101, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 175, 0, 0, 128, 0, 0,
-	0, 0, 0, 0, 69, 0, 0, 0, 0, 168,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 171, 172, 0,
-	167, 0, 0, 0, 173, 114, 0, 0, 129, 81,
-	80, 89, 0, 0, 0, 72, 0, 120, 110, 141,
-	0, 111, 119, 93, 133, 115, 140, 169, 150, 131,
-	147, 61, 130, 139, 70, 122, 63, 137, 127, 99,
-	85, 86, 62, 0, 118, 75, 79, 74, 107, 134,
-	135, 73, 156, 66, 146, 65, 67, 145, 106, 132,
-	138, 100, 97, 64, 136, 98, 96, 88, 77, 82,
-	112, 95, 113, 83, 103, 102, 104, 0, 0, 0,
-	126, 143, 157, 0, 0, 151, 152, 153, 154, 0,
-	0, 0, 105, 68, 84, 123, 87, 94, 117, 155,
-	109, 121, 71, 142, 124, 0, 170, 0, 48, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	108, 0, 60, 0, 91, 0, 116, 78, 144, 76,
-	0, 0, 0, 0, 90, 0, 92, 0, 0, 125,
-	101, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 52, 0, 0, 175, 0,
-	0, 128, 0, 0, 0, 0, 0, 0, 69, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 148, 0, 0, 0, 0, 114,
-	0, 0, 129, 81, 80, 89, 0, 0, 0, 72,
-	0, 120, 110, 141, 0, 111, 119, 93, 133, 115,
-	140, 149, 150, 131, 147, 61, 130, 139, 70, 122,
-	63, 137, 127, 99, 85, 86, 62, 0, 118, 75,
-	79, 74, 107, 134, 135, 73, 156, 66, 146, 65,
-	67, 145, 106, 132, 138, 100, 97, 64, 136, 98,
-	96, 88, 77, 82, 112, 95, 113, 83, 103, 102,
-	104, 0, 0, 0, 126, 143, 157, 0, 0, 151,
-	152, 153, 154, 0, 0, 0, 105, 68, 84, 123,
-	87, 94, 117, 155, 109, 121, 71, 142, 124, 0,
-	0, 0, 48, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 108, 0, 60, 0, 91, 21,
-	116, 78, 144, 76, 0, 0, 0, 0, 90, 0,
-	92, 0, 0, 125, 101, 0, 0, 0, 0, 0,
+	283, 306, 305, 139, 308, 309, 310, 311, 0, 0,
+	71, 307, 284, 312, 313, 314, 0, 0, 0, 299,
+	1572, 326, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 296, 297, 0, 0, 0, 0, 338, 0, 298,
+	0, 0, 294, 295, 300, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 160, 0, 0,
+	336, 0, 122, 0, 0, 140, 85, 84, 93, 0,
+	0, 0, 75, 0, 129, 115, 152, 0, 118, 128,
+	98, 144, 123, 151, 161, 162, 142, 159, 63, 141,
+	150, 72, 131, 65, 148, 138, 104, 89, 90, 64,
+	0, 127, 78, 82, 77, 112, 145, 146, 76, 169,
+	68, 158, 67, 69, 157, 111, 143, 149, 105, 102,
+	66, 147, 103, 101, 92, 80, 86, 119, 100, 120,
+	87, 108, 107, 109, 0, 0, 0, 137, 155, 170,
+	0, 0, 163, 164, 165, 166, 0, 0, 0, 110,
+	70, 88, 134, 91, 99, 126, 168, 114, 130, 73,
+	154, 135, 328, 337, 334, 335, 332, 333, 331, 330,
+	329, 339, 320, 321, 322, 323, 325, 0, 133, 125,
+	132, 1574, 116, 1573, 121, 83, 117, 186, 173, 189,
+	172, 190, 183, 192, 180, 185, 179, 176, 178, 193,
+	177, 174, 181, 184, 182, 175, 187, 188, 171, 191,
+	324, 62, 0, 95, 0, 124, 81, 156, 167, 97,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 113, 0, 0, 0, 0, 0, 0, 0,
+	0, 79, 0, 0, 0, 0, 94, 327, 96, 0,
+	0, 136, 106, 0, 0, 0, 0, 318, 319, 0,
+	0, 0, 0, 0, 0, 0, 0, 52, 0, 0,
+	283, 306, 305, 139, 308, 309, 310, 311, 0, 0,
+	71, 307, 284, 312, 313, 314, 0, 0, 0, 299,
+	0, 326, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 296, 297, 0, 0, 0, 0, 338, 0, 298,
+	0, 0, 294, 295, 300, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 160, 0, 0,
+	336, 0, 122, 0, 0, 140, 85, 84, 93, 0,
+	0, 0, 75, 0, 129, 115, 152, 0, 118, 128,
+	98, 144, 123, 151, 161, 162, 142, 159, 63, 141,
+	150, 72, 131, 65, 148, 138, 104, 89, 90, 64,
+	0, 127, 78, 82, 77, 112, 145, 146, 76, 169,
+	68, 158, 67, 69, 157, 111, 143, 149, 105, 102,
+	66, 147, 103, 101, 92, 80, 86, 119, 100, 120,
+	87, 108, 107, 109, 0, 0, 0, 137, 155, 170,
+	0, 0, 163, 164, 165, 166, 0, 0, 0, 110,
+	70, 88, 134, 91, 99, 126, 168, 114, 130, 73,
+	154, 135, 328, 337, 334, 335, 332, 333, 331, 330,
+	329, 339, 320, 321, 322, 323, 325, 0, 133, 125,
+	132, 1574, 116, 1573, 121, 83, 117, 186, 173, 189,
+	172, 190, 183, 192, 180, 185, 179, 176, 178, 193,
+	177, 174, 181, 184, 182, 175, 187, 188, 171, 191,
+	324, 62, 0, 95, 0, 124, 81, 156, 167, 97,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 113, 0, 0, 0, 0, 0, 0, 0,
+	0, 79, 0, 0, 0, 0, 94, 327, 96, 0,
+	0, 136, 106, 0, 0, 0, 0, 318, 319, 0,
+	0, 0, 0, 0, 0, 0, 0, 52, 0, 0,
+	283, 306, 305, 139, 308, 309, 310, 311, 0, 0,
+	71, 307, 284, 312, 313, 314, 0, 0, 0, 299,
+	0, 326, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 296, 297, 0, 0, 0, 0, 338, 0, 298,
+	0, 0, 294, 295, 300, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 160, 0, 0,
+	336, 0, 122, 0, 0, 140, 85, 84, 93, 0,
+	0, 0, 75, 0, 129, 115, 152, 0, 118, 128,
+	98, 144, 123, 151, 161, 162, 142, 159, 63, 141,
+	150, 72, 131, 65, 148, 138, 104, 89, 90, 64,
+	0, 127, 78, 82, 77, 112, 145, 146, 76, 169,
+	68, 158, 67, 69, 157, 111, 143, 149, 105, 102,
+	66, 147, 103, 101, 92, 80, 86, 119, 100, 120,
+	87, 108, 107, 109, 0, 0, 0, 137, 155, 170,
+	0, 0, 163, 164, 165, 166, 0, 0, 0, 110,
+	70, 88, 134, 91, 99, 126, 168, 114, 130, 73,
+	154, 135, 328, 337, 334, 335, 332, 333, 331, 330,
+	329, 339, 320, 321, 322, 323, 325, 0, 133, 125,
+	132, 74, 116, 153, 121, 83, 117, 186, 173, 189,
+	172, 190, 183, 192, 180, 185, 179, 176, 178, 193,
+	177, 174, 181, 184, 182, 175, 187, 188, 171, 191,
+	324, 62, 0, 95, 0, 124, 81, 156, 167, 97,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 113, 0, 0, 0, 0, 0, 0, 0,
+	0, 79, 0, 0, 0, 0, 94, 0, 96, 0,
+	0, 136, 106, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	223, 0, 0, 139, 0, 0, 0, 0, 0, 0,
+	71, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 557, 556, 566,
+	567, 559, 560, 561, 562, 563, 564, 565, 558, 0,
+	0, 568, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 160, 0, 0,
+	0, 0, 122, 0, 0, 140, 85, 84, 93, 0,
+	0, 0, 75, 0, 129, 115, 152, 0, 118, 128,
+	98, 144, 123, 151, 161, 162, 142, 159, 63, 141,
+	150, 72, 131, 65, 148, 138, 104, 89, 90, 64,
+	0, 127, 78, 82, 77, 112, 145, 146, 76, 169,
+	68, 158, 67, 69, 157, 111, 143, 149, 105, 102,
+	66, 147, 103, 101, 92, 80, 86, 119, 100, 120,
+	87, 108, 107, 109, 0, 0, 0, 137, 155, 170,
+	0, 0, 163, 164, 165, 166, 0, 0, 0, 110,
+	70, 88, 134, 91, 99, 126, 168, 114, 130, 73,
+	154, 135, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 133, 125,
+	132, 74, 116, 153, 121, 83, 117, 186, 173, 189,
+	172, 190, 183, 192, 180, 185, 179, 176, 178, 193,
+	177, 174, 181, 184, 182, 175, 187, 188, 171, 191,
+	0, 62, 0, 95, 0, 124, 81, 156, 0, 555,
+	167, 97, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 113, 0, 0, 0, 542, 0,
+	0, 0, 0, 79, 0, 0, 0, 0, 94, 0,
+	96, 0, 0, 136, 106, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 544, 139, 0, 0, 0, 0,
+	0, 0, 71, 0, 545, 0, 0, 0, 539, 538,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 540, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 160,
+	0, 0, 0, 0, 122, 0, 0, 140, 85, 84,
+	93, 0, 0, 0, 75, 0, 129, 115, 152, 0,
+	118, 128, 98, 144, 123, 151, 161, 162, 142, 159,
+	63, 141, 150, 72, 131, 65, 148, 138, 104, 89,
+	90, 64, 0, 127, 78, 82, 77, 112, 145, 146,
+	76, 169, 68, 158, 67, 69, 157, 111, 143, 149,
+	105, 102, 66, 147, 103, 101, 92, 80, 86, 119,
+	100, 120, 87, 108, 107, 109, 0, 0, 0, 137,
+	155, 170, 0, 0, 163, 164, 165, 166, 0, 0,
+	0, 110, 70, 88, 134, 91, 99, 126, 168, 114,
+	130, 73, 154, 135, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	133, 125, 132, 74, 116, 153, 121, 83, 117, 186,
+	173, 189, 172, 190, 183, 192, 180, 185, 179, 176,
+	178, 193, 177, 174, 181, 184, 182, 175, 187, 188,
+	171, 191, 0, 62, 0, 95, 0, 124, 81, 156,
+	167, 97, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 113, 0, 0, 0, 0, 0,
+	0, 0, 0, 79, 0, 0, 0, 0, 94, 0,
+	96, 0, 0, 136, 106, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 139, 0, 0, 0, 0,
+	0, 0, 71, 0, 0, 0, 0, 0, 216, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 219, 220, 0, 215,
+	0, 0, 0, 221, 122, 0, 0, 140, 85, 84,
+	93, 0, 0, 0, 75, 0, 129, 115, 152, 0,
+	118, 128, 98, 144, 123, 151, 217, 162, 142, 159,
+	63, 141, 150, 72, 131, 65, 148, 138, 104, 89,
+	90, 64, 0, 127, 78, 82, 77, 112, 145, 146,
+	76, 169, 68, 158, 67, 69, 157, 111, 143, 149,
+	105, 102, 66, 147, 103, 101, 92, 80, 86, 119,
+	100, 120, 87, 108, 107, 109, 0, 0, 0, 137,
+	155, 170, 0, 0, 163, 164, 165, 166, 0, 0,
+	0, 110, 70, 88, 134, 91, 99, 126, 168, 114,
+	130, 73, 154, 135, 0, 218, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	133, 125, 132, 74, 116, 153, 121, 83, 117, 186,
+	173, 189, 172, 190, 183, 192, 180, 185, 179, 176,
+	178, 193, 177, 174, 181, 184, 182, 175, 187, 188,
+	171, 191, 0, 62, 0, 95, 0, 124, 81, 156,
+	167, 97, 48, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 113, 0, 0, 0, 0, 0,
+	0, 0, 0, 79, 0, 0, 0, 0, 94, 0,
+	96, 0, 0, 136, 106, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 52,
-	0, 0, 58, 0, 0, 128, 0, 0, 0, 0,
-	0, 0, 69, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 58, 0, 0, 139, 0, 0, 0, 0,
+	0, 0, 71, 0, 59, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 148, 0,
-	0, 0, 0, 114, 0, 0, 129, 81, 80, 89,
-	0, 0, 0, 72, 0, 120, 110, 141, 0, 111,
-	119, 93, 133, 115, 140, 149, 150, 131, 147, 61,
-	130, 139, 70, 122, 63, 137, 127, 99, 85, 86,
-	62, 0, 118, 75, 79, 74, 107, 134, 135, 73,
-	156, 66, 146, 65, 67, 145, 106, 132, 138, 100,
-	97, 64, 136, 98, 96, 88, 77, 82, 112, 95,
-	113, 83, 103, 102, 104, 0, 0, 0, 126, 143,
-	157, 0, 0, 151, 152, 153, 154, 0, 0, 0,
-	105, 68, 84, 123, 87, 94, 117, 155, 109, 121,
-	71, 142, 124, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 160,
+	0, 0, 0, 0, 122, 0, 0, 140, 85, 84,
+	93, 0, 0, 0, 75, 0, 129, 115, 152, 0,
+	118, 128, 98, 144, 123, 151, 161, 162, 142, 159,
+	63, 141, 150, 72, 131, 65, 148, 138, 104, 89,
+	90, 64, 0, 127, 78, 82, 77, 112, 145, 146,
+	76, 169, 68, 158, 67, 69, 157, 111, 143, 149,
+	105, 102, 66, 147, 103, 101, 92, 80, 86, 119,
+	100, 120, 87, 108, 107, 109, 0, 0, 0, 137,
+	155, 170, 0, 0, 163, 164, 165, 166, 0, 0,
+	0, 110, 70, 88, 134, 91, 99, 126, 168, 114,
+	130, 73, 154, 135, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	60, 0, 91, 21, 116, 78, 144, 108, 0, 0,
-	0, 574, 0, 0, 0, 0, 76, 0, 0, 0,
-	0, 90, 0, 92, 0, 0, 125, 101, 0, 0,
+	133, 125, 132, 74, 116, 153, 121, 83, 117, 186,
+	173, 189, 172, 190, 183, 192, 180, 185, 179, 176,
+	178, 193, 177, 174, 181, 184, 182, 175, 187, 188,
+	171, 191, 0, 62, 0, 95, 21, 124, 81, 156,
+	167, 97, 48, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 113, 0, 0, 0, 0, 0,
+	0, 0, 0, 79, 0, 0, 0, 0, 94, 0,
+	96, 0, 0, 136, 106, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 52,
+	0, 0, 223, 0, 0, 139, 0, 0, 0, 0,
+	0, 0, 71, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 58, 0, 576, 128, 0,
-	0, 0, 0, 0, 0, 69, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 160,
+	0, 0, 0, 0, 122, 0, 0, 140, 85, 84,
+	93, 0, 0, 0, 75, 0, 129, 115, 152, 0,
+	118, 128, 98, 144, 123, 151, 161, 162, 142, 159,
+	63, 141, 150, 72, 131, 65, 148, 138, 104, 89,
+	90, 64, 0, 127, 78, 82, 77, 112, 145, 146,
+	76, 169, 68, 158, 67, 69, 157, 111, 143, 149,
+	105, 102, 66, 147, 103, 101, 92, 80, 86, 119,
+	100, 120, 87, 108, 107, 109, 0, 0, 0, 137,
+	155, 170, 0, 0, 163, 164, 165, 166, 0, 0,
+	0, 110, 70, 88, 134, 91, 99, 126, 168, 114,
+	130, 73, 154, 135, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	133, 125, 132, 74, 116, 153, 121, 83, 117, 186,
+	173, 189, 172, 190, 183, 192, 180, 185, 179, 176,
+	178, 193, 177, 174, 181, 184, 182, 175, 187, 188,
+	171, 191, 0, 62, 0, 95, 21, 124, 81, 156,
+	167, 97, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 113, 0, 0, 0, 0, 0,
+	0, 0, 0, 79, 937, 0, 0, 0, 94, 0,
+	96, 0, 0, 136, 106, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 148, 0, 0, 0, 0, 114, 0, 0, 129,
-	81, 80, 89, 0, 0, 0, 72, 0, 120, 110,
-	141, 0, 111, 119, 93, 133, 115, 140, 149, 150,
-	131, 147, 61, 130, 139, 70, 122, 63, 137, 127,
-	99, 85, 86, 62, 0, 118, 75, 79, 74, 107,
-	134, 135, 73, 156, 66, 146, 65, 67, 145, 106,
-	132, 138, 100, 97, 64, 136, 98, 96, 88, 77,
-	82, 112, 95, 113, 83, 103, 102, 104, 0, 0,
-	0, 126, 143, 157, 0, 0, 151, 152, 153, 154,
-	0, 0, 0, 105, 68, 84, 123, 87, 94, 117,
-	155, 109, 121, 71, 142, 124, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 108, 0, 0,
-	0, 0, 0, 60, 0, 91, 76, 116, 78, 144,
-	0, 90, 0, 92, 0, 0, 125, 101, 0, 0,
+	0, 0, 223, 0, 0, 139, 0, 0, 0, 0,
+	0, 0, 71, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 175, 0, 0, 128, 901,
-	0, 0, 902, 0, 0, 69, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 936, 160,
+	0, 0, 0, 934, 932, 0, 0, 933, 85, 84,
+	93, 0, 0, 0, 75, 0, 129, 115, 152, 0,
+	118, 128, 98, 144, 123, 151, 161, 162, 142, 159,
+	63, 141, 150, 72, 131, 65, 148, 138, 104, 89,
+	90, 64, 0, 127, 78, 82, 77, 112, 145, 146,
+	76, 169, 68, 158, 67, 69, 157, 111, 143, 149,
+	105, 102, 66, 147, 103, 101, 92, 80, 86, 119,
+	100, 120, 87, 108, 107, 109, 0, 0, 0, 137,
+	155, 170, 0, 0, 163, 164, 165, 166, 0, 0,
+	0, 110, 70, 88, 134, 91, 99, 126, 168, 114,
+	130, 73, 154, 135, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	133, 125, 132, 74, 116, 153, 121, 83, 117, 186,
+	173, 189, 172, 190, 183, 192, 180, 185, 179, 176,
+	178, 193, 177, 174, 181, 184, 182, 175, 187, 188,
+	171, 191, 0, 62, 0, 95, 0, 124, 81, 156,
+	167, 97, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 113, 0, 0, 0, 0, 0,
+	0, 0, 0, 79, 0, 0, 0, 0, 94, 0,
+	96, 0, 0, 136, 106, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 52,
+	0, 0, 58, 0, 0, 139, 0, 0, 0, 0,
+	0, 0, 71, 0, 59, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 148, 0, 0, 0, 0, 114, 0, 0, 129,
-	81, 80, 89, 0, 0, 0, 72, 0, 120, 110,
-	141, 0, 111, 119, 93, 133, 115, 140, 149, 150,
-	131, 147, 61, 130, 139, 70, 122, 63, 137, 127,
-	99, 85, 86, 62, 0, 118, 75, 79, 74, 107,
-	134, 135, 73, 156, 66, 146, 65, 67, 145, 106,
-	132, 138, 100, 97, 64, 136, 98, 96, 88, 77,
-	82, 112, 95, 113, 83, 103, 102, 104, 0, 0,
-	0, 126, 143, 157, 0, 0, 151, 152, 153, 154,
-	0, 0, 0, 105, 68, 84, 123, 87, 94, 117,
-	155, 109, 121, 71, 142, 124, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 108, 0, 60, 0, 91, 0, 116, 78, 144,
-	76, 0, 594, 0, 0, 90, 0, 92, 0, 0,
-	125, 101, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 175,
-	0, 593, 128, 0, 0, 0, 0, 0, 0, 69,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 160,
+	0, 0, 0, 0, 122, 0, 0, 140, 85, 84,
+	93, 0, 0, 0, 75, 0, 129, 115, 152, 0,
+	118, 128, 98, 144, 123, 151, 161, 162, 142, 159,
+	63, 141, 150, 72, 131, 65, 148, 138, 104, 89,
+	90, 64, 0, 127, 78, 82, 77, 112, 145, 146,
+	76, 169, 68, 158, 67, 69, 157, 111, 143, 149,
+	105, 102, 66, 147, 103, 101, 92, 80, 86, 119,
+	100, 120, 87, 108, 107, 109, 0, 0, 0, 137,
+	155, 170, 0, 0, 163, 164, 165, 166, 0, 0,
+	0, 110, 70, 88, 134, 91, 99, 126, 168, 114,
+	130, 73, 154, 135, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	133, 125, 132, 74, 116, 153, 121, 83, 117, 186,
+	173, 189, 172, 190, 183, 192, 180, 185, 179, 176,
+	178, 193, 177, 174, 181, 184, 182, 175, 187, 188,
+	171, 191, 0, 62, 0, 95, 0, 124, 81, 156,
+	167, 97, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 113, 0, 0, 0, 0, 0,
+	0, 0, 0, 79, 0, 0, 0, 0, 94, 0,
+	96, 0, 0, 136, 106, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 139, 1025, 0, 0, 1026,
+	0, 0, 71, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 148, 0, 0, 0, 0,
-	114, 0, 0, 129, 81, 80, 89, 0, 0, 0,
-	72, 0, 120, 110, 141, 0, 111, 119, 93, 133,
-	115, 140, 149, 150, 131, 147, 61, 130, 139, 70,
-	122, 63, 137, 127, 99, 85, 86, 62, 0, 118,
-	75, 79, 74, 107, 134, 135, 73, 156, 66, 146,
-	65, 67, 145, 106, 132, 138, 100, 97, 64, 136,
-	98, 96, 88, 77, 82, 112, 95, 113, 83, 103,
-	102, 104, 0, 0, 0, 126, 143, 157, 0, 0,
-	151, 152, 153, 154, 0, 0, 0, 105, 68, 84,
-	123, 87, 94, 117, 155, 109, 121, 71, 142, 124,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 108, 0, 0, 0, 574, 0, 60, 0, 91,
-	76, 116, 78, 144, 0, 90, 0, 92, 0, 0,
-	125, 101, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 58,
-	0, 576, 128, 0, 0, 0, 0, 0, 0, 69,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 160,
+	0, 0, 0, 0, 122, 0, 0, 140, 85, 84,
+	93, 0, 0, 0, 75, 0, 129, 115, 152, 0,
+	118, 128, 98, 144, 123, 151, 161, 162, 142, 159,
+	63, 141, 150, 72, 131, 65, 148, 138, 104, 89,
+	90, 64, 0, 127, 78, 82, 77, 112, 145, 146,
+	76, 169, 68, 158, 67, 69, 157, 111, 143, 149,
+	105, 102, 66, 147, 103, 101, 92, 80, 86, 119,
+	100, 120, 87, 108, 107, 109, 0, 0, 0, 137,
+	155, 170, 0, 0, 163, 164, 165, 166, 0, 0,
+	0, 110, 70, 88, 134, 91, 99, 126, 168, 114,
+	130, 73, 154, 135, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	133, 125, 132, 74, 116, 153, 121, 83, 117, 186,
+	173, 189, 172, 190, 183, 192, 180, 185, 179, 176,
+	178, 193, 177, 174, 181, 184, 182, 175, 187, 188,
+	171, 191, 0, 62, 0, 95, 0, 124, 81, 156,
+	167, 97, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 113, 0, 0, 0, 0, 0,
+	0, 0, 0, 79, 0, 0, 0, 0, 94, 0,
+	96, 0, 0, 136, 106, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 58, 0, 652, 139, 0, 0, 0, 0,
+	0, 0, 71, 0, 59, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 148, 0, 0, 0, 0,
-	114, 0, 0, 129, 81, 80, 89, 0, 0, 0,
-	72, 0, 120, 110, 141, 0, 572, 119, 93, 133,
-	115, 140, 149, 150, 131, 147, 61, 130, 139, 70,
-	122, 63, 137, 127, 99, 85, 86, 62, 0, 118,
-	75, 79, 74, 107, 134, 135, 73, 156, 66, 146,
-	65, 67, 145, 106, 132, 138, 100, 97, 64, 136,
-	98, 96, 88, 77, 82, 112, 95, 113, 83, 103,
-	102, 104, 0, 0, 0, 126, 143, 157, 0, 0,
-	151, 152, 153, 154, 0, 0, 0, 105, 68, 84,
-	123, 87, 94, 117, 155, 109, 121, 71, 142, 124,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 108, 0, 0, 0, 0, 0, 60, 0, 91,
-	76, 116, 78, 144, 0, 90, 0, 92, 0, 0,
-	125, 101, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 52, 0, 0, 58,
-	0, 0, 128, 0, 0, 0, 0, 0, 0, 69,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 160,
+	0, 0, 0, 0, 122, 0, 0, 140, 85, 84,
+	93, 0, 0, 0, 75, 0, 129, 115, 152, 0,
+	118, 128, 98, 144, 123, 151, 161, 162, 142, 159,
+	63, 141, 150, 72, 131, 65, 148, 138, 104, 89,
+	90, 64, 0, 127, 78, 82, 77, 112, 145, 146,
+	76, 169, 68, 158, 67, 69, 157, 111, 143, 149,
+	105, 102, 66, 147, 103, 101, 92, 80, 86, 119,
+	100, 120, 87, 108, 107, 109, 0, 0, 0, 137,
+	155, 170, 0, 0, 163, 164, 165, 166, 0, 0,
+	0, 110, 70, 88, 134, 91, 99, 126, 168, 114,
+	130, 73, 154, 135, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	133, 125, 132, 74, 116, 153, 121, 83, 117, 186,
+	173, 189, 172, 190, 183, 192, 180, 185, 179, 176,
+	178, 193, 177, 174, 181, 184, 182, 175, 187, 188,
+	171, 191, 0, 62, 0, 95, 0, 124, 81, 156,
+	167, 97, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 113, 0, 0, 0, 0, 0,
+	0, 0, 0, 79, 0, 0, 0, 0, 94, 0,
+	96, 0, 0, 136, 106, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 58, 0, 0, 139, 0, 0, 0, 0,
+	0, 0, 71, 0, 59, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 148, 0, 0, 0, 0,
-	114, 0, 0, 129, 81, 80, 89, 0, 0, 0,
-	72, 0, 120, 110, 141, 0, 111, 119, 93, 133,
-	115, 140, 149, 150, 131, 147, 61, 130, 139, 70,
-	122, 63, 137, 127, 99, 85, 86, 62, 0, 118,
-	75, 79, 74, 107, 134, 135, 73, 156, 66, 146,
-	65, 67, 145, 106, 132, 138, 100, 97, 64, 136,
-	98, 96, 88, 77, 82, 112, 95, 113, 83, 103,
-	102, 104, 0, 0, 0, 126, 143, 157, 0, 0,
-	151, 152, 153, 154, 0, 0, 0, 105, 68, 84,
-	123, 87, 94, 117, 155, 109, 121, 71, 142, 124,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 108, 0, 0, 0, 0, 0, 60, 0, 91,
-	76, 116, 78, 144, 0, 90, 0, 92, 0, 0,
-	125, 101, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 58,
-	0, 576, 128, 0, 0, 0, 0, 0, 0, 69,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 870, 0, 160,
+	0, 0, 0, 0, 122, 0, 0, 140, 85, 84,
+	93, 0, 0, 0, 75, 0, 129, 115, 152, 0,
+	118, 128, 98, 144, 123, 151, 161, 162, 142, 159,
+	63, 141, 150, 72, 131, 65, 148, 138, 104, 89,
+	90, 64, 0, 127, 78, 82, 77, 112, 145, 146,
+	76, 169, 68, 158, 67, 69, 157, 111, 143, 149,
+	105, 102, 66, 147, 103, 101, 92, 80, 86, 119,
+	100, 120, 87, 108, 107, 109, 0, 0, 0, 137,
+	155, 170, 0, 0, 163, 164, 165, 166, 0, 0,
+	0, 110, 70, 88, 134, 91, 99, 126, 168, 114,
+	130, 73, 154, 135, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	133, 125, 132, 74, 116, 153, 121, 83, 117, 186,
+	173, 189, 172, 190, 183, 192, 180, 185, 179, 176,
+	178, 193, 177, 174, 181, 184, 182, 175, 187, 188,
+	171, 191, 0, 62, 0, 95, 0, 124, 81, 156,
+	167, 97, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 113, 0, 0, 0, 0, 0,
+	0, 0, 0, 79, 0, 0, 0, 0, 94, 0,
+	96, 0, 0, 136, 106, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 544, 139, 0, 0, 0, 0,
+	0, 0, 71, 0, 545, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 160,
+	0, 0, 0, 0, 122, 0, 0, 140, 85, 84,
+	93, 0, 0, 0, 75, 0, 129, 115, 152, 0,
+	118, 128, 98, 144, 123, 151, 161, 162, 142, 159,
+	63, 141, 150, 72, 131, 65, 148, 138, 104, 89,
+	90, 64, 0, 127, 78, 82, 77, 112, 145, 146,
+	76, 169, 68, 158, 67, 69, 157, 111, 143, 149,
+	105, 102, 66, 147, 103, 101, 92, 80, 86, 119,
+	100, 120, 87, 108, 107, 109, 0, 0, 0, 137,
+	155, 170, 0, 0, 163, 164, 165, 166, 0, 0,
+	0, 110, 70, 88, 134, 91, 99, 126, 168, 114,
+	130, 73, 154, 135, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	133, 125, 132, 74, 116, 153, 121, 83, 117, 186,
+	173, 189, 172, 190, 183, 192, 180, 185, 179, 176,
+	178, 193, 177, 174, 181, 184, 182, 175, 187, 188,
+	171, 191, 0, 62, 0, 95, 0, 124, 81, 156,
+	167, 97, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 113, 0, 0, 0, 0, 0,
+	0, 0, 0, 79, 0, 670, 0, 0, 94, 0,
+	96, 0, 0, 136, 106, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 669, 139, 0, 0, 0, 0,
+	0, 0, 71, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 160,
+	0, 0, 0, 0, 122, 0, 0, 140, 85, 84,
+	93, 0, 0, 0, 75, 0, 129, 115, 152, 0,
+	118, 128, 98, 144, 123, 151, 161, 162, 142, 159,
+	63, 141, 150, 72, 131, 65, 148, 138, 104, 89,
+	90, 64, 0, 127, 78, 82, 77, 112, 145, 146,
+	76, 169, 68, 158, 67, 69, 157, 111, 143, 149,
+	105, 102, 66, 147, 103, 101, 92, 80, 86, 119,
+	100, 120, 87, 108, 107, 109, 0, 0, 0, 137,
+	155, 170, 0, 0, 163, 164, 165, 166, 0, 0,
+	0, 110, 70, 88, 134, 91, 99, 126, 168, 114,
+	130, 73, 154, 135, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	133, 125, 132, 74, 116, 153, 121, 83, 117, 186,
+	173, 189, 172, 190, 183, 192, 180, 185, 179, 176,
+	178, 193, 177, 174, 181, 184, 182, 175, 187, 188,
+	171, 191, 0, 62, 0, 95, 0, 124, 81, 156,
+	167, 97, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 113, 0, 0, 0, 0, 0,
+	0, 0, 621, 79, 0, 0, 0, 0, 94, 0,
+	96, 0, 0, 136, 106, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 58, 0, 0, 139, 0, 0, 0, 0,
+	0, 0, 71, 0, 59, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 148, 0, 0, 0, 0,
-	114, 0, 0, 129, 81, 80, 89, 0, 0, 0,
-	72, 0, 120, 110, 141, 0, 111, 119, 93, 133,
-	115, 140, 149, 150, 131, 147, 61, 130, 139, 70,
-	122, 63, 137, 127, 99, 85, 86, 62, 0, 118,
-	75, 79, 74, 107, 134, 135, 73, 156, 66, 146,
-	65, 67, 145, 106, 132, 138, 100, 97, 64, 136,
-	98, 96, 88, 77, 82, 112, 95, 113, 83, 103,
-	102, 104, 0, 0, 0, 126, 143, 157, 0, 0,
-	151, 152, 153, 154, 0, 0, 0, 105, 68, 84,
-	123, 87, 94, 117, 155, 109, 121, 71, 142, 124,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 160,
+	0, 0, 0, 0, 122, 0, 0, 140, 85, 84,
+	93, 0, 0, 0, 75, 0, 129, 115, 152, 0,
+	118, 128, 98, 144, 123, 151, 161, 162, 142, 159,
+	63, 141, 150, 72, 131, 65, 148, 138, 104, 89,
+	90, 64, 0, 127, 78, 82, 77, 112, 145, 146,
+	76, 169, 68, 158, 67, 69, 157, 111, 143, 149,
+	105, 102, 66, 147, 103, 101, 92, 80, 86, 119,
+	100, 120, 87, 108, 107, 109, 0, 0, 0, 137,
+	155, 170, 0, 0, 163, 164, 165, 166, 0, 0,
+	0, 110, 70, 88, 134, 91, 99, 126, 168, 114,
+	130, 73, 154, 135, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 108, 0, 0, 0, 0, 0, 60, 0, 91,
-	76, 116, 78, 144, 0, 90, 0, 92, 0, 0,
-	125, 101, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 175,
-	0, 476, 128, 0, 0, 0, 0, 0, 0, 69,
+	133, 125, 132, 74, 116, 153, 121, 83, 622, 186,
+	173, 189, 172, 190, 183, 192, 180, 185, 179, 176,
+	178, 193, 177, 174, 181, 184, 182, 175, 187, 188,
+	171, 191, 0, 62, 0, 95, 0, 124, 81, 156,
+	167, 97, 0, 0, 0, 0, 0, 350, 0, 0,
+	0, 0, 0, 0, 113, 0, 0, 0, 0, 0,
+	0, 0, 0, 79, 0, 0, 0, 0, 94, 0,
+	96, 0, 0, 136, 106, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 58, 0, 0, 139, 0, 0, 0, 0,
+	0, 0, 71, 0, 59, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 148, 0, 0, 0, 0,
-	114, 0, 0, 129, 81, 80, 89, 0, 0, 0,
-	72, 0, 120, 110, 141, 0, 111, 119, 93, 133,
-	115, 140, 149, 150, 131, 147, 61, 130, 139, 70,
-	122, 63, 137, 127, 99, 85, 86, 62, 0, 118,
-	75, 79, 74, 107, 134, 135, 73, 156, 66, 146,
-	65, 67, 145, 106, 132, 138, 100, 97, 64, 136,
-	98, 96, 88, 77, 82, 112, 95, 113, 83, 103,
-	102, 104, 0, 0, 0, 126, 143, 157, 0, 0,
-	151, 152, 153, 154, 0, 0, 0, 105, 68, 84,
-	123, 87, 94, 117, 155, 109, 121, 71, 142, 124,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 160,
+	0, 0, 0, 0, 122, 0, 0, 140, 85, 84,
+	93, 0, 0, 0, 75, 0, 129, 115, 152, 0,
+	118, 128, 98, 144, 123, 151, 161, 162, 142, 159,
+	63, 141, 150, 72, 131, 65, 148, 138, 104, 89,
+	90, 64, 0, 127, 78, 82, 77, 112, 145, 146,
+	76, 169, 68, 158, 67, 69, 157, 111, 143, 149,
+	105, 102, 66, 147, 103, 101, 92, 80, 86, 119,
+	100, 120, 87, 108, 107, 109, 0, 0, 0, 137,
+	155, 170, 0, 0, 163, 164, 165, 166, 0, 0,
+	0, 110, 70, 88, 134, 91, 99, 126, 168, 114,
+	130, 73, 154, 135, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 108, 60, 0, 91,
-	0, 116, 78, 144, 551, 76, 0, 0, 0, 0,
-	90, 0, 92, 0, 0, 125, 101, 0, 0, 0,
+	133, 125, 132, 74, 116, 153, 121, 83, 117, 186,
+	173, 189, 172, 190, 183, 192, 180, 185, 179, 176,
+	178, 193, 177, 174, 181, 184, 182, 175, 187, 188,
+	171, 191, 0, 62, 0, 95, 0, 124, 81, 156,
+	167, 97, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 113, 0, 0, 0, 0, 0,
+	0, 0, 0, 79, 0, 0, 0, 0, 94, 0,
+	96, 0, 0, 136, 106, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 58, 0, 0, 128, 0, 0,
-	0, 0, 0, 0, 69, 0, 0, 0, 0, 0,
+	0, 0, 58, 0, 0, 139, 0, 0, 0, 0,
+	0, 0, 71, 0, 59, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 235, 0, 160,
+	0, 0, 0, 0, 122, 0, 0, 140, 85, 84,
+	93, 0, 0, 0, 75, 0, 129, 115, 152, 0,
+	118, 128, 98, 144, 123, 151, 161, 162, 142, 159,
+	63, 141, 150, 72, 131, 65, 148, 138, 104, 89,
+	90, 64, 0, 127, 78, 82, 77, 112, 145, 146,
+	76, 169, 68, 158, 67, 69, 157, 111, 143, 149,
+	105, 102, 66, 147, 103, 101, 92, 80, 86, 119,
+	100, 120, 87, 108, 107, 109, 0, 0, 0, 137,
+	155, 170, 0, 0, 163, 164, 165, 166, 0, 0,
+	0, 110, 70, 88, 134, 91, 99, 126, 168, 114,
+	130, 73, 154, 135, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	148, 0, 0, 0, 0, 114, 0, 0, 129, 81,
-	80, 89, 0, 0, 0, 72, 0, 120, 110, 141,
-	0, 111, 119, 93, 133, 115, 140, 149, 150, 131,
-	147, 61, 130, 139, 70, 122, 63, 137, 127, 99,
-	85, 86, 62, 0, 118, 75, 79, 74, 107, 134,
-	135, 73, 156, 66, 146, 65, 67, 145, 106, 132,
-	138, 100, 97, 64, 136, 98, 96, 88, 77, 82,
-	112, 95, 113, 83, 103, 102, 104, 0, 0, 0,
-	126, 143, 157, 0, 0, 151, 152, 153, 154, 0,
-	0, 0, 105, 68, 84, 123, 87, 94, 117, 155,
-	109, 121, 71, 142, 124, 0, 0, 0, 0, 298,
-	0, 0, 0, 0, 0, 0, 108, 0, 0, 0,
-	0, 0, 60, 0, 91, 76, 116, 78, 144, 0,
-	90, 0, 92, 0, 0, 125, 101, 0, 0, 0,
+	133, 125, 132, 74, 116, 153, 121, 83, 117, 186,
+	173, 189, 172, 190, 183, 192, 180, 185, 179, 176,
+	178, 193, 177, 174, 181, 184, 182, 175, 187, 188,
+	171, 191, 0, 62, 0, 95, 0, 124, 81, 156,
+	167, 97, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 113, 0, 0, 0, 0, 0,
+	0, 0, 0, 79, 0, 0, 0, 0, 94, 0,
+	96, 0, 0, 136, 106, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 58, 0, 0, 128, 0, 0,
-	0, 0, 0, 0, 69, 0, 0, 0, 0, 0,
+	0, 0, 58, 0, 0, 139, 0, 0, 0, 0,
+	0, 0, 71, 0, 59, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 160,
+	0, 0, 0, 0, 122, 0, 0, 140, 85, 84,
+	93, 0, 0, 0, 75, 0, 129, 115, 152, 0,
+	118, 128, 98, 144, 123, 151, 161, 162, 142, 159,
+	63, 141, 150, 72, 131, 65, 148, 138, 104, 89,
+	90, 64, 0, 127, 78, 82, 77, 112, 145, 146,
+	76, 169, 68, 158, 67, 69, 157, 111, 143, 149,
+	105, 102, 66, 147, 103, 101, 92, 80, 86, 119,
+	100, 120, 87, 108, 107, 109, 0, 0, 0, 137,
+	155, 170, 0, 0, 163, 164, 165, 166, 0, 0,
+	0, 110, 70, 88, 134, 91, 99, 126, 168, 114,
+	130, 73, 154, 135, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	148, 0, 0, 0, 0, 114, 0, 0, 129, 81,
-	80, 89, 0, 0, 0, 72, 0, 120, 110, 141,
-	0, 111, 119, 93, 133, 115, 140, 149, 150, 131,
-	147, 61, 130, 139, 70, 122, 63, 137, 127, 99,
-	85, 86, 62, 0, 118, 75, 79, 74, 107, 134,
-	135, 73, 156, 66, 146, 65, 67, 145, 106, 132,
-	138, 100, 97, 64, 136, 98, 96, 88, 77, 82,
-	112, 95, 113, 83, 103, 102, 104, 0, 0, 0,
-	126, 143, 157, 0, 0, 151, 152, 153, 154, 0,
-	0, 0, 105, 68, 84, 123, 87, 94, 117, 155,
-	109, 121, 71, 142, 124, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 108, 0, 0, 0,
-	0, 0, 60, 0, 91, 76, 116, 78, 144, 0,
-	90, 0, 92, 0, 0, 125, 101, 0, 0, 0,
+	133, 125, 132, 74, 116, 153, 121, 83, 117, 186,
+	173, 189, 172, 190, 183, 192, 180, 185, 179, 176,
+	178, 193, 177, 174, 181, 184, 182, 175, 187, 188,
+	171, 191, 0, 62, 0, 95, 0, 124, 81, 156,
+	167, 97, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 113, 0, 0, 0, 0, 0,
+	0, 0, 0, 79, 0, 0, 0, 0, 94, 0,
+	96, 0, 0, 136, 106, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 58, 0, 0, 128, 0, 0,
-	0, 0, 0, 0, 69, 0, 0, 0, 0, 0,
+	0, 0, 283, 0, 0, 139, 0, 0, 0, 0,
+	0, 0, 71, 0, 59, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 186, 0,
-	148, 0, 0, 0, 0, 114, 0, 0, 129, 81,
-	80, 89, 0, 0, 0, 72, 0, 120, 110, 141,
-	0, 111, 119, 93, 133, 115, 140, 149, 150, 131,
-	147, 61, 130, 139, 70, 122, 63, 137, 127, 99,
-	85, 86, 62, 0, 118, 75, 79, 74, 107, 134,
-	135, 73, 156, 66, 146, 65, 67, 145, 106, 132,
-	138, 100, 97, 64, 136, 98, 96, 88, 77, 82,
-	112, 95, 113, 83, 103, 102, 104, 0, 0, 0,
-	126, 143, 157, 0, 0, 151, 152, 153, 154, 0,
-	0, 0, 105, 68, 84, 123, 87, 94, 117, 155,
-	109, 121, 71, 142, 124, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 108, 0, 0, 0,
-	0, 0, 60, 0, 91, 76, 116, 78, 144, 0,
-	90, 0, 92, 0, 0, 125, 101, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 160,
+	0, 0, 0, 0, 122, 0, 0, 140, 85, 84,
+	93, 0, 0, 0, 75, 0, 129, 115, 152, 0,
+	118, 128, 98, 144, 123, 151, 161, 162, 142, 159,
+	63, 141, 150, 72, 131, 65, 148, 138, 104, 89,
+	90, 64, 0, 127, 78, 82, 77, 112, 145, 146,
+	76, 169, 68, 158, 67, 69, 157, 111, 143, 149,
+	105, 102, 66, 147, 103, 101, 92, 80, 86, 119,
+	100, 120, 87, 108, 107, 109, 0, 0, 0, 137,
+	155, 170, 0, 0, 163, 164, 165, 166, 0, 0,
+	0, 110, 70, 88, 134, 91, 99, 126, 168, 114,
+	130, 73, 154, 135, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 175, 0, 0, 128, 0, 0,
-	0, 0, 0, 0, 69, 0, 0, 0, 0, 0,
+	133, 125, 132, 74, 116, 153, 121, 83, 117, 186,
+	173, 189, 172, 190, 183, 192, 180, 185, 179, 176,
+	178, 193, 177, 174, 181, 184, 182, 175, 187, 188,
+	171, 191, 113, 62, 0, 95, 650, 124, 81, 156,
+	0, 79, 0, 0, 0, 0, 94, 0, 96, 0,
+	0, 136, 106, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	58, 0, 652, 139, 0, 0, 0, 0, 0, 0,
+	71, 0, 59, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	148, 0, 0, 0, 0, 114, 0, 0, 129, 81,
-	80, 89, 0, 0, 0, 72, 0, 120, 110, 141,
-	0, 111, 119, 93, 133, 115, 140, 149, 150, 131,
-	147, 61, 130, 139, 70, 122, 63, 137, 127, 99,
-	85, 86, 62, 0, 118, 75, 79, 74, 107, 134,
-	135, 73, 156, 66, 146, 65, 67, 145, 106, 132,
-	138, 100, 97, 64, 136, 98, 96, 88, 77, 82,
-	112, 95, 113, 83, 103, 102, 104, 0, 0, 0,
-	126, 143, 157, 0, 0, 151, 152, 153, 154, 0,
-	0, 0, 105, 68, 84, 123, 87, 94, 117, 155,
-	109, 121, 71, 142, 124, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 108, 0, 0, 0,
-	0, 0, 60, 0, 91, 76, 116, 78, 144, 0,
-	90, 0, 92, 0, 0, 125, 101, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 160, 0, 0,
+	0, 0, 122, 0, 0, 140, 85, 84, 93, 0,
+	0, 0, 75, 0, 129, 115, 152, 0, 118, 128,
+	98, 144, 123, 151, 161, 162, 142, 159, 63, 141,
+	150, 72, 131, 65, 148, 138, 104, 89, 90, 64,
+	0, 127, 78, 82, 77, 112, 145, 146, 76, 169,
+	68, 158, 67, 69, 157, 111, 143, 149, 105, 102,
+	66, 147, 103, 101, 92, 80, 86, 119, 100, 120,
+	87, 108, 107, 109, 0, 0, 0, 137, 155, 170,
+	0, 0, 163, 164, 165, 166, 0, 0, 0, 110,
+	70, 88, 134, 91, 99, 126, 168, 114, 130, 73,
+	154, 135, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 133, 125,
+	132, 74, 116, 153, 121, 83, 117, 186, 173, 189,
+	172, 190, 183, 192, 180, 185, 179, 176, 178, 193,
+	177, 174, 181, 184, 182, 175, 187, 188, 171, 191,
+	0, 62, 0, 95, 0, 124, 81, 156, 167, 97,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 113, 0, 0, 0, 0, 0, 0, 0,
+	0, 79, 0, 0, 0, 0, 94, 0, 96, 0,
+	0, 136, 106, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 52, 0, 0,
+	223, 0, 0, 139, 0, 0, 0, 0, 0, 0,
+	71, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 160, 0, 0,
+	0, 0, 122, 0, 0, 140, 85, 84, 93, 0,
+	0, 0, 75, 0, 129, 115, 152, 0, 118, 128,
+	98, 144, 123, 151, 161, 162, 142, 159, 63, 141,
+	150, 72, 131, 65, 148, 138, 104, 89, 90, 64,
+	0, 127, 78, 82, 77, 112, 145, 146, 76, 169,
+	68, 158, 67, 69, 157, 111, 143, 149, 105, 102,
+	66, 147, 103, 101, 92, 80, 86, 119, 100, 120,
+	87, 108, 107, 109, 0, 0, 0, 137, 155, 170,
+	0, 0, 163, 164, 165, 166, 0, 0, 0, 110,
+	70, 88, 134, 91, 99, 126, 168, 114, 130, 73,
+	154, 135, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 133, 125,
+	132, 74, 116, 153, 121, 83, 117, 186, 173, 189,
+	172, 190, 183, 192, 180, 185, 179, 176, 178, 193,
+	177, 174, 181, 184, 182, 175, 187, 188, 171, 191,
+	113, 62, 0, 95, 650, 124, 81, 156, 0, 79,
+	0, 0, 0, 0, 94, 0, 96, 0, 0, 136,
+	106, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 58, 0,
+	652, 139, 0, 0, 0, 0, 0, 0, 71, 0,
+	59, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 234, 0, 0, 128, 0, 0,
-	0, 0, 0, 0, 69, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 160, 0, 0, 0, 0,
+	122, 0, 0, 140, 85, 84, 93, 0, 0, 0,
+	75, 0, 129, 115, 152, 0, 648, 128, 98, 144,
+	123, 151, 161, 162, 142, 159, 63, 141, 150, 72,
+	131, 65, 148, 138, 104, 89, 90, 64, 0, 127,
+	78, 82, 77, 112, 145, 146, 76, 169, 68, 158,
+	67, 69, 157, 111, 143, 149, 105, 102, 66, 147,
+	103, 101, 92, 80, 86, 119, 100, 120, 87, 108,
+	107, 109, 0, 0, 0, 137, 155, 170, 0, 0,
+	163, 164, 165, 166, 0, 0, 0, 110, 70, 88,
+	134, 91, 99, 126, 168, 114, 130, 73, 154, 135,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 133, 125, 132, 74,
+	116, 153, 121, 83, 117, 186, 173, 189, 172, 190,
+	183, 192, 180, 185, 179, 176, 178, 193, 177, 174,
+	181, 184, 182, 175, 187, 188, 171, 191, 97, 62,
+	0, 95, 0, 124, 81, 156, 0, 0, 0, 0,
+	0, 113, 0, 0, 0, 644, 0, 0, 0, 0,
+	79, 0, 0, 0, 0, 94, 0, 96, 0, 0,
+	136, 106, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 58,
+	0, 0, 139, 0, 0, 0, 0, 0, 0, 71,
+	0, 59, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	148, 0, 0, 0, 0, 114, 0, 0, 129, 81,
-	80, 89, 0, 0, 0, 72, 0, 120, 110, 141,
-	0, 111, 119, 93, 133, 115, 140, 149, 150, 131,
-	147, 61, 130, 139, 70, 122, 63, 137, 127, 99,
-	85, 86, 62, 0, 118, 75, 79, 74, 107, 134,
-	135, 73, 156, 66, 146, 65, 67, 145, 106, 132,
-	138, 100, 97, 64, 136, 98, 96, 88, 77, 82,
-	112, 95, 113, 83, 103, 102, 104, 0, 0, 0,
-	126, 143, 157, 0, 0, 151, 152, 153, 154, 0,
-	0, 0, 105, 68, 84, 123, 87, 94, 117, 155,
-	109, 121, 71, 142, 124, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 108, 0, 0, 0,
-	0, 0, 60, 0, 91, 76, 116, 78, 144, 0,
-	90, 0, 92, 0, 0, 125, 101, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 58, 0, 0, 128, 0, 0,
-	0, 0, 0, 0, 69, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 160, 0, 0, 0,
+	0, 122, 0, 0, 140, 85, 84, 93, 0, 0,
+	0, 75, 0, 129, 115, 152, 0, 118, 128, 98,
+	144, 123, 151, 161, 162, 142, 159, 63, 141, 150,
+	72, 131, 65, 148, 138, 104, 89, 90, 64, 0,
+	127, 78, 82, 77, 112, 145, 146, 76, 169, 68,
+	158, 67, 69, 157, 111, 143, 149, 105, 102, 66,
+	147, 103, 101, 92, 80, 86, 119, 100, 120, 87,
+	108, 107, 109, 0, 0, 0, 137, 155, 170, 0,
+	0, 163, 164, 165, 166, 0, 0, 0, 110, 70,
+	88, 134, 91, 99, 126, 168, 114, 130, 73, 154,
+	135, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 133, 125, 132,
+	74, 116, 153, 121, 83, 117, 186, 173, 189, 172,
+	190, 183, 192, 180, 185, 179, 176, 178, 193, 177,
+	174, 181, 184, 182, 175, 187, 188, 171, 191, 0,
+	62, 0, 95, 0, 124, 81, 156, 167, 97, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 113, 0, 0, 0, 0, 0, 0, 0, 0,
+	79, 0, 0, 0, 0, 94, 0, 96, 0, 0,
+	136, 106, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 223,
+	0, 0, 139, 0, 0, 0, 0, 0, 0, 71,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	148, 0, 0, 0, 0, 114, 0, 0, 129, 81,
-	80, 89, 0, 0, 0, 72, 0, 120, 110, 141,
-	0, 111, 119, 93, 133, 115, 140, 149, 150, 131,
-	147, 61, 130, 139, 70, 122, 63, 137, 127, 99,
-	85, 86, 62, 0, 118, 75, 79, 74, 107, 134,
-	135, 73, 156, 66, 146, 65, 67, 145, 106, 132,
-	138, 100, 97, 64, 136, 98, 96, 88, 77, 82,
-	112, 95, 113, 83, 103, 102, 104, 0, 0, 0,
-	126, 143, 157, 195, 0, 151, 152, 153, 154, 0,
-	0, 0, 105, 68, 84, 123, 87, 94, 117, 155,
-	109, 121, 71, 142, 124, 0, 0, 205, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 60, 0, 91, 0, 116, 78, 144, 0,
+	0, 0, 0, 0, 0, 0, 160, 0, 0, 0,
+	0, 122, 0, 0, 140, 85, 84, 93, 0, 0,
+	0, 75, 0, 129, 115, 152, 0, 118, 128, 98,
+	144, 123, 151, 161, 162, 142, 159, 63, 141, 150,
+	72, 131, 65, 148, 138, 104, 89, 90, 64, 0,
+	127, 78, 82, 77, 112, 145, 146, 76, 169, 68,
+	158, 67, 69, 157, 111, 143, 149, 105, 102, 66,
+	147, 103, 101, 92, 80, 86, 119, 100, 120, 87,
+	108, 107, 109, 0, 0, 0, 137, 155, 170, 0,
+	0, 163, 164, 165, 166, 0, 0, 0, 110, 70,
+	88, 134, 91, 99, 126, 168, 114, 130, 73, 154,
+	135, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 133, 125, 132,
+	74, 116, 153, 121, 83, 117, 186, 173, 189, 172,
+	190, 183, 192, 180, 185, 179, 176, 178, 193, 177,
+	174, 181, 184, 182, 175, 187, 188, 171, 191, 0,
+	62, 0, 95, 0, 124, 81, 156, 167, 97, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 113, 0, 0, 0, 0, 0, 0, 0, 0,
+	79, 0, 0, 0, 0, 94, 0, 96, 0, 0,
+	136, 106, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 223,
+	0, 0, 139, 0, 0, 0, 0, 0, 0, 71,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 190, 0,
-	0, 0, 0, 0, 192, 0, 0, 0, 0, 0,
-	0, 198, 194, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 196, 0,
-	0, 200, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 191,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 193, 0, 201, 202,
-	203, 204, 208, 0, 0, 0, 0, 207, 206,
+	0, 0, 0, 0, 0, 0, 160, 0, 0, 0,
+	0, 122, 0, 0, 140, 85, 84, 93, 0, 0,
+	0, 75, 0, 129, 115, 152, 0, 118, 128, 98,
+	144, 123, 151, 161, 162, 142, 159, 63, 141, 150,
+	72, 131, 65, 148, 138, 104, 89, 90, 64, 0,
+	127, 78, 82, 77, 112, 145, 146, 76, 169, 68,
+	158, 67, 69, 157, 111, 143, 149, 105, 102, 66,
+	147, 103, 101, 92, 80, 86, 119, 100, 120, 87,
+	108, 107, 109, 0, 0, 0, 137, 155, 170, 0,
+	0, 163, 164, 165, 166, 0, 0, 0, 110, 70,
+	88, 134, 91, 99, 126, 168, 114, 130, 73, 154,
+	135, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 133, 125, 132,
+	74, 116, 153, 121, 83, 117, 186, 173, 189, 172,
+	190, 183, 192, 740, 185, 179, 176, 178, 193, 177,
+	174, 181, 184, 182, 175, 187, 188, 171, 191, 0,
+	62, 0, 95, 0, 124, 81, 156,
 }
 
 var yyPact = [...]int16{
-	1825, -1000, -183, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1557, -1000, -177, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 14554, -1000, -1000, -1000, -1000, -1000, -1000, 319, 10914,
+	237, 273, 140, 14274, 270, 2128, 14554, -1000, 169, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1188, 1215, -1000, -1000,
+	-1000, 141, -1000, -1000, -1000, 898, -1000, 929, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 10488, -1000, -1000, -1000, -1000, -1000, -1000, 793, 7158,
-	184, 249, 135, 9828, 248, 10661, 10488, -1000, 107, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 929, 953, -1000, -1000,
-	-1000, 150, -1000, -1000, -1000, 727, -1000, 773, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -1784,294 +2447,353 @@ var yyPact = [...]int16{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 5814, -1000,
-	179, 8723, 9608, 4898, -1000, 652, 245, 10488, -93, 10048,
-	177, 177, 177, -1000, -1000, -1000, -1000, 195, 10488, -1000,
-	10488, 176, 643, 176, 176, 176, 10488, -1000, 307, 10488,
-	641, 873, 293, 2930, 2930, 2930, 2930, 140, 2930, 1,
-	805, -1000, -1000, -1000, -1000, 2930, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 922, 925, 830, 912,
-	846, 577, -1000, 10488, 907, 10048, 937, -1000, 2012, 304,
-	-1000, 6262, 38, 773, -1000, -1000, 773, -1000, -1000, 279,
-	-1000, -1000, 6710, 6710, 6710, 6710, 6710, 6710, 6710, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 773, -1000, 5133, 773, 773, 773, 773,
-	773, 773, 773, 773, 6262, 773, 773, 773, 773, 773,
-	773, 773, 773, 773, 773, 773, 773, 773, 468, 9388,
-	762, 410, -1000, -1000, -1000, 906, 7606, 8503, 10488, 746,
-	-1000, 771, 4652, -59, -1000, -1000, -1000, 412, 8283, -1000,
-	-1000, -1000, 872, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 8112, -1000, 238, 12034, 13994, 6452,
+	-1000, 141, 762, 16181, 658, 1185, -1000, -1000, -1000, 678,
+	981, 1184, -120, 1141, 262, 14554, -36, 16181, 231, 231,
+	231, -1000, -1000, -1000, -1000, -1000, 268, 14554, -1000, 14554,
+	228, 797, 228, 228, 228, 14554, -1000, 365, 14554, 792,
+	1105, 302, 4485, 4485, 4485, 4485, 183, 4485, 22, 1010,
+	-1000, -1000, -1000, -1000, 4485, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1157, 1183, 995, 1145, 1068,
+	710, -1000, 14554, 1140, 16181, 1198, -1000, 10634, 359, -1000,
+	8952, 70, 929, -1000, -1000, -1000, -1000, 929, -1000, -1000,
+	322, 355, -1000, -1000, 10072, 10072, 10072, 10072, 10072, 10072,
+	10072, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 929, -1000, 7552, 929, 929,
+	929, 929, 929, 929, 929, 929, 8952, 929, 929, 929,
+	929, 929, 929, 929, 929, 929, 929, 929, 929, 929,
+	547, 13714, 879, 397, -1000, -1000, 1210, 15901, 11194, 15630,
+	14554, 906, -1000, 922, 6171, 0, -1000, -1000, -1000, 489,
+	13434, -1000, -1000, -1000, 1101, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 686, -1000, 2182, 639,
-	2930, 189, 795, 627, 445, 594, 10488, 10488, 2930, 187,
-	10488, 896, 804, 10488, 592, 588, -1000, 4406, -1000, 2930,
-	2930, 2930, 2930, 2930, 2930, 2930, 2930, -1000, -1000, -1000,
-	-1000, -1000, -1000, 2930, 2930, -1000, 5, -1000, 10488, -1000,
-	880, 6262, 6262, 929, -1000, 150, -1000, -1000, -1000, 871,
-	-1000, -1000, -1000, -1000, -1000, 773, 658, 300, 10488, -1000,
-	6262, 6262, 596, -1000, 9163, -1000, -1000, 3422, 351, 295,
-	6710, 489, 441, 6710, 6710, 6710, 6710, 6710, 6710, 6710,
-	6710, 6710, 6710, 6710, 6710, 6710, 6710, 6710, 6710, 516,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 578, -1000,
-	150, 614, 614, -36, -36, -36, -36, -36, -36, 6934,
-	5366, 577, 676, 466, 5133, 5814, 5814, 6262, 6262, 10268,
-	10268, 5814, 916, 438, 466, 10268, -1000, 577, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 5814, 5814, 5814, 5814, -1000,
-	156, 10488, -1000, 10268, 8723, 8723, 8723, 8723, 8723, -1000,
-	839, 837, -1000, 826, 817, 816, 280, 10488, -1000, 674,
-	7606, 268, 773, -1000, 8943, -1000, -1000, 156, 714, 8723,
-	10488, -1000, -1000, 4160, 771, -59, 743, -1000, -21, 4,
-	6038, 272, -1000, -1000, -1000, -1000, 2684, 463, 444, 28,
-	-1000, -1000, -1000, 776, -1000, 776, 776, 776, 776, 58,
-	58, 58, 58, -1000, -1000, -1000, -1000, -1000, 792, 786,
-	-1000, 776, 776, 776, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	777, 777, 777, 779, 779, 797, -1000, 10488, -154, 572,
-	2930, 895, 2930, -1000, 285, -1000, 10488, -1000, -1000, 10488,
-	2930, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 384, -1000, -1000, -1000,
-	-1000, 947, 330, 634, 770, -1000, 622, 922, 577, 846,
-	8059, 822, -1000, -1000, -1000, 10048, 10048, -1000, 351, 388,
-	-1000, -1000, 537, -1000, -1000, -1000, -1000, 292, 773, -1000,
-	3914, 1790, -1000, -1000, -1000, -1000, 489, 6710, 6710, 6710,
-	1675, 1790, 1765, 6, -19, 52, -36, 282, 282, 11,
-	11, 11, 11, 11, 86, 86, -1000, -1000, -1000, 577,
-	-1000, -1000, -1000, 577, 5814, 768, -1000, -1000, 6262, -1000,
-	577, 672, 672, 514, 426, 765, -1000, 286, 759, 672,
-	5814, 417, -1000, 6262, 577, -1000, 672, 577, 672, 672,
-	175, 773, -1000, 767, -1000, 403, 410, 791, 802, 702,
-	-1000, -1000, -1000, -1000, 834, -1000, 824, -1000, 803, -1000,
-	-1000, -1000, -1000, -1000, 241, 218, 201, 10048, -1000, 935,
-	8723, 761, -1000, -1000, 743, -59, -11, -1000, -1000, -1000,
-	466, -1000, 553, 741, 2438, -1000, -1000, -1000, -1000, -1000,
-	-1000, 785, 888, 291, 317, 547, -1000, -1000, 875, -1000,
-	459, 17, -1000, -1000, 505, 58, 58, -1000, -1000, 272,
-	870, 272, 272, 272, 525, 525, -1000, -1000, -1000, -1000,
-	503, -1000, -1000, -1000, 502, -1000, 801, 10048, 2930, -1000,
-	3668, -1000, -1000, -1000, -1000, -1000, -1000, 1254, 887, 446,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 155, -1000, 2930, -1000, 435, 10488, 10488, -1000, 854,
-	6262, 6262, 6262, -1000, -1000, -1000, 880, -1000, 916, 927,
-	-1000, 865, 864, 5814, -1000, 283, -1000, -1000, -1000, -1000,
-	3176, 5814, 261, -1000, 1675, 1790, 1357, -1000, 6710, 6710,
-	-1000, -1000, -1000, 672, 5814, 466, -1000, -1000, -1000, 1944,
-	516, 1944, 6710, 6710, 3914, 6710, 6710, -128, 748, 385,
-	-1000, 6262, 371, -1000, -1000, -1000, -1000, -1000, 800, 10268,
-	773, -1000, 7382, 10048, 929, 10268, 6262, 6262, -1000, -1000,
-	6262, 782, -1000, 6262, -1000, -1000, -1000, -1000, 773, 773,
-	773, 649, -1000, 929, 761, -1000, -1000, -1000, -27, -34,
-	-1000, -1000, 2684, -1000, 2684, 10048, -1000, 544, 538, -1000,
-	-1000, 799, 162, -1000, -1000, -1000, 697, 272, 272, -1000,
-	364, -1000, -1000, -1000, 670, -1000, 666, 740, 663, 10488,
-	-1000, -1000, 730, -1000, 391, -1000, -1000, 10048, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 141,
+	676, 1182, -1000, -1000, -1000, 675, 975, 841, -1000, 121,
+	-1000, 973, -1000, 634, 972, -134, 16461, 775, 4485, 255,
+	998, 773, 518, 771, 14554, 14554, 4485, 240, 14554, 1135,
+	1006, 14554, 768, 736, -1000, 5328, -1000, 4485, 4485, 4485,
+	4485, 4485, 4485, 4485, 4485, -1000, -1000, -1000, -1000, -1000,
+	-1000, 4485, 4485, -1000, 38, -1000, 14554, -1000, 1103, 8952,
+	8952, 1188, -1000, 141, -1000, -1000, -1000, 1097, -1000, -1000,
+	-1000, -1000, -1000, 929, 752, 342, 14554, -1000, 8952, 8952,
+	582, -1000, 13154, -1000, -1000, -1000, 4204, 427, 341, 10072,
+	569, 415, 10072, 10072, 10072, 10072, 10072, 10072, 10072, 10072,
+	10072, 10072, 10072, 10072, 10072, 10072, 10072, 10072, 640, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 732, -1000, 141,
+	1125, 1125, 5609, 15, 15, 15, 15, 15, 15, 10352,
+	7832, 710, 839, 455, 7552, 8112, 8112, 8952, 8952, 14834,
+	14834, 8112, 1149, 506, 455, 14834, -1000, 710, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 8112, 8112, 8112, 8112, -1000,
+	206, 12874, 266, -1000, 14834, 12034, 12034, 12034, 12034, 12034,
+	-1000, 1049, 1046, -1000, 1036, 1033, 1021, 234, -1000, 1210,
+	-1000, 243, 1210, -1000, 14554, 837, 11194, 318, 929, -1000,
+	12594, -1000, -1000, 206, 854, 12034, 14554, -1000, -1000, 5890,
+	922, 0, 910, -1000, 3, 43, 8672, 371, -1000, -1000,
+	-1000, -1000, -1000, -1000, 970, -1000, 634, 6733, 11754, 561,
+	52, -1000, -1000, -1000, -1000, -1000, 947, -1000, 947, 947,
+	947, 947, 120, 120, 120, 120, -1000, -1000, -1000, -1000,
+	-1000, -1000, 965, 964, -1000, 947, 947, 947, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	10048, 10488, -1000, -1000, -1000, -1000, -1000, 10048, -1000, -1000,
-	523, 6262, -1000, -1000, 852, 466, 466, -1000, -1000, 10488,
-	-1000, -1000, -1000, -1000, 731, 10048, -1000, -1000, 577, 3668,
-	-1000, 6710, 1790, 1790, -1000, -1000, 577, 776, 776, -1000,
-	776, 779, 777, 777, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 776, 98, 776, 97, -1000, 577, 577, 407, 1535,
-	-1000, 146, 234, 773, -100, -1000, 466, 6262, -1000, 881,
-	708, 721, -1000, -1000, 5590, 577, 658, 649, 922, -1000,
-	466, 466, 466, 10048, 466, 10048, 10048, 10048, 7839, 10048,
-	922, -1000, -1000, -1000, -1000, 2438, -1000, 647, -1000, 776,
-	-1000, -1000, 35, 946, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 58, 521, 58, 500, -1000,
-	492, 2930, 3668, 2684, -1000, 774, -1000, -1000, -1000, -1000,
-	891, -1000, 466, -1000, -1000, 935, 8723, -1000, -1000, 1790,
-	-1000, -1000, 194, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 6710, 6710, -1000, 6710, 6710, 6710,
-	577, 520, 466, 884, -1000, 773, -1000, -1000, 171, -1000,
-	-1000, 636, -1000, 625, 625, 625, 268, -1000, -1000, 294,
-	10048, -1000, 281, -1000, -70, 272, -1000, 272, 637, 615,
-	-1000, -1000, -1000, 10048, 773, 932, 724, -1000, -1000, 1623,
-	1623, 1623, 1623, 73, -1000, -1000, 945, -1000, 773, -1000,
-	150, 10048, -1000, -1000, -1000, -1000, -1000, 294, -1000, 532,
-	369, 512, -1000, 462, 882, -1000, 877, -1000, -1000, -1000,
-	-1000, -1000, 608, 152, 928, 924, -1000, -1000, -1000, -1000,
-	577, 67, -162, 10268, 721, 577, -1000, -1000, -1000, 479,
-	-1000, -1000, -1000, 491, -1000, -1000, 795, 576, -1000, 10048,
-	-1000, 6262, 6262, -1000, 851, -136, -177, 715, -1000, -1000,
-	-1000, -154, -1000, 152, 861, 466, 711, -1000, 850, -1000,
-	-1000, -1000, 148, -159, 129, -163, 773, -178, 6486, -1000,
-	1623, 577, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 949, 949, 949,
+	950, 950, 16181, 833, -1000, 487, 16181, 671, -1000, -1000,
+	668, 999, -1000, 14554, -160, 713, 4485, 1134, 4485, -1000,
+	651, -1000, 14554, -1000, -1000, 14554, 4485, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 482, -1000, -1000, -1000, -1000, 1205, 394, 641,
+	921, -1000, 542, 1157, 710, 1068, 12314, 1024, -1000, -1000,
+	-1000, 16181, 16181, -1000, 427, 419, -1000, -1000, 616, -1000,
+	-1000, -1000, -1000, 326, 929, -1000, 4766, 1953, -1000, -1000,
+	-1000, -1000, 569, 10072, 10072, 10072, 1778, 1953, 1972, 1500,
+	108, 502, 15, 227, 227, 17, 17, 17, 17, 17,
+	2, 2, -1000, -1000, -1000, 710, -1000, -1000, -1000, -1000,
+	-1000, 710, 8112, 917, -1000, -1000, 8952, -1000, 710, 806,
+	806, 486, 473, 909, -1000, 325, 890, 806, 8112, 543,
+	-1000, 8952, 710, -1000, 806, 710, 806, 806, 106, 929,
+	14554, -1000, 14554, 902, -1000, 478, 397, 961, 1005, 794,
+	-1000, -1000, -1000, -1000, 1043, -1000, 1039, -1000, 1027, -1000,
+	-1000, -1000, 1019, -1000, -1000, -1000, -1000, 260, 258, 257,
+	16181, -1000, 1196, 12034, 886, -1000, -1000, 910, 0, 30,
+	-1000, -1000, -1000, 455, -1000, 696, 16181, 813, 908, 267,
+	7014, 658, -1000, -120, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 958, 1121, 347, 392, 694, -1000, -1000, 1107, -1000,
+	549, 34, -1000, -1000, 605, 120, 120, -1000, -1000, 371,
+	1095, 371, 371, 371, 654, 654, -1000, -1000, -1000, 464,
+	459, 453, -1000, 590, -1000, -1000, -1000, 588, -1000, 811,
+	-1000, 121, -1000, 634, 650, 809, -1000, -157, 107, -118,
+	1004, 16181, 4485, -1000, 5609, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1388, 1326, 495, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 201, -1000, 4485, -1000, 497,
+	14554, 14554, -1000, 1075, 8952, 8952, 8952, -1000, -1000, -1000,
+	1103, -1000, 1149, 1161, -1000, 1087, 1086, 8112, -1000, 311,
+	-1000, -1000, -1000, -1000, 5047, 8112, 308, -1000, 1778, 1953,
+	1937, -1000, 10072, 10072, 290, -1000, -98, 806, 8112, 455,
+	-1000, -1000, -1000, 1627, 640, 1627, 10072, 10072, 4766, 10072,
+	10072, -150, 901, 498, -1000, 8952, 460, -1000, -1000, -1000,
+	-1000, -1000, 1003, 14834, 929, -1000, 11474, 16181, 200, 200,
+	1188, 14834, 8952, 8952, -1000, -1000, 8952, 952, -1000, 8952,
+	-1000, -1000, -1000, -1000, 16181, -1000, 929, 929, 929, 749,
+	-1000, 1188, 886, -1000, -1000, -1000, 9, 24, -1000, -1000,
+	803, -1000, 7295, -1000, 7295, 16181, -1000, 692, 690, -1000,
+	-1000, 1002, 164, -1000, -1000, -1000, 830, 371, 371, -1000,
+	426, -1000, -1000, -1000, 801, -1000, 795, 1627, 1627, 16181,
+	905, 789, -1000, 16181, 545, -1000, -1000, -106, 16181, -1000,
+	-145, -124, -137, 1089, -128, -141, 646, 14554, -1000, -1000,
+	887, -1000, 475, -1000, -1000, 16181, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 16181, 14554,
+	-1000, -1000, -1000, -1000, -1000, 16181, -1000, -1000, 644, 8952,
+	-1000, -1000, 1065, 455, 455, -1000, -1000, 14554, -1000, -1000,
+	-1000, -1000, 859, 16181, -1000, 287, 710, 5609, -1000, 10072,
+	1953, 1953, 5609, -1000, 15372, -98, -1000, 710, 947, 947,
+	-1000, 947, 950, 949, 949, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 947, 159, 947, 103, -1000, 947, -1000, -1000,
+	-1000, 710, 710, 779, 1539, -1000, 277, 361, 942, 929,
+	-44, -1000, 455, 8952, -1000, 1123, 846, 864, -1000, -1000,
+	8392, 710, 752, 749, 194, 929, 226, 1157, -1000, 455,
+	455, 455, 16181, 455, 929, 16181, 16181, 16181, 15092, 16181,
+	1157, -1000, -1000, -1000, -1000, -1000, 7014, -1000, 747, -1000,
+	947, -1000, -1000, 57, 1203, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 120, 643, 120, 435,
+	881, 507, -1000, -189, 587, -1000, 580, -1000, -1000, 642,
+	1127, 1181, -1000, 938, 1179, -130, -131, 1175, 1139, -1000,
+	4485, 5609, 7295, -1000, 937, -1000, -1000, -1000, -1000, 1126,
+	-1000, 455, -1000, -1000, 1196, 12034, -1000, 5609, -1000, 1953,
+	-1000, 684, -1000, -1000, -1000, -1000, 218, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 10072,
+	10072, 5609, -1000, 10072, 10072, 10072, 710, 639, 455, 1113,
+	-1000, 929, -1000, -1000, 187, -1000, -1000, 16181, 141, 515,
+	-1000, 745, 8112, 743, 743, 743, 318, -1000, -1000, 299,
+	16181, -1000, 353, -1000, -15, 371, -1000, 371, -1000, 1627,
+	-1000, 16181, 1627, 796, 727, -1000, 579, 931, 634, 630,
+	1174, 1173, 624, 620, -1000, -1000, -1000, 16181, 929, 1194,
+	867, -1000, 710, 199, -1000, -1000, -1000, 1757, 1757, -1000,
+	1757, 1757, 310, -1000, -1000, 1201, -1000, 929, -1000, 141,
+	726, -1000, 466, -1000, 1131, -1000, 710, -1000, -1000, -1000,
+	-1000, 299, -1000, 685, 461, 617, -1000, 545, 1112, -1000,
+	1109, -1000, -1000, -1000, 420, -1000, -1000, -1000, -1000, -108,
+	8952, 718, -133, 613, 609, -1000, -1000, 709, 198, 1191,
+	1163, -1000, 1188, 1160, -1000, -1000, -1000, -1000, 710, 78,
+	-168, 14834, 864, 710, -1000, 16181, 10072, -1000, 14554, -1000,
+	-1000, 577, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 707,
+	-1000, -1000, 1159, -1000, -1000, 998, 689, -1000, 16181, 1212,
+	8952, 8952, -27, 8952, -1000, 1063, -154, -172, 858, -1000,
+	-1000, 1953, 1138, -1000, -1000, 571, -160, -1000, 198, 1083,
+	-1000, 16181, 455, 856, -1000, 9512, -1000, -1000, 856, -1000,
+	1057, -1000, -1000, 16181, -1000, -1000, -1000, 191, 853, -1000,
+	1137, -1000, 9792, -72, -71, 88, -165, 645, 185, 16181,
+	929, 520, -1000, -1000, -1000, -1000, -1000, -170, 929, -1000,
+	684, 9792, -174, 9232, 710, -1000, -1000, 1757, 710, -1000,
+	-1000, -1000,
 }
 
 var yyPgo = [...]int16{
-	0, 1191, 11, 758, 227, 1189, 1188, 1185, 1182, 1180,
-	1179, 1178, 1177, 1175, 1171, 1169, 1168, 1162, 1161, 1160,
-	1159, 1156, 1155, 1154, 1150, 69, 1149, 1147, 1146, 59,
-	1142, 44, 1140, 1138, 35, 347, 40, 48, 796, 1137,
-	16, 65, 62, 1128, 43, 1127, 1126, 66, 1125, 60,
-	1124, 1122, 126, 1121, 1120, 14, 18, 1119, 1117, 1115,
-	1114, 64, 561, 1113, 1112, 1111, 1110, 1109, 1107, 50,
-	8, 7, 6, 17, 1106, 232, 10, 1104, 49, 1101,
-	1097, 1096, 1095, 33, 1093, 37, 1090, 23, 39, 32,
-	13, 1089, 163, 1088, 55, 30, 22, 9, 61, 53,
-	1086, 27, 54, 42, 1085, 1084, 265, 1083, 1082, 1081,
-	1080, 1078, 1077, 296, 251, 1075, 1073, 1072, 1070, 45,
-	180, 490, 551, 73, 1069, 1066, 1065, 1376, 67, 51,
-	20, 1061, 46, 1157, 36, 1043, 1040, 31, 1037, 1035,
-	1032, 1031, 1027, 1023, 1022, 34, 1011, 1009, 1006, 24,
-	26, 1005, 1003, 25, 28, 1001, 1000, 999, 41, 47,
-	998, 57, 997, 996, 994, 992, 29, 19, 991, 15,
-	990, 5, 989, 982, 3, 979, 21, 977, 2, 976,
-	4, 38, 975, 974, 0, 464, 962, 961, 74,
+	0, 1468, 64, 998, 134, 1466, 1465, 1464, 1462, 1454,
+	1452, 1451, 1448, 1447, 1445, 1443, 1442, 1440, 1439, 1438,
+	1437, 1433, 1432, 1429, 1427, 93, 1425, 1423, 1419, 92,
+	1417, 75, 1416, 1415, 51, 89, 29, 55, 45, 1414,
+	40, 91, 99, 1413, 72, 1410, 1405, 97, 1404, 83,
+	1400, 1399, 1745, 1397, 1395, 82, 1394, 90, 1393, 1392,
+	1391, 46, 1390, 10, 23, 42, 1389, 1383, 1376, 26,
+	94, 257, 1375, 1374, 1370, 1369, 1367, 1366, 73, 5,
+	15, 21, 24, 1365, 36, 9, 1364, 74, 1363, 1362,
+	1360, 1359, 32, 3, 1358, 1355, 2, 1354, 1353, 1,
+	1352, 1351, 17, 12, 58, 1350, 43, 53, 54, 8,
+	1349, 147, 1347, 80, 47, 39, 11, 96, 78, 1345,
+	48, 81, 67, 1344, 1343, 323, 1342, 1339, 1338, 1336,
+	1330, 1328, 240, 270, 1327, 1326, 1323, 1321, 35, 0,
+	751, 1947, 281, 95, 1320, 1319, 1313, 1310, 2815, 49,
+	88, 33, 1309, 76, 41, 426, 52, 1307, 1304, 31,
+	62, 1302, 19, 61, 1298, 1297, 1295, 1293, 1283, 1272,
+	70, 1271, 13, 1270, 28, 18, 1269, 1267, 50, 59,
+	1265, 1262, 1261, 60, 79, 1260, 66, 1259, 1258, 1257,
+	77, 63, 27, 71, 1246, 69, 1245, 1244, 68, 16,
+	1242, 57, 1241, 44, 38, 1239, 20, 1234, 14, 1233,
+	1232, 4, 1231, 25, 1227, 7, 1226, 6, 56, 1225,
+	1224, 1670, 1735, 1223, 1221, 98,
 }
 
 var yyR1 = [...]uint8{
-	0, 182, 183, 183, 1, 1, 1, 1, 1, 1,
+	0, 219, 220, 220, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 2, 2, 3, 3, 3, 7, 4, 5,
-	5, 6, 6, 93, 93, 92, 91, 91, 8, 8,
-	28, 28, 9, 10, 10, 10, 186, 186, 47, 47,
-	94, 94, 11, 11, 11, 11, 99, 99, 103, 103,
-	103, 104, 104, 104, 104, 135, 135, 12, 12, 12,
-	12, 12, 12, 12, 180, 180, 179, 178, 178, 177,
-	177, 176, 17, 163, 164, 164, 164, 159, 138, 138,
-	138, 138, 141, 141, 139, 139, 139, 139, 139, 139,
-	139, 140, 140, 140, 140, 140, 142, 142, 142, 142,
-	142, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 144, 144, 144, 144,
-	144, 144, 144, 144, 158, 158, 145, 145, 153, 153,
-	154, 154, 154, 151, 151, 152, 152, 155, 155, 155,
-	146, 146, 146, 146, 146, 146, 146, 148, 148, 156,
-	156, 149, 149, 149, 150, 150, 157, 157, 157, 157,
-	157, 147, 147, 160, 160, 172, 172, 171, 171, 171,
-	162, 162, 168, 168, 168, 168, 168, 161, 161, 170,
-	170, 169, 165, 165, 165, 166, 166, 166, 167, 167,
-	167, 13, 13, 13, 13, 13, 13, 13, 13, 13,
-	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
-	181, 175, 173, 173, 174, 174, 14, 15, 15, 15,
-	15, 15, 16, 16, 18, 19, 19, 19, 19, 19,
+	5, 6, 6, 112, 112, 111, 110, 110, 8, 8,
+	8, 8, 28, 28, 9, 10, 10, 10, 223, 223,
+	47, 47, 61, 61, 62, 62, 63, 63, 60, 60,
+	113, 113, 11, 11, 11, 11, 118, 118, 122, 122,
+	122, 123, 123, 123, 123, 157, 157, 12, 12, 12,
+	12, 12, 12, 12, 12, 12, 217, 217, 216, 215,
+	215, 214, 214, 213, 17, 188, 188, 190, 190, 190,
+	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
+	190, 191, 191, 192, 193, 194, 194, 195, 195, 200,
+	200, 196, 196, 196, 196, 196, 196, 197, 197, 198,
+	198, 199, 199, 201, 189, 189, 189, 184, 163, 163,
+	163, 163, 163, 163, 166, 166, 164, 164, 164, 164,
+	164, 164, 164, 165, 165, 165, 165, 165, 167, 167,
+	167, 167, 167, 168, 168, 168, 168, 168, 168, 168,
+	168, 168, 168, 168, 168, 168, 168, 168, 168, 169,
+	169, 169, 169, 169, 169, 169, 169, 183, 183, 170,
+	170, 178, 178, 179, 179, 179, 176, 176, 177, 177,
+	180, 180, 180, 171, 171, 171, 171, 171, 171, 171,
+	173, 173, 181, 181, 174, 174, 174, 175, 175, 182,
+	182, 182, 182, 182, 172, 172, 185, 185, 209, 209,
+	208, 208, 208, 187, 187, 205, 205, 205, 205, 205,
+	186, 186, 207, 207, 206, 202, 202, 202, 203, 203,
+	203, 204, 204, 204, 204, 204, 13, 13, 13, 13,
+	13, 13, 13, 13, 13, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 212, 210, 210, 211,
+	211, 14, 15, 15, 15, 15, 15, 16, 16, 18,
+	19, 19, 19, 19, 19, 19, 19, 19, 19, 19,
 	19, 19, 19, 19, 19, 19, 19, 19, 19, 19,
-	19, 19, 19, 19, 19, 19, 19, 19, 111, 111,
-	108, 108, 109, 109, 110, 110, 110, 112, 112, 112,
-	136, 136, 136, 20, 20, 22, 22, 23, 24, 21,
-	21, 21, 21, 21, 187, 25, 26, 26, 27, 27,
-	27, 31, 31, 31, 29, 29, 30, 30, 36, 36,
-	35, 35, 37, 37, 37, 37, 124, 124, 124, 123,
-	123, 39, 39, 40, 40, 41, 41, 42, 42, 42,
-	42, 54, 54, 90, 90, 95, 95, 43, 43, 43,
-	43, 44, 44, 45, 45, 46, 46, 131, 131, 130,
-	130, 130, 129, 129, 48, 48, 48, 50, 49, 49,
-	49, 49, 49, 49, 51, 51, 53, 53, 52, 52,
-	55, 55, 55, 55, 56, 56, 38, 38, 38, 38,
-	38, 38, 38, 107, 107, 58, 58, 57, 57, 57,
-	57, 57, 57, 57, 57, 57, 57, 68, 68, 68,
-	68, 68, 68, 59, 59, 59, 59, 59, 59, 59,
-	34, 34, 69, 69, 69, 75, 70, 70, 62, 62,
-	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
-	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
-	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
-	66, 66, 66, 64, 64, 64, 64, 64, 64, 64,
-	64, 64, 64, 64, 64, 64, 64, 64, 65, 65,
-	65, 65, 65, 65, 65, 65, 188, 188, 67, 67,
-	67, 67, 32, 32, 32, 32, 32, 134, 134, 137,
-	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
-	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
-	137, 79, 79, 33, 33, 77, 77, 78, 80, 80,
-	76, 76, 76, 61, 61, 61, 61, 61, 61, 61,
-	61, 63, 63, 63, 81, 81, 82, 82, 83, 83,
-	84, 84, 85, 86, 86, 86, 87, 87, 87, 87,
-	88, 88, 88, 60, 60, 60, 60, 60, 60, 89,
-	89, 89, 89, 96, 96, 71, 71, 73, 73, 72,
-	74, 97, 97, 101, 98, 98, 102, 102, 102, 100,
-	100, 100, 126, 126, 126, 105, 105, 113, 113, 114,
-	114, 106, 106, 115, 115, 115, 115, 115, 115, 115,
-	115, 115, 115, 116, 116, 116, 117, 117, 118, 118,
-	118, 125, 125, 121, 121, 122, 122, 127, 127, 128,
-	128, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 119, 120, 120, 120, 120, 120, 120, 120,
-	120, 120, 120, 120, 120, 120, 120, 120, 120, 120,
-	120, 120, 120, 120, 120, 120, 120, 120, 120, 120,
-	120, 120, 120, 120, 120, 120, 120, 120, 120, 120,
-	120, 120, 120, 120, 120, 120, 120, 120, 120, 120,
-	120, 120, 120, 120, 120, 120, 120, 120, 120, 120,
-	120, 120, 120, 120, 120, 120, 120, 120, 120, 120,
-	120, 120, 120, 120, 120, 120, 120, 120, 120, 120,
-	120, 120, 120, 120, 120, 120, 120, 120, 120, 120,
-	120, 120, 120, 120, 120, 120, 120, 120, 120, 120,
-	120, 184, 185, 132, 133, 133, 133,
+	19, 19, 19, 130, 130, 127, 127, 128, 128, 129,
+	129, 129, 131, 131, 131, 158, 158, 158, 20, 20,
+	22, 22, 23, 24, 21, 21, 21, 21, 21, 224,
+	25, 26, 26, 27, 27, 27, 31, 31, 31, 29,
+	29, 30, 30, 36, 36, 35, 35, 37, 37, 37,
+	37, 144, 144, 144, 143, 143, 143, 39, 39, 40,
+	40, 41, 41, 42, 42, 42, 42, 54, 54, 55,
+	55, 56, 56, 57, 59, 59, 58, 58, 109, 109,
+	114, 114, 43, 43, 43, 43, 44, 44, 45, 45,
+	46, 46, 152, 152, 151, 151, 151, 150, 150, 48,
+	48, 48, 50, 49, 49, 49, 49, 49, 49, 51,
+	51, 53, 53, 52, 52, 64, 64, 64, 64, 65,
+	65, 38, 38, 38, 38, 38, 38, 38, 126, 126,
+	67, 67, 66, 66, 66, 66, 66, 66, 66, 66,
+	66, 66, 77, 77, 77, 77, 77, 77, 68, 68,
+	68, 68, 68, 68, 68, 34, 34, 78, 78, 78,
+	84, 79, 79, 71, 71, 71, 71, 71, 71, 71,
+	71, 71, 71, 71, 71, 71, 71, 71, 71, 71,
+	71, 71, 71, 71, 71, 71, 71, 71, 71, 71,
+	71, 71, 71, 71, 71, 71, 75, 75, 75, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 74, 74, 74, 74, 74, 74,
+	74, 74, 225, 225, 76, 76, 76, 76, 92, 92,
+	92, 93, 97, 97, 94, 94, 95, 95, 96, 91,
+	91, 98, 98, 98, 100, 100, 99, 99, 99, 99,
+	99, 32, 32, 32, 32, 32, 156, 156, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 160, 160, 160, 153, 161, 161,
+	162, 88, 88, 33, 33, 86, 86, 87, 89, 89,
+	85, 85, 85, 147, 147, 147, 70, 70, 70, 70,
+	70, 70, 70, 70, 70, 72, 72, 72, 90, 90,
+	101, 101, 102, 102, 103, 103, 104, 105, 105, 105,
+	106, 106, 106, 106, 107, 107, 107, 69, 69, 69,
+	69, 69, 69, 108, 108, 108, 108, 115, 115, 80,
+	80, 82, 82, 81, 83, 116, 116, 120, 117, 117,
+	121, 121, 121, 119, 119, 119, 146, 146, 146, 124,
+	124, 132, 132, 133, 133, 125, 125, 134, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 135, 135, 135,
+	136, 136, 137, 137, 137, 145, 145, 141, 141, 141,
+	142, 142, 148, 148, 148, 148, 149, 149, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 221, 222, 154, 155, 155, 155,
 }
 
 var yyR2 = [...]int8{
 	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 3, 1, 4, 6, 7, 5, 10, 1,
+	1, 1, 3, 1, 4, 6, 7, 5, 11, 1,
 	3, 1, 3, 1, 3, 4, 0, 3, 7, 8,
-	1, 1, 8, 8, 7, 6, 1, 1, 1, 3,
+	8, 9, 1, 1, 8, 8, 7, 6, 1, 1,
+	1, 3, 0, 4, 1, 3, 1, 3, 0, 3,
 	0, 4, 3, 4, 5, 4, 1, 3, 3, 2,
-	2, 2, 2, 2, 1, 1, 1, 2, 8, 4,
-	6, 5, 5, 5, 0, 2, 1, 0, 2, 1,
-	3, 3, 4, 4, 1, 3, 3, 8, 3, 1,
-	1, 1, 2, 1, 1, 1, 1, 1, 1, 1,
-	1, 2, 2, 2, 2, 2, 1, 2, 2, 2,
-	1, 4, 4, 2, 2, 3, 3, 3, 3, 1,
-	1, 1, 1, 1, 6, 6, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 3, 0, 3, 0, 5,
-	0, 3, 5, 0, 1, 0, 1, 0, 1, 2,
-	0, 2, 2, 2, 2, 2, 2, 0, 3, 0,
-	1, 0, 3, 3, 0, 2, 0, 2, 1, 2,
-	1, 0, 2, 5, 4, 1, 2, 2, 3, 2,
-	0, 1, 2, 3, 3, 2, 2, 1, 1, 1,
-	3, 2, 0, 1, 3, 1, 2, 3, 1, 1,
-	1, 6, 7, 7, 12, 7, 7, 7, 4, 5,
+	2, 2, 2, 2, 1, 1, 1, 2, 3, 4,
+	8, 4, 6, 5, 5, 5, 0, 2, 1, 0,
+	2, 1, 3, 3, 4, 4, 4, 2, 5, 1,
+	1, 1, 2, 4, 3, 6, 2, 2, 2, 3,
+	5, 1, 3, 3, 9, 0, 5, 5, 4, 0,
+	5, 0, 6, 6, 6, 5, 5, 0, 3, 3,
+	6, 1, 3, 3, 1, 3, 3, 8, 3, 1,
+	1, 1, 1, 1, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 2, 2, 2, 2, 2, 1, 2,
+	2, 2, 1, 4, 4, 2, 2, 3, 3, 3,
+	3, 1, 1, 1, 1, 1, 1, 6, 6, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 3, 0,
+	3, 0, 5, 0, 3, 5, 0, 1, 0, 1,
+	0, 1, 2, 0, 2, 2, 2, 2, 2, 2,
+	0, 3, 0, 1, 0, 3, 3, 0, 2, 0,
+	2, 1, 2, 1, 0, 2, 5, 4, 1, 2,
+	2, 3, 2, 0, 1, 2, 3, 3, 2, 2,
+	1, 1, 1, 3, 2, 0, 1, 3, 1, 2,
+	3, 1, 1, 1, 1, 1, 6, 7, 7, 12,
+	7, 7, 7, 4, 5, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 7, 1, 3, 8,
+	8, 5, 4, 6, 5, 4, 4, 3, 2, 3,
+	4, 4, 4, 4, 4, 4, 4, 4, 3, 3,
+	3, 3, 4, 3, 6, 4, 2, 4, 2, 2,
+	2, 2, 3, 1, 1, 0, 1, 0, 1, 0,
+	2, 2, 0, 2, 2, 0, 1, 1, 2, 1,
+	1, 2, 1, 1, 2, 2, 2, 2, 2, 0,
+	2, 0, 2, 1, 2, 2, 0, 1, 1, 0,
+	1, 0, 1, 0, 1, 1, 3, 1, 2, 3,
+	5, 0, 1, 2, 1, 1, 1, 0, 2, 1,
+	3, 1, 1, 2, 3, 1, 3, 3, 7, 0,
+	1, 1, 2, 9, 0, 1, 0, 2, 1, 3,
+	1, 3, 4, 4, 4, 3, 2, 4, 0, 1,
+	0, 2, 1, 2, 0, 1, 2, 1, 1, 1,
+	2, 2, 1, 2, 3, 2, 3, 2, 3, 2,
+	2, 2, 1, 1, 3, 0, 5, 5, 5, 0,
+	2, 1, 3, 3, 2, 3, 1, 2, 0, 3,
+	1, 1, 3, 3, 4, 4, 5, 3, 4, 5,
+	6, 2, 1, 2, 1, 2, 1, 2, 1, 1,
+	1, 1, 1, 1, 1, 0, 2, 1, 1, 1,
+	3, 1, 3, 1, 1, 1, 4, 1, 1, 1,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 2, 2, 2, 2, 2,
+	2, 3, 1, 1, 1, 1, 5, 6, 6, 4,
+	4, 6, 6, 6, 6, 8, 8, 6, 8, 8,
+	9, 7, 5, 4, 2, 2, 2, 2, 2, 2,
+	2, 2, 0, 2, 4, 4, 4, 4, 0, 4,
+	2, 4, 0, 1, 0, 2, 1, 3, 5, 0,
+	3, 0, 2, 5, 1, 1, 2, 2, 2, 2,
+	2, 0, 3, 4, 7, 3, 1, 1, 2, 3,
+	3, 1, 2, 2, 2, 2, 1, 1, 1, 1,
+	1, 1, 1, 2, 1, 2, 2, 1, 2, 1,
+	2, 1, 1, 1, 5, 7, 5, 0, 1, 3,
+	3, 0, 1, 0, 2, 1, 2, 4, 0, 2,
+	1, 3, 5, 7, 6, 3, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 2, 2, 0, 3,
+	0, 2, 0, 3, 1, 3, 2, 0, 1, 1,
+	0, 2, 4, 4, 0, 2, 4, 2, 1, 3,
+	5, 4, 6, 1, 3, 3, 5, 0, 5, 1,
+	3, 1, 2, 3, 1, 1, 3, 3, 1, 3,
+	3, 3, 3, 1, 2, 1, 1, 1, 1, 1,
+	1, 0, 2, 0, 3, 0, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 0, 1, 1,
+	1, 1, 0, 1, 1, 0, 2, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 7, 1, 3, 8, 8, 5, 4, 6, 5,
-	4, 4, 3, 2, 3, 4, 4, 4, 4, 4,
-	4, 4, 4, 3, 3, 3, 3, 4, 3, 6,
-	4, 2, 4, 2, 2, 2, 2, 3, 1, 1,
-	0, 1, 0, 1, 0, 2, 2, 0, 2, 2,
-	0, 1, 1, 2, 1, 1, 2, 1, 1, 2,
-	2, 2, 2, 2, 0, 2, 0, 2, 1, 2,
-	2, 0, 1, 1, 0, 1, 0, 1, 0, 1,
-	1, 3, 1, 2, 3, 5, 0, 1, 2, 1,
-	1, 0, 2, 1, 3, 1, 1, 1, 3, 1,
-	3, 3, 7, 1, 3, 1, 3, 4, 4, 4,
-	3, 2, 4, 0, 1, 0, 2, 0, 1, 0,
-	1, 2, 1, 1, 1, 2, 2, 1, 2, 3,
-	2, 3, 2, 3, 2, 2, 2, 1, 1, 3,
-	0, 5, 5, 5, 0, 2, 1, 3, 3, 2,
-	3, 1, 2, 0, 3, 1, 1, 3, 3, 4,
-	4, 5, 3, 4, 5, 6, 2, 1, 2, 1,
-	2, 1, 2, 1, 1, 1, 1, 1, 1, 1,
-	0, 2, 1, 1, 1, 3, 1, 3, 1, 1,
-	1, 4, 1, 1, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 2,
-	2, 2, 2, 2, 2, 3, 1, 1, 1, 1,
-	4, 5, 6, 4, 4, 6, 6, 6, 6, 8,
-	8, 6, 8, 8, 9, 7, 5, 4, 2, 2,
-	2, 2, 2, 2, 2, 2, 0, 2, 4, 4,
-	4, 4, 0, 3, 4, 7, 3, 1, 1, 2,
-	3, 3, 1, 2, 2, 2, 2, 1, 1, 1,
-	1, 1, 1, 1, 2, 1, 2, 2, 1, 2,
-	1, 0, 1, 0, 2, 1, 2, 4, 0, 2,
-	1, 3, 5, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 2, 2, 0, 3, 0, 2, 0, 3,
-	1, 3, 2, 0, 1, 1, 0, 2, 4, 4,
-	0, 2, 4, 2, 1, 3, 5, 4, 6, 1,
-	3, 3, 5, 0, 5, 1, 3, 1, 2, 3,
-	1, 1, 3, 3, 1, 3, 3, 3, 3, 1,
-	2, 1, 1, 1, 1, 1, 1, 0, 2, 0,
-	3, 0, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 0, 1, 1, 1, 1, 0, 1,
-	1, 0, 2, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -2091,308 +2813,364 @@ var yyR2 = [...]int8{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 0, 0, 1, 1,
+	1, 1, 1, 1, 0, 0, 1, 1,
 }
 
 var yyChk = [...]int16{
-	-1000, -182, -1, -2, -7, -8, -9, -10, -11, -12,
+	-1000, -219, -1, -2, -7, -8, -9, -10, -11, -12,
 	-13, -14, -15, -16, -18, -19, -20, -22, -23, -24,
-	-21, 247, -3, 7, -28, 9, 10, 30, -17, 113,
-	114, 116, 115, 141, 117, 134, 49, 153, 154, 156,
-	157, 25, 135, 136, 139, 140, -4, -5, 6, 8,
-	236, -184, 53, -183, 251, -93, -92, -127, 56, -120,
-	244, 153, 164, 158, 185, 177, 175, 178, 215, 66,
-	156, 224, 137, 173, 169, 167, 27, 190, 249, 168,
-	132, 131, 191, 195, 216, 162, 163, 218, 189, 133,
-	32, 246, 34, 145, 219, 193, 188, 184, 187, 161,
-	183, 38, 197, 196, 198, 214, 180, 170, 18, 222,
-	140, 143, 192, 194, 127, 147, 248, 220, 166, 144,
-	139, 223, 157, 217, 226, 37, 202, 160, 59, 130,
-	154, 151, 181, 146, 171, 172, 186, 159, 182, 155,
-	148, 141, 225, 203, 250, 179, 176, 152, 122, 149,
-	150, 207, 208, 209, 210, 221, 174, 204, -25, -187,
-	-25, -25, -25, -25, -163, 53, -118, 122, 71, 149,
-	228, 119, 120, 126, -121, 56, -120, -106, 122, 124,
-	120, 120, 121, 122, 228, 119, 120, -52, -127, 120,
-	107, 178, 113, 205, 121, 32, 147, -136, 120, -108,
-	150, 207, 208, 209, 210, 56, 217, 216, 211, -127,
-	155, -132, -132, -132, -132, -132, -83, 15, -27, 5,
-	-25, -2, -3, 54, -91, -184, -37, 98, -38, -127,
-	-57, 73, -62, 29, 56, -120, 23, -61, -58, -76,
-	-74, -75, 107, 108, 96, 97, 104, 74, 109, -66,
-	-64, -65, -67, 58, 57, 67, 60, 61, 62, 63,
-	68, 69, 70, -121, -72, -184, 43, 44, 237, 238,
-	239, 240, 243, 241, 76, 33, 227, 235, 234, 233,
-	231, 232, 229, 230, 125, 228, 102, 236, -26, -106,
-	-40, -41, -42, -43, -54, -75, -184, -52, 11, -47,
-	-52, -98, -135, 155, -102, 217, 216, -122, -100, -121,
-	-119, 215, 178, 214, 118, 72, 22, 24, 200, 75,
-	107, 16, 76, 106, 237, 113, 47, 229, 230, 227,
-	239, 240, 228, 205, 29, 10, 25, 135, 21, 100,
-	115, 79, 80, 138, 23, 136, 70, 19, 50, 11,
-	13, 14, 125, 124, 91, 121, 45, 8, 109, 26,
-	88, 41, 28, 43, 89, 17, 231, 232, 31, 243,
-	142, 102, 48, 35, 73, 68, 51, 71, 15, 46,
-	90, 116, 236, 44, 119, 6, 242, 30, 134, 42,
-	120, 206, 78, 123, 69, 5, 126, 9, 49, 52,
-	233, 234, 235, 33, 77, 12, -164, -159, 56, 121,
-	-52, 236, -121, -114, 125, -114, -114, 120, -52, -52,
-	-113, 125, 56, -113, -113, -113, -52, 110, -52, 56,
-	30, 228, 56, 147, 120, 148, 122, -133, -184, -122,
-	-133, -133, -133, 151, 152, -133, -109, 212, 51, -133,
-	-87, 17, 16, -6, -4, -184, 6, 20, 21, -31,
-	39, 40, -185, 55, -92, 22, -89, -121, 11, -124,
-	72, 71, 88, -123, 22, -121, 58, 110, -38, -127,
-	-59, 91, 73, 89, 90, 75, 252, 93, 92, 103,
-	96, 97, 98, 99, 100, 101, 102, 94, 95, 106,
-	81, 82, 83, 84, 85, 86, 87, -107, -184, -75,
-	-184, 111, 112, -62, -62, -62, -62, -62, -62, -62,
-	-184, -2, -70, -38, -184, -184, -184, -184, -184, -184,
-	-184, -184, -184, -79, -38, -184, -188, -184, -188, -188,
-	-188, -188, -188, -188, -188, -184, -184, -184, -184, 65,
-	-53, 26, -52, 30, 54, -48, -50, -49, -51, 41,
-	45, 47, 42, 43, 44, 212, 48, -131, 22, -40,
-	-184, -130, 143, -129, 22, -127, 58, -52, -47, -186,
-	54, 11, 52, 54, -98, 155, -99, -103, 218, 220,
-	81, -126, -121, 58, 29, 30, 55, 54, -138, -141,
-	-143, -142, -144, -139, -140, 175, 176, 107, 179, 181,
-	182, 183, 184, 185, 186, 187, 188, 189, 190, 30,
-	137, 171, 172, 173, 174, 191, 192, 193, 194, 195,
-	196, 197, 198, 158, 159, 160, 161, 162, 163, 164,
-	166, 167, 168, 169, 170, 56, -133, 122, -180, 52,
-	56, 73, 56, -52, -52, -133, 123, -52, 23, 51,
-	-52, 56, 56, -128, -127, -119, -133, -133, -133, -133,
-	-133, -133, -133, -133, -133, -133, -111, 206, 213, -52,
-	-88, 19, 31, -38, -84, -85, -38, -83, -2, -25,
-	35, -29, 21, -75, -185, 54, 110, -52, -38, -38,
-	-68, 68, 73, 69, 70, -123, 98, -128, -122, -119,
-	110, -62, -69, -72, -75, 64, 91, 89, 90, 75,
-	-62, -62, -62, -62, -62, -62, -62, -62, -62, -62,
-	-62, -62, -62, -62, -62, -62, -134, 56, 58, 56,
-	-61, -61, -121, -36, 21, -35, -37, -185, 54, -185,
-	-2, -35, -35, -38, -38, -76, -121, -127, -76, -35,
-	-29, -77, -78, 77, -76, -185, -35, -36, -35, -35,
-	-94, 143, -52, -97, -101, -76, -41, -42, -42, -41,
-	-42, 41, 41, 41, 46, 41, 46, 41, 46, 41,
-	-49, -127, -185, -55, 49, 124, 50, -184, -129, -94,
-	52, -40, -52, -102, -99, 54, 219, 221, 222, 51,
-	-38, -150, 106, -165, -166, -167, -122, 58, 60, -159,
-	-160, -168, 127, 130, 126, -161, 121, 28, -155, 68,
-	73, -151, 203, -145, 53, -145, -145, -145, -145, -149,
-	178, -149, -149, -149, 53, 53, -145, -145, -145, -153,
-	53, -153, -153, -154, 53, -154, -125, 52, -52, -178,
-	247, -179, 56, -133, 23, -133, -115, 118, 115, 116,
-	-175, 114, 200, 178, 66, 29, 15, 237, 143, 250,
-	56, 144, -52, -52, -133, -110, 11, 91, 9, 91,
-	54, 18, 54, -86, 24, 25, -87, -185, -31, -63,
-	-121, 60, 63, -30, 42, -121, -121, 68, 69, 70,
-	110, -184, -128, -69, -62, -62, -62, -34, 138, 72,
-	253, -185, -185, -35, 54, -38, -185, -185, -185, 54,
-	52, 22, 54, 11, 110, 54, 11, -185, -35, -80,
-	-78, 79, -38, -185, -185, -185, -185, -185, -60, 30,
-	33, -2, -184, -184, -56, 54, 12, 81, -45, -44,
-	51, 52, -46, 51, -44, 41, 41, 41, 121, 121,
-	121, -95, -121, -56, -40, -56, -103, -104, 223, 220,
-	226, 56, 54, -167, 81, 53, 28, -161, -161, 56,
-	56, -146, 29, 68, -152, 204, 60, -149, -149, -150,
-	30, -150, -150, -150, -158, 58, -158, 60, 60, 51,
-	-121, -133, -177, -176, -122, -132, -181, 149, 128, 129,
-	132, 131, 56, 121, 28, 127, 130, 143, 126, -181,
-	149, -116, -117, 123, 22, 121, 28, 143, -133, -112,
-	89, 12, -127, -127, 37, -38, -38, -85, -88, -105,
-	19, 11, 33, 33, -35, 110, 98, -122, -36, 110,
-	-34, 72, -62, -62, -185, -37, -137, 107, 175, 137,
-	173, 169, 168, 167, 159, 160, 161, 162, 163, 164,
-	189, 180, 202, 171, 203, 59, -134, -137, -62, -62,
-	-122, -62, -62, 244, -83, 80, -38, 78, -96, 51,
-	-97, -71, -73, -72, -184, -2, -89, -95, -83, -101,
-	-38, -38, -38, 53, -38, -184, -184, -184, -185, 54,
-	-83, -56, 220, 224, 225, -166, -167, -170, -169, -121,
-	56, 56, -148, 51, 58, 60, 61, 68, 227, 67,
-	55, -150, -150, 56, 107, 55, 54, 55, 54, 55,
-	54, -52, 54, 81, -132, -121, -132, -121, -52, -132,
-	-121, 58, -38, 38, -52, -39, 11, -121, -185, -62,
-	-185, -145, -145, -145, -154, -153, -153, -145, 163, -145,
-	163, -185, -185, -185, 54, 19, -185, 54, 19, -184,
-	-33, 242, -38, 27, -96, 54, -185, -185, -185, -185,
-	-87, -90, -121, -90, -90, -90, -130, -121, -87, 55,
-	54, -145, -156, 200, 9, -149, 58, -149, 60, 60,
-	-133, -176, -167, 53, 26, -56, -40, -149, 56, -62,
-	-62, -62, -62, -62, -185, 58, 28, -73, 33, -2,
-	-184, 54, 55, -185, -185, -185, -55, -172, -171, 52,
-	133, 66, -169, -157, 127, 28, 126, 227, -150, -150,
-	55, 55, -90, -184, -81, 13, -185, -185, -185, -185,
-	-32, 91, 247, 9, -71, -2, -121, -171, 56, -162,
-	81, 58, -147, 66, 28, 28, 55, -173, -174, 143,
-	-82, 14, 16, -185, 245, 48, 248, -97, -185, 60,
-	58, -180, -185, 54, -121, -38, -70, 38, 246, 249,
-	-178, -174, 33, 38, 145, 247, 146, 248, -184, 249,
-	-62, 142, -185, -185,
+	-21, 282, -3, 9, -28, 11, 12, 32, -17, 116,
+	117, 119, 118, 144, 120, 137, 51, 156, 157, 159,
+	160, 27, 138, 139, 142, 143, -4, -5, 8, 10,
+	239, -221, 55, -220, 286, -112, -111, -148, 58, 70,
+	-139, -140, 279, 156, 167, 161, 188, 180, 178, 181,
+	218, 68, 159, 227, 249, 140, 176, 172, 170, 29,
+	193, 284, 171, 253, 135, 134, 194, 198, 219, 165,
+	166, 221, 192, 136, 34, 281, 36, 7, 148, 222,
+	196, 191, 187, 190, 164, 186, 40, 200, 199, 201,
+	217, 183, 173, 20, 225, 143, 250, 254, 146, 195,
+	197, 252, 130, 150, 283, 247, 223, 169, 147, 142,
+	226, 160, 248, 246, 220, 229, 39, 205, 163, 61,
+	133, 157, 154, 184, 149, 174, 175, 189, 162, 185,
+	158, 151, 144, 251, 228, 206, 285, 182, 179, 155,
+	125, 152, 153, 210, 211, 212, 213, 6, 224, 177,
+	207, 276, 258, 256, 269, 273, 265, 268, 266, 264,
+	262, 270, 272, 260, 271, 263, 255, 274, 275, 257,
+	259, 277, 261, 267, -25, -224, -25, -25, -25, -25,
+	-188, 24, -190, 55, 68, 255, -193, -195, -198, 260,
+	261, 256, 248, 259, -137, 125, 74, 152, 231, 122,
+	123, 129, -141, 58, -139, -140, -125, 125, 127, 123,
+	123, 124, 125, 231, 122, 123, -52, -148, 123, 110,
+	181, 116, 208, 124, 34, 150, -158, 123, -127, 153,
+	210, 211, 212, 213, 58, 220, 219, 214, -148, 158,
+	-154, -154, -154, -154, -154, -102, 17, -27, 5, -25,
+	-2, -3, 56, -110, -221, -37, 101, -38, -148, -66,
+	76, -71, 31, 58, 70, -139, -140, 25, -70, -67,
+	-85, -147, -83, -84, 110, 111, 99, 100, 107, 77,
+	112, -75, -73, -74, -76, 60, 59, 69, 62, 63,
+	64, 65, 71, 72, 73, -141, -81, -221, 45, 46,
+	240, 241, 242, 243, 278, 244, 79, 35, 230, 238,
+	237, 236, 234, 235, 232, 233, 128, 231, 105, 239,
+	-26, -125, -40, -41, -42, -43, -54, -84, -221, -52,
+	13, -47, -52, -117, -157, 158, -121, 220, 219, -142,
+	-119, -141, -138, 218, 181, 217, 121, 75, 24, 26,
+	203, 78, 110, 18, 79, 109, 240, 116, 49, 232,
+	233, 230, 242, 243, 231, 208, 31, 12, 27, 138,
+	23, 103, 118, 82, 83, 141, 25, 139, 73, 21,
+	52, 13, 15, 16, 128, 127, 94, 124, 47, 10,
+	112, 28, 91, 43, 30, 45, 92, 19, 234, 235,
+	33, 278, 145, 105, 50, 37, 76, 71, 53, 74,
+	17, 48, 93, 119, 239, 46, 122, 8, 245, 32,
+	137, 44, 123, 209, 81, 126, 72, 5, 129, 11,
+	51, 54, 236, 237, 238, 35, 80, 14, -2, 24,
+	68, 255, -193, -195, -198, 260, 261, -189, -184, -141,
+	60, 18, 60, 55, 18, 264, 24, 124, -52, 239,
+	-141, -133, 128, -133, -133, 123, -52, -52, -132, 128,
+	58, -132, -132, -132, -52, 113, -52, 58, 32, 231,
+	58, 150, 123, 151, 125, -155, -221, -142, -155, -155,
+	-155, 154, 155, -155, -128, 215, 53, -155, -106, 19,
+	18, -6, -4, -221, 8, 22, 23, -31, 41, 42,
+	-222, 57, -111, 24, -108, -141, 13, -144, 75, 74,
+	91, -143, 24, -141, 60, 70, 113, -38, -148, -68,
+	94, 76, 92, 93, 78, 287, 96, 95, 106, 99,
+	100, 101, 102, 103, 104, 105, 97, 98, 109, 84,
+	85, 86, 87, 88, 89, 90, -126, -221, -84, -221,
+	114, 115, 113, -71, -71, -71, -71, -71, -71, -71,
+	-221, -2, -79, -38, -221, -221, -221, -221, -221, -221,
+	-221, -221, -221, -88, -38, -221, -225, -221, -225, -225,
+	-225, -225, -225, -225, -225, -221, -221, -221, -221, 67,
+	-53, 28, 254, -52, 32, 56, -48, -50, -49, -51,
+	43, 47, 49, 44, 45, 46, 215, 50, -55, -56,
+	-57, 7, -152, -148, 24, -40, -221, -151, 146, -150,
+	24, -148, 60, -52, -47, -223, 56, 13, 54, 56,
+	-117, 158, -118, -122, 221, 223, 84, -146, -141, 60,
+	31, 32, -2, 60, 18, 60, 55, 57, 56, -163,
+	-166, -168, -167, -169, 280, -160, -164, -165, 178, 179,
+	110, 182, 184, 185, 186, 187, 61, 188, 189, 190,
+	191, 192, 193, 32, 140, 174, 175, 176, 177, 194,
+	195, 196, 197, 198, 199, 200, 201, 276, 271, 277,
+	161, 162, 163, 164, 165, 166, 167, 169, 170, 171,
+	172, 173, 55, -199, -201, 60, 55, 274, 265, -141,
+	262, 58, -155, 125, -217, 54, 58, 76, 58, -52,
+	-52, -155, 126, -52, 25, 53, -52, 58, 58, -149,
+	-148, -138, -155, -155, -155, -155, -155, -155, -155, -155,
+	-155, -155, -130, 209, 216, -52, -107, 21, 33, -38,
+	-103, -104, -38, -102, -2, -25, 37, -29, 23, -84,
+	-222, 56, 113, -52, -38, -38, -77, 71, 76, 72,
+	73, -143, 101, -149, -142, -138, 113, -71, -78, -81,
+	-84, 66, 94, 92, 93, 78, -71, -71, -71, -71,
+	-71, -71, -71, -71, -71, -71, -71, -71, -71, -71,
+	-71, -71, -156, 58, 60, 58, -70, 70, -70, -142,
+	-141, -36, 23, -35, -37, -222, 56, -222, -2, -35,
+	-35, -38, -38, -85, -141, -148, -85, -35, -29, -86,
+	-87, 80, -85, -222, -35, -36, -35, -35, -113, 146,
+	123, -52, 123, -116, -120, -85, -41, -42, -42, -41,
+	-42, 43, 43, 43, 48, 43, 48, 43, 48, 43,
+	-49, -57, 125, -55, -148, -222, -64, 51, 127, 52,
+	-221, -150, -113, 54, -40, -52, -121, -118, 56, 222,
+	224, 225, 53, -38, -175, 109, 55, -199, -202, -190,
+	-203, 68, -204, 248, 58, -139, -138, 60, 62, -184,
+	-185, -205, 130, 133, 129, -186, 124, 30, -180, 71,
+	76, -176, 206, -170, 55, -170, -170, -170, -170, -174,
+	181, -174, -174, -174, 55, 55, -170, -170, -170, -153,
+	-153, -153, -178, 55, -178, -178, -179, 55, -179, -191,
+	-192, -141, 57, 56, 84, -109, -141, 60, -196, 60,
+	-145, 54, -52, -215, 282, -216, 58, -155, 25, -155,
+	-134, 121, 118, 119, -212, 117, 203, 181, 68, 31,
+	17, 240, 146, 285, 58, 147, -52, -52, -155, -129,
+	13, 94, 11, 94, 56, 20, 56, -105, 26, 27,
+	-106, -222, -31, -72, -141, 62, 65, -30, 44, -141,
+	-141, 71, 72, 73, 113, -221, -149, -78, -71, -71,
+	-71, -34, 141, 75, 288, -222, -222, -35, 56, -38,
+	-222, -222, -222, 56, 54, 24, 56, 13, 113, 56,
+	13, -222, -35, -89, -87, 82, -38, -222, -222, -222,
+	-222, -222, -69, 32, 35, -2, -221, -221, -52, -52,
+	-65, 56, 14, 84, -45, -44, 53, 54, -46, 53,
+	-44, 43, 43, 43, -59, 48, 124, 124, 124, -114,
+	-141, -65, -40, -65, -122, -123, 226, 223, 229, 58,
+	-191, 57, 56, -204, 84, 55, 30, -186, -186, 58,
+	58, -171, 31, 71, -177, 207, 62, -174, -174, -175,
+	32, -175, -175, -175, -183, 60, -183, 85, 85, 85,
+	62, 62, 57, 56, -163, -201, 60, 57, 56, -200,
+	282, 266, 269, 271, 272, 71, 263, 53, -141, -155,
+	-214, -213, -142, -154, -218, 152, 131, 132, 135, 134,
+	58, 124, 30, 130, 133, 146, 129, -218, 152, -135,
+	-136, 126, 24, 124, 30, 146, -155, -131, 92, 14,
+	-148, -148, 39, -38, -38, -104, -107, -124, 21, 13,
+	35, 35, -35, 113, 101, -142, -36, 113, -34, 75,
+	-71, -71, 113, -92, 250, -222, -37, -159, 110, 178,
+	140, 176, 172, 171, 170, 162, 163, 164, 165, 166,
+	167, 192, 183, 205, 174, 206, 61, 179, 175, 280,
+	-160, -156, -159, -71, -71, -142, -149, -71, -71, 279,
+	-102, 83, -38, 81, -115, 53, -116, -80, -82, -81,
+	-221, -2, -108, -114, -61, 146, -61, -102, -120, -38,
+	-38, -38, 55, -38, -141, -221, -221, -221, -222, 56,
+	-102, -65, 223, 227, 228, 57, -203, -204, -207, -206,
+	-141, 58, 58, -173, 53, 60, 62, 63, 71, 230,
+	69, 57, -175, -175, 58, 110, 57, 56, 57, -159,
+	-159, -161, -162, -141, 56, 57, 56, -192, -172, 68,
+	-194, 257, -141, 275, 267, 270, 34, 267, 273, 60,
+	-52, 56, 84, -154, -141, -154, -141, -52, -154, -141,
+	60, -38, 40, -52, -39, 13, -141, 113, -222, -71,
+	-142, -221, -141, -92, -222, -170, -170, -170, -179, -178,
+	-178, -170, 166, -170, 166, -170, -222, -222, -222, 56,
+	21, 113, -222, 56, 21, -221, -33, 245, -38, 29,
+	-115, 56, -222, -222, -222, -222, -69, -221, -60, 128,
+	-106, -109, -221, -109, -109, -109, -151, -141, -106, 57,
+	56, -170, -181, 203, 11, -174, 60, -174, 86, 56,
+	86, 56, 289, 62, 62, 60, 28, 18, 55, 18,
+	267, 267, 18, 24, -155, -213, -204, 55, 28, -65,
+	-40, -142, -93, -97, 58, -174, 58, -71, -71, -142,
+	-71, -71, -71, -222, 60, 30, -82, 35, -2, -221,
+	-62, -63, -141, -2, 76, 57, -36, -222, -222, -222,
+	-64, -209, -208, 54, 136, 68, -206, -182, 130, 30,
+	129, 230, -175, -175, -159, -162, -159, 57, 57, 62,
+	55, -199, 60, 18, 18, 60, 60, -109, -221, -90,
+	15, -222, -91, 146, -222, -222, -222, -222, -32, 94,
+	282, 11, -80, -2, -222, 56, 84, 25, -222, -208,
+	58, -187, 84, 60, -172, 30, 30, 86, 258, -103,
+	57, -197, 268, 60, 60, 57, -210, -211, 146, -101,
+	16, 18, -102, 18, -222, 280, 50, 283, -116, -222,
+	-63, -71, -148, 62, 57, 18, -217, -222, 56, -141,
+	-94, 6, -38, -79, -98, -100, 246, 247, -79, 40,
+	281, 284, -58, 24, 60, -215, -211, 35, -95, -96,
+	-141, -99, 78, 251, 249, -71, 40, -109, 148, 56,
+	24, -99, 252, 253, 248, 252, 253, 282, 149, -96,
+	-221, 75, 283, -221, -93, -99, 284, -71, 145, -222,
+	-222, -222,
 }
 
 var yyDef = [...]int16{
 	0, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
-	21, 0, -2, 284, 284, 284, 284, 284, 0, 598,
-	581, 0, 0, 0, 0, -2, 274, 275, 0, 277,
-	278, 803, 803, 803, 803, 803, 528, 0, 284, 40,
-	41, 0, 801, 1, 3, 0, 33, 36, 607, 608,
-	703, 704, 705, 706, 707, 708, 709, 710, 711, 712,
-	713, 714, 715, 716, 717, 718, 719, 720, 721, 722,
-	723, 724, 725, 726, 727, 728, 729, 730, 731, 732,
-	733, 734, 735, 736, 737, 738, 739, 740, 741, 742,
-	743, 744, 745, 746, 747, 748, 749, 750, 751, 752,
-	753, 754, 755, 756, 757, 758, 759, 760, 761, 762,
-	763, 764, 765, 766, 767, 768, 769, 770, 771, 772,
-	773, 774, 775, 776, 777, 778, 779, 780, 781, 782,
-	783, 784, 785, 786, 787, 788, 789, 790, 791, 792,
-	793, 794, 795, 796, 797, 798, 799, 800, 0, 286,
-	581, 0, 0, 0, 67, 0, 0, 791, 0, 792,
-	579, 579, 579, 599, 600, 603, 604, 0, 0, 582,
-	0, 577, 0, 577, 577, 577, 0, 233, 358, 0,
-	0, 0, 0, 804, 804, 804, 804, 0, 804, 262,
-	251, 253, 254, 255, 256, 804, 271, 272, 261, 273,
-	276, 279, 280, 281, 282, 283, 536, 0, 0, 288,
-	291, 0, -2, 0, 0, 0, 0, 302, 306, 0,
-	366, 0, 371, 373, -2, -2, 0, 408, 409, 410,
-	412, 413, 0, 0, 0, 0, 0, 0, 0, 436,
-	437, 438, 439, 513, 514, 515, 516, 517, 518, 519,
-	520, 375, 376, 510, 560, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 501, 0, 466, 466, 466, 466,
-	466, 466, 466, 466, 0, 0, 0, 0, 285, 0,
-	0, 313, 315, 316, 317, -2, 0, 339, 0, 0,
-	48, 52, 0, 782, 564, -2, -2, 0, 0, 605,
-	606, -2, 710, -2, 611, 612, 613, 614, 615, 616,
-	617, 618, 619, 620, 621, 622, 623, 624, 625, 626,
-	627, 628, 629, 630, 631, 632, 633, 634, 635, 636,
-	637, 638, 639, 640, 641, 642, 643, 644, 645, 646,
-	647, 648, 649, 650, 651, 652, 653, 654, 655, 656,
-	657, 658, 659, 660, 661, 662, 663, 664, 665, 666,
-	667, 668, 669, 670, 671, 672, 673, 674, 675, 676,
-	677, 678, 679, 680, 681, 682, 683, 684, 685, 686,
-	687, 688, 689, 690, 691, 692, 693, 694, 695, 696,
-	697, 698, 699, 700, 701, 702, 0, 84, 0, 0,
-	804, 0, 74, 0, 0, 0, 0, 0, 804, 0,
-	0, 0, 0, 0, 0, 0, 232, 0, 234, 804,
-	804, 804, 804, 804, 804, 804, 804, 243, 805, 806,
-	244, 245, 246, 804, 804, 248, 0, 263, 0, 257,
-	540, 0, 0, 528, 31, 0, 284, 289, 290, 294,
-	292, 293, 30, 802, 34, 0, 0, 549, 0, 303,
-	0, 0, 0, 307, 0, 309, 310, 0, 369, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	393, 394, 395, 396, 397, 398, 399, 372, 0, 386,
-	0, 0, 0, 429, 430, 431, 432, 433, 434, 0,
-	298, 0, 0, 406, 0, 0, 0, 0, 0, 0,
-	0, 0, 294, 0, 502, 0, 458, 0, 459, 460,
-	461, 462, 463, 464, 465, 0, 298, 0, 0, 287,
-	50, 0, 357, 0, 0, 0, 0, 0, 0, 344,
-	0, 0, 347, 0, 0, 0, 0, 0, 338, 0,
-	0, 360, 754, 340, 0, 342, 343, -2, 0, 0,
-	0, 46, 47, 0, 53, 782, 55, 56, 0, 0,
-	0, 164, 572, 573, 574, 570, 192, 0, 147, 143,
-	89, 90, 91, 136, 93, 136, 136, 136, 136, 161,
-	161, 161, 161, 119, 120, 121, 122, 123, 0, 0,
-	106, 136, 136, 136, 110, 126, 127, 128, 129, 130,
-	131, 132, 133, 94, 95, 96, 97, 98, 99, 100,
-	138, 138, 138, 140, 140, 601, 69, 0, 77, 0,
-	804, 0, 804, 82, 0, 208, 0, 227, 578, 0,
-	804, 230, 231, 359, 609, 610, 235, 236, 237, 238,
-	239, 240, 241, 242, 247, 250, 264, 258, 259, 252,
-	24, 0, 0, 537, 529, 530, 533, 536, 0, 291,
-	0, 296, 295, 35, 37, 0, 0, 27, 367, 368,
-	370, 387, 0, 389, 391, 308, 304, 0, 511, -2,
-	0, 377, 378, 402, 403, 404, 0, 0, 0, 0,
-	400, 382, 0, 0, 414, 415, 416, 417, 418, 419,
-	420, 421, 422, 423, 424, 425, 428, 477, 478, 0,
-	426, 427, 435, 0, 0, 299, 300, 405, 0, 559,
-	0, 0, 0, 0, 0, 0, 510, 0, 0, 0,
-	0, 508, 505, 0, 0, 467, 0, 0, 0, 0,
-	0, 0, 356, 364, 561, 0, 314, 333, 335, 0,
-	330, 345, 346, 348, 0, 350, 0, 352, 0, 354,
-	355, 318, 320, 321, 0, 0, 0, 0, 341, 364,
-	0, 364, 49, 565, 54, 0, 0, 59, 60, 566,
-	567, 568, 0, 83, 193, 195, 198, 199, 200, 85,
-	86, 0, 0, 0, 0, 0, 187, 188, 150, 148,
-	0, 145, 144, 92, 0, 161, 161, 113, 114, 164,
-	0, 164, 164, 164, 0, 0, 107, 108, 109, 101,
-	0, 102, 103, 104, 0, 105, 0, 0, 804, 71,
-	0, 75, 76, 72, 580, 73, 803, 0, 0, 593,
-	209, 583, 584, 585, 586, 587, 588, 589, 590, 591,
-	592, 0, 226, 804, 229, 267, 0, 0, 541, 0,
-	0, 0, 0, 532, 534, 535, 540, 32, 294, 0,
-	521, 0, 0, 0, 297, 551, 550, 388, 390, 392,
-	0, 298, 0, 379, 400, 383, 0, 380, 0, 0,
-	411, 374, 440, 0, 0, 407, -2, 443, 444, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 528, 0,
-	506, 0, 0, 457, 468, 469, 470, 471, 553, 0,
-	0, 544, 0, 0, 528, 0, 0, 0, 327, 334,
-	0, 0, 328, 0, 329, 349, 351, 353, 0, 0,
-	0, 0, 325, 528, 364, 45, 57, 58, 0, 0,
-	64, 165, 0, 196, 0, 0, 182, 0, 0, 185,
-	186, 157, 0, 149, 88, 146, 0, 164, 164, 115,
-	0, 116, 117, 118, 0, 134, 0, 0, 0, 0,
-	602, 70, 78, 79, 0, 201, 803, 0, 210, 211,
-	212, 213, 214, 215, 216, 217, 218, 219, 220, 803,
-	0, 0, 803, 594, 595, 596, 597, 0, 228, 249,
-	0, 0, 265, 266, 0, 538, 539, 531, 25, 0,
-	575, 576, 522, 523, 311, 0, 305, 512, 0, 0,
-	381, 0, 401, 384, 441, 301, 0, 136, 136, 482,
-	136, 140, 138, 138, 487, 488, 489, 490, 491, 492,
-	493, 136, 495, 136, 498, 500, 0, 0, 0, 0,
-	511, 0, 0, 0, 503, 456, 509, 0, 38, 0,
-	553, 543, 555, 557, 0, 0, 0, 0, 536, 562,
-	365, 563, 331, 0, 336, 0, 0, 0, 339, 0,
-	536, 44, 61, 62, 63, 194, 197, 0, 189, 136,
-	183, 184, 159, 0, 151, 152, 153, 154, 155, 156,
-	137, 111, 112, 162, 163, 161, 0, 161, 0, 141,
-	0, 804, 0, 0, 202, 0, 203, 205, 206, 207,
-	0, 268, 269, 542, 26, 364, 0, 552, 442, 385,
-	445, 479, 161, 483, 484, 485, 486, 494, 496, 497,
-	499, 447, 446, 448, 0, 0, 451, 0, 0, 0,
-	0, 0, 507, 0, 39, 0, 558, -2, 0, 51,
-	42, 0, 323, 0, 0, 0, 360, 326, 43, 174,
-	0, 191, 166, 160, 0, 164, 135, 164, 0, 0,
-	68, 80, 81, 0, 0, 524, 312, 480, 481, 0,
-	0, 0, 0, 472, 455, 504, 0, 556, 0, 547,
-	0, 0, 332, 361, 362, 363, 322, 173, 175, 0,
-	180, 0, 190, 171, 0, 168, 170, 158, 124, 125,
-	139, 142, 0, 0, 526, 0, 449, 450, 452, 453,
-	0, 0, 0, 0, 546, 0, 324, 176, 177, 0,
-	181, 179, 87, 0, 167, 169, 74, 0, 222, 0,
-	28, 0, 0, 454, 0, 0, 0, 554, -2, 178,
-	172, 77, 221, 0, 0, 527, 525, 473, 0, 476,
-	204, 223, 0, 474, 0, 0, 0, 0, 0, 475,
-	0, 0, 224, 225,
+	21, 0, -2, 339, 339, 339, 339, 339, 0, 702,
+	685, 0, 0, 0, 0, -2, 329, 330, 0, 332,
+	333, 944, 944, 944, 944, 944, 632, 0, 339, 42,
+	43, 0, 942, 1, 3, 0, 33, 36, 712, 713,
+	714, 715, 810, 811, 812, 813, 814, 815, 816, 817,
+	818, 819, 820, 821, 822, 823, 824, 825, 826, 827,
+	828, 829, 830, 831, 832, 833, 834, 835, 836, 837,
+	838, 839, 840, 841, 842, 843, 844, 845, 846, 847,
+	848, 849, 850, 851, 852, 853, 854, 855, 856, 857,
+	858, 859, 860, 861, 862, 863, 864, 865, 866, 867,
+	868, 869, 870, 871, 872, 873, 874, 875, 876, 877,
+	878, 879, 880, 881, 882, 883, 884, 885, 886, 887,
+	888, 889, 890, 891, 892, 893, 894, 895, 896, 897,
+	898, 899, 900, 901, 902, 903, 904, 905, 906, 907,
+	908, 909, 910, 911, 912, 913, 914, 915, 916, 917,
+	918, 919, 920, 921, 922, 923, 924, 925, 926, 927,
+	928, 929, 930, 931, 932, 933, 934, 935, 936, 937,
+	938, 939, 940, 941, 0, 341, 685, 0, 0, 0,
+	77, 0, 0, 0, 0, 0, 99, 100, 101, 0,
+	0, 0, 0, 0, 0, 908, 0, 909, 683, 683,
+	683, 703, 704, 707, 708, 709, 0, 0, 686, 0,
+	681, 0, 681, 681, 681, 0, 288, 423, 0, 0,
+	0, 0, 945, 945, 945, 945, 0, 945, 317, 306,
+	308, 309, 310, 311, 945, 326, 327, 316, 328, 331,
+	334, 335, 336, 337, 338, 640, 0, 0, 343, 346,
+	0, -2, 0, 0, 0, 0, 357, 361, 0, 431,
+	0, 436, 438, -2, -2, -2, -2, 0, 473, 474,
+	475, 477, 478, 479, 0, 0, 0, 0, 0, 0,
+	0, 502, 503, 504, 505, 616, 617, 618, 620, 621,
+	622, 623, 624, 440, 441, 610, 664, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 601, 0, 532, 532,
+	532, 532, 532, 532, 532, 532, 0, 0, 0, 0,
+	340, 0, 0, 369, 371, 372, 379, 375, 0, 404,
+	0, 0, 50, 62, 0, 898, 668, -2, -2, 0,
+	0, 710, 711, -2, 817, -2, 718, 719, 720, 721,
+	722, 723, 724, 725, 726, 727, 728, 729, 730, 731,
+	732, 733, 734, 735, 736, 737, 738, 739, 740, 741,
+	742, 743, 744, 745, 746, 747, 748, 749, 750, 751,
+	752, 753, 754, 755, 756, 757, 758, 759, 760, 761,
+	762, 763, 764, 765, 766, 767, 768, 769, 770, 771,
+	772, 773, 774, 775, 776, 777, 778, 779, 780, 781,
+	782, 783, 784, 785, 786, 787, 788, 789, 790, 791,
+	792, 793, 794, 795, 796, 797, 798, 799, 800, 801,
+	802, 803, 804, 805, 806, 807, 808, 809, 78, 0,
+	0, 0, 106, 107, 108, 0, 0, 0, 134, 0,
+	97, 0, 102, 0, 0, 0, 0, 0, 945, 0,
+	86, 0, 0, 0, 0, 0, 945, 0, 0, 0,
+	0, 0, 0, 0, 287, 0, 289, 945, 945, 945,
+	945, 945, 945, 945, 945, 298, 946, 947, 299, 300,
+	301, 945, 945, 303, 0, 318, 0, 312, 644, 0,
+	0, 632, 31, 0, 339, 344, 345, 349, 347, 348,
+	30, 943, 34, 0, 0, 653, 0, 358, 0, 0,
+	0, 362, 0, 364, 365, 366, 0, 434, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 458,
+	459, 460, 461, 462, 463, 464, 437, 0, 451, 0,
+	0, 0, 0, 495, 496, 497, 498, 499, 500, 0,
+	353, 0, 0, 471, 0, 0, 0, 0, 0, 0,
+	0, 0, 349, 0, 602, 0, 524, 0, 525, 526,
+	527, 528, 529, 530, 531, 0, 353, 0, 0, 342,
+	60, 0, 865, 422, 0, 0, 0, 0, 0, 0,
+	409, 0, 0, 412, 0, 0, 0, 0, 373, 380,
+	381, 0, 379, 402, 0, 0, 0, 425, 866, 405,
+	0, 407, 408, -2, 0, 0, 0, 48, 49, 0,
+	63, 898, 65, 66, 0, 0, 0, 217, 676, 677,
+	678, 674, 79, 104, 0, 109, 0, 245, 0, 200,
+	196, 139, 140, 141, 142, 143, 189, 145, 189, 189,
+	189, 189, 214, 214, 214, 214, 171, 172, 173, 174,
+	175, 176, 0, 0, 158, 189, 189, 189, 162, 179,
+	180, 181, 182, 183, 184, 185, 186, 597, 597, 597,
+	146, 147, 148, 149, 150, 151, 152, 191, 191, 191,
+	193, 193, 0, 0, 131, 0, 0, 0, 121, 129,
+	928, 705, 81, 0, 89, 0, 945, 0, 945, 94,
+	0, 263, 0, 282, 682, 0, 945, 285, 286, 424,
+	716, 717, 290, 291, 292, 293, 294, 295, 296, 297,
+	302, 305, 319, 313, 314, 307, 24, 0, 0, 641,
+	633, 634, 637, 640, 0, 346, 0, 351, 350, 35,
+	37, 0, 0, 27, 432, 433, 435, 452, 0, 454,
+	456, 363, 359, 0, 611, -2, 0, 442, 443, 467,
+	468, 469, 0, 0, 0, 0, 465, 447, 0, 0,
+	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
+	490, 491, 494, 566, 567, 0, 492, 619, 493, 615,
+	501, 0, 0, 354, 355, 470, 0, 663, 0, 0,
+	0, 0, 0, 0, 610, 0, 0, 0, 0, 608,
+	605, 0, 0, 533, 0, 0, 0, 0, 0, 0,
+	0, 421, 0, 429, 665, 0, 370, 398, 400, 0,
+	395, 410, 411, 413, 0, 415, 0, 417, 0, 419,
+	420, 382, 384, 374, 403, 376, 377, 0, 0, 0,
+	0, 406, 429, 0, 429, 51, 669, 64, 0, 0,
+	69, 70, 670, 671, 672, 0, 0, 0, 95, 96,
+	246, 819, 248, 880, 251, 252, 253, 254, 255, 135,
+	136, 0, 870, 888, 0, 0, 240, 241, 203, 201,
+	0, 198, 197, 144, 0, 214, 214, 165, 166, 217,
+	0, 217, 217, 217, 0, 0, 159, 160, 161, 0,
+	0, 0, 153, 0, 154, 155, 156, 0, 157, 0,
+	111, 0, 103, 0, 0, 0, 388, 119, 118, 0,
+	0, 0, 945, 83, 0, 87, 88, 84, 684, 85,
+	944, 0, 0, 697, 264, 687, 688, 689, 690, 691,
+	692, 693, 694, 695, 696, 0, 281, 945, 284, 322,
+	0, 0, 645, 0, 0, 0, 0, 636, 638, 639,
+	644, 32, 349, 0, 625, 0, 0, 0, 352, 655,
+	654, 453, 455, 457, 0, 353, 0, 444, 465, 448,
+	0, 445, 0, 0, 476, 439, 538, 0, 0, 472,
+	-2, 509, 510, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 632, 0, 606, 0, 0, 523, 534, 535,
+	536, 537, 657, 0, 0, 648, 0, 0, 52, 52,
+	632, 0, 0, 0, 392, 399, 0, 0, 393, 0,
+	394, 414, 416, 418, 0, 385, 0, 0, 0, 0,
+	390, 632, 429, 47, 67, 68, 0, 0, 74, 218,
+	0, 110, 0, 249, 0, 0, 235, 0, 0, 238,
+	239, 210, 0, 202, 138, 199, 0, 217, 217, 167,
+	0, 168, 169, 170, 0, 187, 0, 0, 0, 0,
+	0, 0, 98, 0, 224, 132, 133, 115, 0, 117,
+	0, 0, 0, 0, 0, 0, 0, 0, 706, 82,
+	90, 91, 0, 256, 944, 0, 265, 266, 267, 268,
+	269, 270, 271, 272, 273, 274, 275, 944, 0, 0,
+	944, 698, 699, 700, 701, 0, 283, 304, 0, 0,
+	320, 321, 0, 642, 643, 635, 25, 0, 679, 680,
+	626, 627, 367, 0, 360, 612, 0, 0, 446, 0,
+	466, 449, 0, 506, 0, 538, 356, 0, 189, 189,
+	571, 189, 193, 191, 191, 576, 577, 578, 579, 580,
+	581, 582, 189, 584, 189, 587, 589, 189, 591, 592,
+	593, 0, 0, 0, 0, 611, 0, 0, 0, 0,
+	603, 522, 609, 0, 38, 0, 657, 647, 659, 661,
+	0, 0, 0, 0, 0, 0, 58, 640, 666, 430,
+	667, 396, 0, 401, 0, 0, 0, 0, 404, 0,
+	640, 46, 71, 72, 73, 105, 247, 250, 0, 242,
+	189, 236, 237, 212, 0, 204, 205, 206, 207, 208,
+	209, 190, 163, 164, 215, 216, 214, 0, 214, 0,
+	0, 0, 598, 0, 0, 194, 0, 112, 113, 0,
+	0, 0, 389, 0, 0, 0, 0, 0, 0, 130,
+	945, 0, 0, 257, 0, 258, 260, 261, 262, 0,
+	323, 324, 646, 26, 429, 0, 656, 0, 508, 450,
+	614, 542, 540, 507, 511, 568, 214, 572, 573, 574,
+	575, 583, 585, 586, 588, 590, 513, 512, 514, 0,
+	0, 0, 517, 0, 0, 0, 0, 0, 607, 0,
+	39, 0, 662, -2, 0, 61, 40, 0, 0, 0,
+	44, 0, 353, 0, 0, 0, 425, 391, 45, 227,
+	0, 244, 219, 213, 0, 217, 188, 217, 594, 0,
+	596, 0, 0, 0, 0, 225, 0, 0, 0, 0,
+	0, 0, 0, 0, 80, 92, 93, 0, 0, 628,
+	368, 613, 0, 549, 543, 569, 570, 0, 0, 612,
+	0, 0, 561, 521, 604, 0, 660, 0, 651, 0,
+	0, 54, 56, 41, 0, 397, 0, 426, 427, 428,
+	378, 226, 228, 0, 233, 0, 243, 224, 0, 221,
+	223, 211, 177, 178, 0, 599, 600, 192, 195, 0,
+	0, 0, 127, 0, 0, 125, 126, 0, 0, 630,
+	0, 539, 632, 0, 515, 516, 518, 519, 0, 0,
+	0, 0, 650, 0, 53, 0, 0, 59, 0, 229,
+	230, 0, 234, 232, 137, 220, 222, 595, 114, 0,
+	120, 122, 0, 123, 124, 86, 0, 277, 0, 544,
+	0, 0, 551, 0, 520, 0, 0, 0, 658, -2,
+	55, 57, 386, 231, 116, 0, 89, 276, 0, 0,
+	28, 0, 631, 629, 541, 0, 554, 555, 550, 562,
+	0, 565, 383, 0, 128, 259, 278, 0, 545, 546,
+	0, 552, 0, 901, 822, 0, 563, 387, 0, 0,
+	0, 0, 556, 557, 558, 559, 560, 0, 0, 547,
+	542, 0, 0, 0, 0, 553, 564, 0, 0, 548,
+	279, 280,
 }
 
-var yyTok1 = [...]uint8{
+var yyTok1 = [...]int16{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 74, 3, 3, 3, 101, 93, 3,
-	53, 55, 98, 96, 54, 97, 110, 99, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 251,
-	82, 81, 83, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 77, 3, 3, 3, 104, 96, 3,
+	55, 57, 101, 99, 56, 100, 113, 102, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 289, 286,
+	85, 84, 86, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 252, 3, 253, 103, 3, 3, 3, 3, 3,
+	3, 287, 3, 288, 106, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 92, 3, 104,
+	3, 3, 3, 3, 95, 3, 107,
 }
 
-var yyTok2 = [...]uint8{
+var yyTok2 = [...]int16{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
-	52, 56, 57, 58, 59, 60, 61, 62, 63, 64,
-	65, 66, 67, 68, 69, 70, 71, 72, 73, 75,
-	76, 77, 78, 79, 80, 84, 85, 86, 87, 88,
-	89, 90, 91, 94, 95, 100, 102, 105, 106, 107,
-	108, 109, 111, 112, 113, 114, 115, 116, 117, 118,
+	52, 53, 54, 58, 59, 60, 61, 62, 63, 64,
+	65, 66, 67, 68, 69, 70, 71, 72, 73, 74,
+	75, 76, 78, 79, 80, 81, 82, 83, 87, 88,
+	89, 90, 91, 92, 93, 94, 97, 98, 103, 105,
+	108, 109, 110, 111, 112, 114, 115, 116, 117, 118,
 	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
 	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
 	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
@@ -2406,11 +3184,15 @@ var yyTok2 = [...]uint8{
 	219, 220, 221, 222, 223, 224, 225, 226, 227, 228,
 	229, 230, 231, 232, 233, 234, 235, 236, 237, 238,
 	239, 240, 241, 242, 243, 244, 245, 246, 247, 248,
-	249, 250,
+	249, 250, 251, 252, 253, 254, 255, 256, 257, 258,
+	259, 260, 261, 262, 263, 264, 265, 266, 267, 268,
+	269, 270, 271, 272, 273, 274,
 }
 
-var yyTok3 = [...]int8{
-	0,
+var yyTok3 = [...]uint16{
+	57600, 275, 57601, 276, 57602, 277, 57603, 278, 57604, 279,
+	57605, 280, 57606, 281, 57607, 282, 57608, 283, 57609, 284,
+	57610, 285, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -2752,41 +3534,41 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:306
+//line sql.y:377
 		{
 			setParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:311
+//line sql.y:382
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:312
+//line sql.y:383
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:316
+//line sql.y:387
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
 	case 22:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:339
+//line sql.y:410
 		{
 			yyVAL.selStmt = &With{CTEs: yyDollar[2].commonTableExprs, Stmt: yyDollar[3].selStmt}
 		}
 	case 23:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:343
+//line sql.y:414
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
 	case 24:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:349
+//line sql.y:420
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -2796,85 +3578,85 @@ yydefault:
 		}
 	case 25:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:357
+//line sql.y:428
 		{
 			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].str}
 		}
 	case 26:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:361
+//line sql.y:432
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, SelectExprs: SelectExprs{Nextval{Expr: yyDollar[5].expr}}, From: TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}}
 		}
 	case 27:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:367
+//line sql.y:438
 		{
 			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
 		}
 	case 28:
-		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:374
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:445
 		{
-			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SelectExprs: yyDollar[6].selectExprs, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr)}
+			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SelectExprs: yyDollar[6].selectExprs, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr), Windows: yyDollar[11].namedWindows}
 		}
 	case 29:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:380
+//line sql.y:451
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
 	case 30:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:384
+//line sql.y:455
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
 	case 31:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:390
+//line sql.y:461
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
 	case 32:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:394
+//line sql.y:465
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
 	case 33:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:400
+//line sql.y:471
 		{
 			yyVAL.commonTableExprs = CommonTableExprs{yyDollar[1].commonTableExpr}
 		}
 	case 34:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:404
+//line sql.y:475
 		{
 			yyVAL.commonTableExprs = append(yyDollar[1].commonTableExprs, yyDollar[3].commonTableExpr)
 		}
 	case 35:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:410
+//line sql.y:481
 		{
 			yyVAL.commonTableExpr = &CommonTableExpr{Name: yyDollar[1].tableIdent, Columns: yyDollar[2].columns, Subquery: yyDollar[4].subquery}
 		}
 	case 36:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:415
+//line sql.y:486
 		{
 			yyVAL.columns = nil
 		}
 	case 37:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:419
+//line sql.y:490
 		{
 			yyVAL.columns = yyDollar[2].columns
 		}
 	case 38:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:426
+//line sql.y:497
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].ins
@@ -2888,7 +3670,7 @@ yydefault:
 		}
 	case 39:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:438
+//line sql.y:509
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
@@ -2899,194 +3681,281 @@ yydefault:
 			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
 		}
 	case 40:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:519
+		{
+			ins := yyDollar[8].ins
+			ins.Action = yyDollar[1].str
+			ins.Comments = yyDollar[2].bytes2
+			ins.Ignore = yyDollar[3].str
+			ins.Table = yyDollar[6].tableName
+			ins.PartitionValues = yyDollar[7].partVals
+			yyVAL.statement = ins
+		}
+	case 41:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:529
+		{
+			// ignore_opt lets INSERT OVERWRITE also start an insert into a table
+			// named overwrite, until TABLE tells them apart.
+			if yyDollar[1].str != InsertStr || yyDollar[3].str != "" {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Overwrite: true, Table: yyDollar[6].tableName, PartitionValues: yyDollar[7].partVals, IfNotExists: bool(yyDollar[8].boolVal), Rows: yyDollar[9].selStmt}
+		}
+	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:450
+//line sql.y:541
 		{
 			yyVAL.str = InsertStr
 		}
-	case 41:
+	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:454
+//line sql.y:545
 		{
 			yyVAL.str = ReplaceStr
 		}
-	case 42:
+	case 44:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:460
+//line sql.y:551
 		{
 			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), TableExprs: yyDollar[3].tableExprs, Exprs: yyDollar[5].updateExprs, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
 		}
-	case 43:
+	case 45:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:466
+//line sql.y:557
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
 		}
-	case 44:
+	case 46:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:470
+//line sql.y:561
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr)}
 		}
-	case 45:
+	case 47:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:474
+//line sql.y:565
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
-	case 46:
+	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:479
+//line sql.y:570
 		{
 		}
-	case 47:
+	case 49:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:480
+//line sql.y:571
 		{
 		}
-	case 48:
+	case 50:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:484
+//line sql.y:575
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 49:
+	case 51:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:488
+//line sql.y:579
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 50:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:493
-		{
-			yyVAL.partitions = nil
-		}
-	case 51:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:497
-		{
-			yyVAL.partitions = yyDollar[3].partitions
-		}
 	case 52:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:503
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:584
 		{
-			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
+			yyVAL.partVals = nil
 		}
 	case 53:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:507
+//line sql.y:588
 		{
-			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[4].setExprs}
+			yyVAL.partVals = yyDollar[3].partVals
 		}
 	case 54:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:511
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:594
 		{
-			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[5].setExprs}
+			yyVAL.partVals = PartitionValues{yyDollar[1].partVal}
 		}
 	case 55:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:515
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:598
 		{
-			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[4].setExprs}
+			yyVAL.partVals = append(yyDollar[1].partVals, yyDollar[3].partVal)
 		}
 	case 56:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:521
+//line sql.y:604
 		{
-			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
+			yyVAL.partVal = &PartitionValue{Name: yyDollar[1].colIdent}
 		}
 	case 57:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:525
+//line sql.y:608
 		{
-			yyVAL.setExprs = append(yyVAL.setExprs, yyDollar[3].setExpr)
+			yyVAL.partVal = &PartitionValue{Name: yyDollar[1].colIdent, Value: yyDollar[3].expr}
 		}
 	case 58:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:531
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:613
 		{
-			yyVAL.setExpr = yyDollar[3].setExpr
+			yyVAL.boolVal = BoolVal(false)
 		}
 	case 59:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:535
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:617
 		{
-			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_read_only"), Expr: NewIntVal([]byte("0"))}
+			yyVAL.boolVal = BoolVal(true)
 		}
 	case 60:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:539
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:622
 		{
-			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_read_only"), Expr: NewIntVal([]byte("1"))}
+			yyVAL.partitions = nil
 		}
 	case 61:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:545
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:626
 		{
-			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("repeatable read"))}
+			yyVAL.partitions = yyDollar[3].partitions
 		}
 	case 62:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:549
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:632
 		{
-			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("read committed"))}
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
 		}
 	case 63:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:553
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:636
 		{
-			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("read uncommitted"))}
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[4].setExprs}
 		}
 	case 64:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:557
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:640
 		{
-			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("serializable"))}
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[5].setExprs}
 		}
 	case 65:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:563
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:644
 		{
-			yyVAL.str = SessionStr
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[4].setExprs}
 		}
 	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:567
+//line sql.y:650
 		{
-			yyVAL.str = GlobalStr
+			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
 	case 67:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:654
+		{
+			yyVAL.setExprs = append(yyVAL.setExprs, yyDollar[3].setExpr)
+		}
+	case 68:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:660
+		{
+			yyVAL.setExpr = yyDollar[3].setExpr
+		}
+	case 69:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:664
+		{
+			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_read_only"), Expr: NewIntVal([]byte("0"))}
+		}
+	case 70:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:668
+		{
+			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_read_only"), Expr: NewIntVal([]byte("1"))}
+		}
+	case 71:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:674
+		{
+			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("repeatable read"))}
+		}
+	case 72:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:678
+		{
+			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("read committed"))}
+		}
+	case 73:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:573
+//line sql.y:682
+		{
+			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("read uncommitted"))}
+		}
+	case 74:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:686
+		{
+			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("serializable"))}
+		}
+	case 75:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:692
+		{
+			yyVAL.str = SessionStr
+		}
+	case 76:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:696
+		{
+			yyVAL.str = GlobalStr
+		}
+	case 77:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:702
 		{
 			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
 			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 68:
+	case 78:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:707
+		{
+			yyDollar[1].ddl.Select = yyDollar[3].selStmt
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 79:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:712
+		{
+			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
+			yyDollar[1].ddl.Select = yyDollar[4].selStmt
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 80:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:578
+//line sql.y:718
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[7].tableName, NewName: yyDollar[7].tableName}
 		}
-	case 69:
+	case 81:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:583
+//line sql.y:723
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, NewName: yyDollar[3].tableName.ToViewName()}
 		}
-	case 70:
+	case 82:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:587
+//line sql.y:727
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, NewName: yyDollar[5].tableName.ToViewName()}
 		}
-	case 71:
+	case 83:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:591
+//line sql.y:731
 		{
 			yyVAL.statement = &DDL{Action: CreateVindexStr, VindexSpec: &VindexSpec{
 				Name:   yyDollar[3].colIdent,
@@ -3094,104 +3963,336 @@ yydefault:
 				Params: yyDollar[5].vindexParams,
 			}}
 		}
-	case 72:
+	case 84:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:599
+//line sql.y:739
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 73:
+	case 85:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:603
+//line sql.y:743
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 74:
+	case 86:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:608
+//line sql.y:748
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
-	case 75:
+	case 87:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:612
+//line sql.y:752
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 76:
+	case 88:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:618
+//line sql.y:758
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 77:
+	case 89:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:623
+//line sql.y:763
 		{
 			var v []VindexParam
 			yyVAL.vindexParams = v
 		}
-	case 78:
+	case 90:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:628
+//line sql.y:768
 		{
 			yyVAL.vindexParams = yyDollar[2].vindexParams
 		}
-	case 79:
+	case 91:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:634
+//line sql.y:774
 		{
 			yyVAL.vindexParams = make([]VindexParam, 0, 4)
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
 		}
-	case 80:
+	case 92:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:639
+//line sql.y:779
 		{
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[3].vindexParam)
 		}
-	case 81:
+	case 93:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:645
+//line sql.y:785
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
-	case 82:
+	case 94:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:651
+//line sql.y:791
 		{
 			yyVAL.ddl = &DDL{Action: CreateStr, NewName: yyDollar[4].tableName}
 			setDDL(yylex, yyVAL.ddl)
 		}
-	case 83:
+	case 95:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:658
+//line sql.y:798
 		{
 			yyVAL.TableSpec = yyDollar[2].TableSpec
 			yyVAL.TableSpec.Options = yyDollar[4].str
 		}
-	case 84:
+	case 96:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:803
+		{
+			yyVAL.TableSpec = yyDollar[4].TableSpec
+			yyVAL.TableSpec.Columns = yyDollar[2].TableSpec.Columns
+			yyVAL.TableSpec.Indexes = yyDollar[2].TableSpec.Indexes
+		}
+	case 97:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:814
+		{
+			yyVAL.TableSpec = &TableSpec{Comment: NewStrVal(yyDollar[2].bytes)}
+		}
+	case 98:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:818
+		{
+			yyVAL.TableSpec = &TableSpec{PartitionedBy: yyDollar[4].columnDefinitions}
+		}
+	case 99:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:822
+		{
+			yyVAL.TableSpec = &TableSpec{ClusteredBy: yyDollar[1].clusteredBy}
+		}
+	case 100:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:826
+		{
+			yyVAL.TableSpec = &TableSpec{RowFormat: yyDollar[1].rowFormat}
+		}
+	case 101:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:665
+//line sql.y:830
+		{
+			yyVAL.TableSpec = &TableSpec{StoredAs: yyDollar[1].storedAs}
+		}
+	case 102:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:834
+		{
+			yyVAL.TableSpec = &TableSpec{Location: NewStrVal(yyDollar[2].bytes)}
+		}
+	case 103:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:838
+		{
+			yyVAL.TableSpec = &TableSpec{TblProperties: yyDollar[3].tableProps}
+		}
+	case 104:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:842
+		{
+			yyVAL.TableSpec.Comment = NewStrVal(yyDollar[3].bytes)
+		}
+	case 105:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:846
+		{
+			yyVAL.TableSpec.PartitionedBy = yyDollar[5].columnDefinitions
+		}
+	case 106:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:850
+		{
+			yyVAL.TableSpec.ClusteredBy = yyDollar[2].clusteredBy
+		}
+	case 107:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:854
+		{
+			yyVAL.TableSpec.RowFormat = yyDollar[2].rowFormat
+		}
+	case 108:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:858
+		{
+			yyVAL.TableSpec.StoredAs = yyDollar[2].storedAs
+		}
+	case 109:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:862
+		{
+			yyVAL.TableSpec.Location = NewStrVal(yyDollar[3].bytes)
+		}
+	case 110:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:866
+		{
+			yyVAL.TableSpec.TblProperties = yyDollar[4].tableProps
+		}
+	case 111:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:872
+		{
+			yyVAL.columnDefinitions = []*ColumnDefinition{yyDollar[1].columnDefinition}
+		}
+	case 112:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:876
+		{
+			yyVAL.columnDefinitions = append(yyDollar[1].columnDefinitions, yyDollar[3].columnDefinition)
+		}
+	case 113:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:882
+		{
+			yyDollar[2].columnType.Comment = yyDollar[3].optVal
+			yyVAL.columnDefinition = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
+		}
+	case 114:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:889
+		{
+			yyVAL.clusteredBy = &ClusteredBy{Columns: yyDollar[4].columns, SortedBy: yyDollar[6].orderBy, Buckets: NewIntVal(yyDollar[8].bytes)}
+		}
+	case 115:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:894
+		{
+			yyVAL.orderBy = nil
+		}
+	case 116:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:898
+		{
+			yyVAL.orderBy = yyDollar[4].orderBy
+		}
+	case 117:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:904
+		{
+			yyVAL.rowFormat = &RowFormat{Serde: NewStrVal(yyDollar[4].bytes), SerdeProperties: yyDollar[5].tableProps}
+		}
+	case 118:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:908
+		{
+			yyVAL.rowFormat = yyDollar[4].rowFormat
+		}
+	case 119:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:913
+		{
+			yyVAL.tableProps = nil
+		}
+	case 120:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:917
+		{
+			yyVAL.tableProps = yyDollar[4].tableProps
+		}
+	case 121:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:922
+		{
+			yyVAL.rowFormat = &RowFormat{}
+		}
+	case 122:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:926
+		{
+			yyVAL.rowFormat.FieldsTerminatedBy = NewStrVal(yyDollar[5].bytes)
+			yyVAL.rowFormat.EscapedBy = yyDollar[6].optVal
+		}
+	case 123:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:931
+		{
+			yyVAL.rowFormat.CollectionItemsTerminatedBy = NewStrVal(yyDollar[6].bytes)
+		}
+	case 124:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:935
+		{
+			yyVAL.rowFormat.MapKeysTerminatedBy = NewStrVal(yyDollar[6].bytes)
+		}
+	case 125:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:939
+		{
+			yyVAL.rowFormat.LinesTerminatedBy = NewStrVal(yyDollar[5].bytes)
+		}
+	case 126:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:943
+		{
+			yyVAL.rowFormat.NullDefinedAs = NewStrVal(yyDollar[5].bytes)
+		}
+	case 127:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:948
+		{
+			yyVAL.optVal = nil
+		}
+	case 128:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:952
+		{
+			yyVAL.optVal = NewStrVal(yyDollar[3].bytes)
+		}
+	case 129:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:958
+		{
+			yyVAL.storedAs = &StoredAs{FileFormat: yyDollar[3].colIdent}
+		}
+	case 130:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:962
+		{
+			yyVAL.storedAs = &StoredAs{InputFormat: NewStrVal(yyDollar[4].bytes), OutputFormat: NewStrVal(yyDollar[6].bytes)}
+		}
+	case 131:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:968
+		{
+			yyVAL.tableProps = TableProperties{yyDollar[1].tableProp}
+		}
+	case 132:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:972
+		{
+			yyVAL.tableProps = append(yyDollar[1].tableProps, yyDollar[3].tableProp)
+		}
+	case 133:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:978
+		{
+			yyVAL.tableProp = &TableProperty{Key: NewStrVal(yyDollar[1].bytes), Value: NewStrVal(yyDollar[3].bytes)}
+		}
+	case 134:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:984
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
 		}
-	case 85:
+	case 135:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:670
+//line sql.y:989
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 		}
-	case 86:
+	case 136:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:674
+//line sql.y:993
 		{
 			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
 		}
-	case 87:
+	case 137:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:680
+//line sql.y:999
 		{
 			yyDollar[2].columnType.NotNull = yyDollar[3].boolVal
 			yyDollar[2].columnType.Default = yyDollar[4].optVal
@@ -3199,712 +4300,742 @@ yydefault:
 			yyDollar[2].columnType.Autoincrement = yyDollar[6].boolVal
 			yyDollar[2].columnType.KeyOpt = yyDollar[7].colKeyOpt
 			yyDollar[2].columnType.Comment = yyDollar[8].optVal
-			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
+			yyVAL.columnDefinition = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
 		}
-	case 88:
+	case 138:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:691
+//line sql.y:1010
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
 			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
 		}
-	case 92:
+	case 142:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1019
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 143:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1023
+		{
+			yyVAL.columnType = ColumnType{Type: yyDollar[1].convertType.Type, Complex: yyDollar[1].convertType.Complex}
+		}
+	case 144:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:702
+//line sql.y:1029
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].optVal
 		}
-	case 93:
+	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:707
+//line sql.y:1034
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 94:
+	case 146:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:713
+//line sql.y:1040
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 95:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:717
+//line sql.y:1044
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 96:
+	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:721
+//line sql.y:1048
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 97:
+	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:725
+//line sql.y:1052
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 98:
+	case 150:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:729
+//line sql.y:1056
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 99:
+	case 151:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:733
+//line sql.y:1060
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 100:
+	case 152:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:737
+//line sql.y:1064
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 101:
+	case 153:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:743
+//line sql.y:1070
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 102:
+	case 154:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:749
+//line sql.y:1076
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 103:
+	case 155:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:755
+//line sql.y:1082
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 104:
+	case 156:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:761
+//line sql.y:1088
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 105:
+	case 157:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:767
+//line sql.y:1094
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 106:
+	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:775
+//line sql.y:1102
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 107:
+	case 159:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:779
+//line sql.y:1106
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 108:
+	case 160:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:783
+//line sql.y:1110
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 109:
+	case 161:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:787
+//line sql.y:1114
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 110:
+	case 162:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:791
+//line sql.y:1118
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 111:
+	case 163:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:797
+//line sql.y:1124
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 112:
+	case 164:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:801
+//line sql.y:1128
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 113:
+	case 165:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:805
+//line sql.y:1132
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 114:
+	case 166:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:809
+//line sql.y:1136
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 115:
+	case 167:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:813
+//line sql.y:1140
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 116:
+	case 168:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:817
+//line sql.y:1144
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 117:
+	case 169:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:821
+//line sql.y:1148
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 118:
+	case 170:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:825
+//line sql.y:1152
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 119:
+	case 171:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:829
+//line sql.y:1156
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 120:
+	case 172:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:833
+//line sql.y:1160
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 121:
+	case 173:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:837
+//line sql.y:1164
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 122:
+	case 174:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:841
+//line sql.y:1168
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 123:
+	case 175:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:845
+//line sql.y:1172
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 124:
+	case 176:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1176
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 177:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:849
+//line sql.y:1180
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 125:
+	case 178:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:854
+//line sql.y:1185
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 126:
+	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:860
+//line sql.y:1191
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 127:
+	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:864
+//line sql.y:1195
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 128:
+	case 181:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:868
+//line sql.y:1199
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 129:
+	case 182:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:872
+//line sql.y:1203
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 130:
+	case 183:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:876
+//line sql.y:1207
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 131:
+	case 184:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:880
+//line sql.y:1211
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 132:
+	case 185:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:884
+//line sql.y:1215
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 133:
+	case 186:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:888
+//line sql.y:1219
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 134:
+	case 187:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:894
+//line sql.y:1225
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
 		}
-	case 135:
+	case 188:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:899
+//line sql.y:1230
 		{
 			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
 		}
-	case 136:
+	case 189:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:904
+//line sql.y:1235
 		{
 			yyVAL.optVal = nil
 		}
-	case 137:
+	case 190:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:908
+//line sql.y:1239
 		{
 			yyVAL.optVal = NewIntVal(yyDollar[2].bytes)
 		}
-	case 138:
+	case 191:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:913
+//line sql.y:1244
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 139:
+	case 192:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:917
+//line sql.y:1248
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 140:
+	case 193:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:925
+//line sql.y:1256
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 141:
+	case 194:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:929
+//line sql.y:1260
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 			}
 		}
-	case 142:
+	case 195:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:935
+//line sql.y:1266
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 143:
+	case 196:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:943
+//line sql.y:1274
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 144:
+	case 197:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:947
+//line sql.y:1278
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 145:
+	case 198:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:952
+//line sql.y:1283
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 146:
+	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:956
+//line sql.y:1287
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 147:
+	case 200:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:962
+//line sql.y:1293
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 148:
+	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:966
+//line sql.y:1297
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 149:
+	case 202:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:970
+//line sql.y:1301
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 150:
+	case 203:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:975
+//line sql.y:1306
 		{
 			yyVAL.optVal = nil
 		}
-	case 151:
+	case 204:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:979
+//line sql.y:1310
 		{
 			yyVAL.optVal = NewStrVal(yyDollar[2].bytes)
 		}
-	case 152:
+	case 205:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:983
+//line sql.y:1314
 		{
 			yyVAL.optVal = NewIntVal(yyDollar[2].bytes)
 		}
-	case 153:
+	case 206:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:987
+//line sql.y:1318
 		{
 			yyVAL.optVal = NewFloatVal(yyDollar[2].bytes)
 		}
-	case 154:
+	case 207:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:991
+//line sql.y:1322
 		{
 			yyVAL.optVal = NewValArg(yyDollar[2].bytes)
 		}
-	case 155:
+	case 208:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:995
+//line sql.y:1326
 		{
 			yyVAL.optVal = NewValArg(yyDollar[2].bytes)
 		}
-	case 156:
+	case 209:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:999
+//line sql.y:1330
 		{
 			yyVAL.optVal = NewBitVal(yyDollar[2].bytes)
 		}
-	case 157:
+	case 210:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1004
+//line sql.y:1335
 		{
 			yyVAL.optVal = nil
 		}
-	case 158:
+	case 211:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1008
+//line sql.y:1339
 		{
 			yyVAL.optVal = NewValArg(yyDollar[3].bytes)
 		}
-	case 159:
+	case 212:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1013
+//line sql.y:1344
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 160:
+	case 213:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1017
+//line sql.y:1348
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 161:
+	case 214:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1022
+//line sql.y:1353
 		{
 			yyVAL.str = ""
 		}
-	case 162:
+	case 215:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1026
+//line sql.y:1357
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 163:
+	case 216:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1030
+//line sql.y:1361
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 164:
+	case 217:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1035
+//line sql.y:1366
 		{
 			yyVAL.str = ""
 		}
-	case 165:
+	case 218:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1039
+//line sql.y:1370
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 166:
+	case 219:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1044
+//line sql.y:1375
 		{
 			yyVAL.colKeyOpt = colKeyNone
 		}
-	case 167:
+	case 220:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1048
+//line sql.y:1379
 		{
 			yyVAL.colKeyOpt = colKeyPrimary
 		}
-	case 168:
+	case 221:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1052
+//line sql.y:1383
 		{
 			yyVAL.colKeyOpt = colKey
 		}
-	case 169:
+	case 222:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1056
+//line sql.y:1387
 		{
 			yyVAL.colKeyOpt = colKeyUniqueKey
 		}
-	case 170:
+	case 223:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1060
+//line sql.y:1391
 		{
 			yyVAL.colKeyOpt = colKeyUnique
 		}
-	case 171:
+	case 224:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1065
+//line sql.y:1396
 		{
 			yyVAL.optVal = nil
 		}
-	case 172:
+	case 225:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1069
+//line sql.y:1400
 		{
 			yyVAL.optVal = NewStrVal(yyDollar[2].bytes)
 		}
-	case 173:
+	case 226:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1075
+//line sql.y:1406
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
 		}
-	case 174:
+	case 227:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1079
+//line sql.y:1410
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns}
 		}
-	case 175:
+	case 228:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1085
+//line sql.y:1416
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 176:
+	case 229:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1089
+//line sql.y:1420
 		{
 			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
 		}
-	case 177:
+	case 230:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1095
+//line sql.y:1426
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].bytes)}
 		}
-	case 178:
+	case 231:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1099
+//line sql.y:1430
 		{
 			// should not be string
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
 		}
-	case 179:
+	case 232:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1104
+//line sql.y:1435
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
 		}
-	case 180:
+	case 233:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1110
+//line sql.y:1441
 		{
 			yyVAL.str = ""
 		}
-	case 181:
+	case 234:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1114
+//line sql.y:1445
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 182:
+	case 235:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1120
+//line sql.y:1451
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
-	case 183:
+	case 236:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1124
+//line sql.y:1455
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Spatial: true, Unique: false}
 		}
-	case 184:
+	case 237:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1128
+//line sql.y:1459
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Unique: true}
 		}
-	case 185:
+	case 238:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1132
+//line sql.y:1463
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(string(yyDollar[2].bytes)), Unique: true}
 		}
-	case 186:
+	case 239:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1136
+//line sql.y:1467
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(string(yyDollar[2].bytes)), Unique: false}
 		}
-	case 187:
+	case 240:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1142
+//line sql.y:1473
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 188:
+	case 241:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1146
+//line sql.y:1477
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 189:
+	case 242:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1152
+//line sql.y:1483
 		{
 			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
 		}
-	case 190:
+	case 243:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1156
+//line sql.y:1487
 		{
 			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
 		}
-	case 191:
+	case 244:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1162
+//line sql.y:1493
 		{
 			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].optVal}
 		}
-	case 192:
+	case 245:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1167
+//line sql.y:1498
 		{
 			yyVAL.str = ""
 		}
-	case 193:
+	case 246:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1171
+//line sql.y:1502
 		{
 			yyVAL.str = " " + string(yyDollar[1].str)
 		}
-	case 194:
+	case 247:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1175
+//line sql.y:1506
 		{
 			yyVAL.str = string(yyDollar[1].str) + ", " + string(yyDollar[3].str)
 		}
-	case 195:
+	case 248:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1183
+//line sql.y:1514
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 196:
+	case 249:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1187
+//line sql.y:1518
 		{
 			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 197:
+	case 250:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1191
+//line sql.y:1522
 		{
 			yyVAL.str = yyDollar[1].str + "=" + yyDollar[3].str
 		}
-	case 198:
+	case 251:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1530
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 252:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1197
+//line sql.y:1534
 		{
-			yyVAL.str = yyDollar[1].colIdent.String()
+			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 199:
+	case 253:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1201
+//line sql.y:1538
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 254:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1542
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
-	case 200:
+	case 255:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1205
+//line sql.y:1546
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 201:
+	case 256:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1211
+//line sql.y:1552
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
 		}
-	case 202:
+	case 257:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1215
+//line sql.y:1556
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
 		}
-	case 203:
+	case 258:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1219
+//line sql.y:1560
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
 		}
-	case 204:
+	case 259:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1223
+//line sql.y:1564
 		{
 			yyVAL.statement = &DDL{
 				Action: AddColVindexStr,
@@ -3917,9 +5048,9 @@ yydefault:
 				VindexCols: yyDollar[9].columns,
 			}
 		}
-	case 205:
+	case 260:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1236
+//line sql.y:1577
 		{
 			yyVAL.statement = &DDL{
 				Action: DropColVindexStr,
@@ -3929,71 +5060,71 @@ yydefault:
 				},
 			}
 		}
-	case 206:
+	case 261:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1246
+//line sql.y:1587
 		{
 			// Change this to a rename statement
 			yyVAL.statement = &DDL{Action: RenameStr, Table: yyDollar[4].tableName, NewName: yyDollar[7].tableName}
 		}
-	case 207:
+	case 262:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1251
+//line sql.y:1592
 		{
 			// Rename an index can just be an alter
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
 		}
-	case 208:
+	case 263:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1256
+//line sql.y:1597
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName(), NewName: yyDollar[3].tableName.ToViewName()}
 		}
-	case 209:
+	case 264:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1260
+//line sql.y:1601
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, PartitionSpec: yyDollar[5].partSpec}
 		}
-	case 221:
+	case 276:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1279
+//line sql.y:1620
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
 		}
-	case 222:
+	case 277:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1285
+//line sql.y:1626
 		{
 			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
 		}
-	case 223:
+	case 278:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1289
+//line sql.y:1630
 		{
 			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
 		}
-	case 224:
+	case 279:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1295
+//line sql.y:1636
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
 		}
-	case 225:
+	case 280:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1299
+//line sql.y:1640
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
-	case 226:
+	case 281:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1305
+//line sql.y:1646
 		{
 			yyVAL.statement = &DDL{Action: RenameStr, Table: yyDollar[3].tableName, NewName: yyDollar[5].tableName}
 		}
-	case 227:
+	case 282:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1311
+//line sql.y:1652
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -4001,16 +5132,16 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, Table: yyDollar[4].tableName, IfExists: exists}
 		}
-	case 228:
+	case 283:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1319
+//line sql.y:1660
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableName, NewName: yyDollar[5].tableName}
 		}
-	case 229:
+	case 284:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1324
+//line sql.y:1665
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -4018,123 +5149,123 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, Table: yyDollar[4].tableName.ToViewName(), IfExists: exists}
 		}
-	case 230:
+	case 285:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1332
+//line sql.y:1673
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 231:
+	case 286:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1336
+//line sql.y:1677
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 232:
+	case 287:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1342
+//line sql.y:1683
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
 		}
-	case 233:
+	case 288:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1346
+//line sql.y:1687
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
 		}
-	case 234:
+	case 289:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1351
+//line sql.y:1692
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName, NewName: yyDollar[3].tableName}
 		}
-	case 235:
+	case 290:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1357
+//line sql.y:1698
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 236:
+	case 291:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1361
+//line sql.y:1702
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 237:
+	case 292:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1365
+//line sql.y:1706
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 238:
+	case 293:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1370
+//line sql.y:1711
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 239:
+	case 294:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1374
+//line sql.y:1715
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 240:
+	case 295:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1378
+//line sql.y:1719
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 241:
+	case 296:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1382
+//line sql.y:1723
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 242:
+	case 297:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1386
+//line sql.y:1727
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 243:
+	case 298:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1390
+//line sql.y:1731
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 244:
+	case 299:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1394
+//line sql.y:1735
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 245:
+	case 300:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1398
+//line sql.y:1739
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 246:
+	case 301:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1402
+//line sql.y:1743
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 247:
+	case 302:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1406
+//line sql.y:1747
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 248:
+	case 303:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1410
+//line sql.y:1751
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 249:
+	case 304:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1414
+//line sql.y:1755
 		{
 			// this is ugly, but I couldn't find a better way for now
 			if yyDollar[4].str == "processlist" {
@@ -4144,616 +5275,671 @@ yydefault:
 				yyVAL.statement = &Show{Type: yyDollar[4].str, ShowTablesOpt: showTablesOpt}
 			}
 		}
-	case 250:
+	case 305:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1424
+//line sql.y:1765
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 251:
+	case 306:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1428
+//line sql.y:1769
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 252:
+	case 307:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1432
+//line sql.y:1773
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), OnTable: yyDollar[4].tableName}
 		}
-	case 253:
+	case 308:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1436
+//line sql.y:1777
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 254:
+	case 309:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1440
+//line sql.y:1781
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 255:
+	case 310:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1444
+//line sql.y:1785
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 256:
+	case 311:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1448
+//line sql.y:1789
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 257:
+	case 312:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1458
+//line sql.y:1799
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 258:
+	case 313:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1464
+//line sql.y:1805
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 259:
+	case 314:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1468
+//line sql.y:1809
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 260:
+	case 315:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1474
+//line sql.y:1815
 		{
 			yyVAL.str = ""
 		}
-	case 261:
+	case 316:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1478
+//line sql.y:1819
 		{
 			yyVAL.str = "extended "
 		}
-	case 262:
+	case 317:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1484
+//line sql.y:1825
 		{
 			yyVAL.str = ""
 		}
-	case 263:
+	case 318:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1488
+//line sql.y:1829
 		{
 			yyVAL.str = "full "
 		}
-	case 264:
+	case 319:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1494
+//line sql.y:1835
 		{
 			yyVAL.str = ""
 		}
-	case 265:
+	case 320:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1498
+//line sql.y:1839
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 266:
+	case 321:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1502
+//line sql.y:1843
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 267:
+	case 322:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1508
+//line sql.y:1849
 		{
 			yyVAL.showFilter = nil
 		}
-	case 268:
+	case 323:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1512
+//line sql.y:1853
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 269:
+	case 324:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1516
+//line sql.y:1857
 		{
 			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
 		}
-	case 270:
+	case 325:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1522
+//line sql.y:1863
 		{
 			yyVAL.str = ""
 		}
-	case 271:
+	case 326:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1526
+//line sql.y:1867
 		{
 			yyVAL.str = SessionStr
 		}
-	case 272:
+	case 327:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1530
+//line sql.y:1871
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 273:
+	case 328:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1536
+//line sql.y:1877
 		{
 			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
 		}
-	case 274:
+	case 329:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1540
+//line sql.y:1881
 		{
 			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
 		}
-	case 275:
+	case 330:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1546
+//line sql.y:1887
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 276:
+	case 331:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1550
+//line sql.y:1891
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 277:
+	case 332:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1556
+//line sql.y:1897
 		{
 			yyVAL.statement = &Commit{}
 		}
-	case 278:
+	case 333:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1562
+//line sql.y:1903
 		{
 			yyVAL.statement = &Rollback{}
 		}
-	case 279:
+	case 334:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1568
+//line sql.y:1909
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 280:
+	case 335:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1572
+//line sql.y:1913
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 281:
+	case 336:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1576
+//line sql.y:1917
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 282:
+	case 337:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1580
+//line sql.y:1921
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 283:
+	case 338:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1584
+//line sql.y:1925
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 284:
+	case 339:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1589
+//line sql.y:1930
 		{
 			setAllowComments(yylex, true)
 		}
-	case 285:
+	case 340:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1593
+//line sql.y:1934
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 286:
+	case 341:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1599
+//line sql.y:1940
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 287:
+	case 342:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1603
+//line sql.y:1944
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 288:
+	case 343:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1609
+//line sql.y:1950
 		{
 			yyVAL.str = UnionStr
 		}
-	case 289:
+	case 344:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1613
+//line sql.y:1954
 		{
 			yyVAL.str = UnionAllStr
 		}
-	case 290:
+	case 345:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1617
+//line sql.y:1958
 		{
 			yyVAL.str = UnionDistinctStr
 		}
-	case 291:
+	case 346:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1622
+//line sql.y:1963
 		{
 			yyVAL.str = ""
 		}
-	case 292:
+	case 347:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1626
+//line sql.y:1967
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 293:
+	case 348:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1630
+//line sql.y:1971
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 294:
+	case 349:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1635
+//line sql.y:1976
 		{
 			yyVAL.str = ""
 		}
-	case 295:
+	case 350:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1639
+//line sql.y:1980
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 296:
+	case 351:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1644
+//line sql.y:1985
 		{
 			yyVAL.str = ""
 		}
-	case 297:
+	case 352:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1648
+//line sql.y:1989
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 298:
+	case 353:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1653
+//line sql.y:1994
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 299:
+	case 354:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1657
+//line sql.y:1998
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 300:
+	case 355:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1663
+//line sql.y:2004
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 301:
+	case 356:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1667
+//line sql.y:2008
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 302:
+	case 357:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1673
+//line sql.y:2014
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 303:
+	case 358:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1677
+//line sql.y:2018
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 304:
+	case 359:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1681
+//line sql.y:2022
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
-	case 305:
+	case 360:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1685
+//line sql.y:2026
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
-	case 306:
+	case 361:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1690
+//line sql.y:2032
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 307:
+	case 362:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1694
+//line sql.y:2036
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 308:
+	case 363:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1698
+//line sql.y:2040
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 310:
+	case 365:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1705
+//line sql.y:2047
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 311:
+	case 366:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2051
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 367:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1710
+//line sql.y:2056
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 312:
+	case 368:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1714
+//line sql.y:2060
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 313:
+	case 369:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1720
+//line sql.y:2066
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 314:
+	case 370:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1724
+//line sql.y:2070
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 317:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1734
+	case 373:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2080
 		{
+			yyDollar[1].aliasedTableName.LateralViews = yyDollar[2].lateralViews
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
-	case 318:
+	case 374:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1738
+//line sql.y:2085
 		{
-			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery, As: yyDollar[3].tableIdent}
+			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery, As: yyDollar[2].tableIdent, LateralViews: yyDollar[3].lateralViews}
 		}
-	case 319:
+	case 375:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1742
+//line sql.y:2089
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery}
 		}
-	case 320:
+	case 376:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1746
+//line sql.y:2093
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 321:
+	case 377:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1752
+//line sql.y:2099
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
 		}
-	case 322:
+	case 378:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1756
+//line sql.y:2103
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
 		}
-	case 323:
+	case 379:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2108
+		{
+			yyVAL.lateralViews = nil
+		}
+	case 380:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2112
+		{
+			yyVAL.lateralViews = yyDollar[1].lateralViews
+		}
+	case 381:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2118
+		{
+			yyVAL.lateralViews = LateralViews{yyDollar[1].lateralView}
+		}
+	case 382:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2122
+		{
+			yyVAL.lateralViews = append(yyDollar[1].lateralViews, yyDollar[2].lateralView)
+		}
+	case 383:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:2128
+		{
+			yyVAL.lateralView = &LateralView{Outer: bool(yyDollar[3].boolVal), Func: &FuncExpr{Name: yyDollar[4].colIdent, Exprs: yyDollar[6].selectExprs}, As: yyDollar[8].tableIdent, Columns: yyDollar[9].columns}
+		}
+	case 384:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2133
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 385:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2137
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 386:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2145
+		{
+			yyVAL.columns = nil
+		}
+	case 387:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2149
+		{
+			yyVAL.columns = yyDollar[2].columns
+		}
+	case 388:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1762
+//line sql.y:2155
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 324:
+	case 389:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1766
+//line sql.y:2159
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 325:
+	case 390:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1772
+//line sql.y:2165
 		{
 			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
 		}
-	case 326:
+	case 391:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1776
+//line sql.y:2169
 		{
 			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
 		}
-	case 327:
+	case 392:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1789
+//line sql.y:2182
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 328:
+	case 393:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1793
+//line sql.y:2186
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 329:
+	case 394:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1797
+//line sql.y:2190
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 330:
+	case 395:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1801
+//line sql.y:2194
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 331:
+	case 396:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1807
+//line sql.y:2200
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 332:
+	case 397:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1809
+//line sql.y:2202
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
-	case 333:
+	case 398:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1813
+//line sql.y:2206
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 334:
+	case 399:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1815
+//line sql.y:2208
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 335:
+	case 400:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1819
+//line sql.y:2212
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 336:
+	case 401:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1821
+//line sql.y:2214
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 337:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1824
-		{
-			yyVAL.empty = struct{}{}
-		}
-	case 338:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1826
+	case 403:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2219
 		{
-			yyVAL.empty = struct{}{}
+			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 339:
+	case 404:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1829
+//line sql.y:2225
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 340:
+	case 405:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1833
+//line sql.y:2229
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 341:
+	case 406:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1837
+//line sql.y:2233
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 343:
+	case 408:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1844
+//line sql.y:2240
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 344:
+	case 409:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1850
+//line sql.y:2246
 		{
 			yyVAL.str = JoinStr
 		}
-	case 345:
+	case 410:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1854
+//line sql.y:2250
 		{
 			yyVAL.str = JoinStr
 		}
-	case 346:
+	case 411:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1858
+//line sql.y:2254
 		{
 			yyVAL.str = JoinStr
 		}
-	case 347:
+	case 412:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1864
+//line sql.y:2260
 		{
 			yyVAL.str = StraightJoinStr
 		}
-	case 348:
+	case 413:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1870
+//line sql.y:2266
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 349:
+	case 414:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1874
+//line sql.y:2270
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 350:
+	case 415:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1878
+//line sql.y:2274
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 351:
+	case 416:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1882
+//line sql.y:2278
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 352:
+	case 417:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1886
+//line sql.y:2282
 		{
 			yyVAL.str = FullOuterJoinStr
 		}
-	case 353:
+	case 418:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1890
+//line sql.y:2286
 		{
 			yyVAL.str = FullOuterJoinStr
 		}
-	case 354:
+	case 419:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1896
+//line sql.y:2292
 		{
 			yyVAL.str = NaturalJoinStr
 		}
-	case 355:
+	case 420:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1900
+//line sql.y:2296
 		{
 			if yyDollar[2].str == LeftJoinStr {
 				yyVAL.str = NaturalLeftJoinStr
@@ -4761,459 +5947,465 @@ yydefault:
 				yyVAL.str = NaturalRightJoinStr
 			}
 		}
-	case 356:
+	case 421:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1910
+//line sql.y:2306
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 357:
+	case 422:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1914
+//line sql.y:2310
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 358:
+	case 423:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1920
+//line sql.y:2316
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 359:
+	case 424:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1924
+//line sql.y:2320
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 360:
+	case 425:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1929
+//line sql.y:2325
 		{
 			yyVAL.indexHints = nil
 		}
-	case 361:
+	case 426:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1933
+//line sql.y:2329
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].columns}
 		}
-	case 362:
+	case 427:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1937
+//line sql.y:2333
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].columns}
 		}
-	case 363:
+	case 428:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1941
+//line sql.y:2337
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].columns}
 		}
-	case 364:
+	case 429:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1946
+//line sql.y:2342
 		{
 			yyVAL.expr = nil
 		}
-	case 365:
+	case 430:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1950
+//line sql.y:2346
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 366:
+	case 431:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1956
+//line sql.y:2352
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 367:
+	case 432:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1960
+//line sql.y:2356
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 368:
+	case 433:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1964
+//line sql.y:2360
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 369:
+	case 434:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1968
+//line sql.y:2364
 		{
 			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
 		}
-	case 370:
+	case 435:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1972
+//line sql.y:2368
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
 		}
-	case 371:
+	case 436:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1976
+//line sql.y:2372
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 372:
+	case 437:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1980
+//line sql.y:2376
 		{
 			yyVAL.expr = &Default{ColName: yyDollar[2].str}
 		}
-	case 373:
+	case 438:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1986
+//line sql.y:2382
 		{
 			yyVAL.str = ""
 		}
-	case 374:
+	case 439:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1990
+//line sql.y:2386
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 375:
+	case 440:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1996
+//line sql.y:2392
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 376:
+	case 441:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2000
+//line sql.y:2396
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 377:
+	case 442:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2006
+//line sql.y:2402
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
 		}
-	case 378:
+	case 443:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2010
+//line sql.y:2406
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
 		}
-	case 379:
+	case 444:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2014
+//line sql.y:2410
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
 		}
-	case 380:
+	case 445:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2018
+//line sql.y:2414
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
-	case 381:
+	case 446:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2022
+//line sql.y:2418
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
-	case 382:
+	case 447:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2026
+//line sql.y:2422
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
 		}
-	case 383:
+	case 448:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2030
+//line sql.y:2426
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
 		}
-	case 384:
+	case 449:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2034
+//line sql.y:2430
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
-	case 385:
+	case 450:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2038
+//line sql.y:2434
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
-	case 386:
+	case 451:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2042
+//line sql.y:2438
 		{
 			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
-	case 387:
+	case 452:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2048
+//line sql.y:2444
 		{
 			yyVAL.str = IsNullStr
 		}
-	case 388:
+	case 453:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2052
+//line sql.y:2448
 		{
 			yyVAL.str = IsNotNullStr
 		}
-	case 389:
+	case 454:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2056
+//line sql.y:2452
 		{
 			yyVAL.str = IsTrueStr
 		}
-	case 390:
+	case 455:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2060
+//line sql.y:2456
 		{
 			yyVAL.str = IsNotTrueStr
 		}
-	case 391:
+	case 456:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2064
+//line sql.y:2460
 		{
 			yyVAL.str = IsFalseStr
 		}
-	case 392:
+	case 457:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2068
+//line sql.y:2464
 		{
 			yyVAL.str = IsNotFalseStr
 		}
-	case 393:
+	case 458:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2074
+//line sql.y:2470
 		{
 			yyVAL.str = EqualStr
 		}
-	case 394:
+	case 459:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2078
+//line sql.y:2474
 		{
 			yyVAL.str = LessThanStr
 		}
-	case 395:
+	case 460:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2082
+//line sql.y:2478
 		{
 			yyVAL.str = GreaterThanStr
 		}
-	case 396:
+	case 461:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2086
+//line sql.y:2482
 		{
 			yyVAL.str = LessEqualStr
 		}
-	case 397:
+	case 462:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2090
+//line sql.y:2486
 		{
 			yyVAL.str = GreaterEqualStr
 		}
-	case 398:
+	case 463:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2094
+//line sql.y:2490
 		{
 			yyVAL.str = NotEqualStr
 		}
-	case 399:
+	case 464:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2098
+//line sql.y:2494
 		{
 			yyVAL.str = NullSafeEqualStr
 		}
-	case 400:
+	case 465:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2103
+//line sql.y:2499
 		{
 			yyVAL.expr = nil
 		}
-	case 401:
+	case 466:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2107
+//line sql.y:2503
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 402:
+	case 467:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2113
+//line sql.y:2509
 		{
 			yyVAL.colTuple = yyDollar[1].valTuple
 		}
-	case 403:
+	case 468:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2117
+//line sql.y:2513
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
-	case 404:
+	case 469:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2121
+//line sql.y:2517
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
-	case 405:
+	case 470:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2127
+//line sql.y:2523
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 406:
+	case 471:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2133
+//line sql.y:2529
 		{
 			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
-	case 407:
+	case 472:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2137
+//line sql.y:2533
 		{
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
-	case 408:
+	case 473:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2143
+//line sql.y:2539
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 409:
+	case 474:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2147
+//line sql.y:2543
 		{
 			yyVAL.expr = yyDollar[1].boolVal
 		}
-	case 410:
+	case 475:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2151
+//line sql.y:2547
 		{
 			yyVAL.expr = yyDollar[1].colName
 		}
-	case 411:
+	case 476:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2155
+//line sql.y:2551
 		{
 			yyVAL.expr = &BracketExpr{Expr: yyDollar[1].expr, Index: yyDollar[3].expr}
 		}
-	case 412:
+	case 477:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2159
+//line sql.y:2555
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 413:
+	case 478:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2559
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 479:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2163
+//line sql.y:2563
 		{
 			yyVAL.expr = yyDollar[1].subquery
 		}
-	case 414:
+	case 480:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2167
+//line sql.y:2567
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
 		}
-	case 415:
+	case 481:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2171
+//line sql.y:2571
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
 		}
-	case 416:
+	case 482:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2175
+//line sql.y:2575
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
 		}
-	case 417:
+	case 483:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2179
+//line sql.y:2579
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
 		}
-	case 418:
+	case 484:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2183
+//line sql.y:2583
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
 		}
-	case 419:
+	case 485:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2187
+//line sql.y:2587
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
 		}
-	case 420:
+	case 486:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2191
+//line sql.y:2591
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
 		}
-	case 421:
+	case 487:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2195
+//line sql.y:2595
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
 		}
-	case 422:
+	case 488:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2199
+//line sql.y:2599
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 423:
+	case 489:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2203
+//line sql.y:2603
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 424:
+	case 490:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2207
+//line sql.y:2607
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
 		}
-	case 425:
+	case 491:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2211
+//line sql.y:2611
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
 		}
-	case 426:
+	case 492:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2215
+//line sql.y:2615
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
 		}
-	case 427:
+	case 493:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2219
+//line sql.y:2619
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
 		}
-	case 428:
+	case 494:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2223
+//line sql.y:2623
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
 		}
-	case 429:
+	case 495:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2227
+//line sql.y:2627
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 430:
+	case 496:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2231
+//line sql.y:2631
 		{
 			yyVAL.expr = &UnaryExpr{Operator: UBinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 431:
+	case 497:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2235
+//line sql.y:2635
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -5221,9 +6413,9 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UPlusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 432:
+	case 498:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2243
+//line sql.y:2643
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -5237,21 +6429,21 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 433:
+	case 499:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2257
+//line sql.y:2657
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
 		}
-	case 434:
+	case 500:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2261
+//line sql.y:2661
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
 		}
-	case 435:
+	case 501:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2265
+//line sql.y:2665
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -5259,489 +6451,711 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
 		}
-	case 440:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2283
+	case 506:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2683
 		{
-			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].windowSpec}
 		}
-	case 441:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2287
+	case 507:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2687
 		{
-			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs, Over: yyDollar[6].windowSpec}
 		}
-	case 442:
+	case 508:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2291
+//line sql.y:2691
 		{
 			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
 		}
-	case 443:
+	case 509:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2301
+//line sql.y:2701
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 444:
+	case 510:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2305
+//line sql.y:2705
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 445:
+	case 511:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2309
+//line sql.y:2709
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 446:
+	case 512:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2313
+//line sql.y:2713
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType, Cast: true}
 		}
-	case 447:
+	case 513:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2317
+//line sql.y:2717
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
-	case 448:
+	case 514:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2321
+//line sql.y:2721
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: nil}
 		}
-	case 449:
+	case 515:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2325
+//line sql.y:2725
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 450:
+	case 516:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2329
+//line sql.y:2729
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 451:
+	case 517:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2333
+//line sql.y:2733
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: nil}
 		}
-	case 452:
+	case 518:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2337
+//line sql.y:2737
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 453:
+	case 519:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2341
+//line sql.y:2741
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 454:
+	case 520:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2345
+//line sql.y:2745
 		{
 			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
 		}
-	case 455:
+	case 521:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2349
+//line sql.y:2749
 		{
 			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str}
 		}
-	case 456:
+	case 522:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2353
+//line sql.y:2753
 		{
 			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
 		}
-	case 457:
+	case 523:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2357
+//line sql.y:2757
 		{
 			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
 		}
-	case 458:
+	case 524:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2367
+//line sql.y:2767
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
-	case 459:
+	case 525:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2371
+//line sql.y:2771
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
-	case 460:
+	case 526:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2375
+//line sql.y:2775
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
-	case 461:
+	case 527:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2379
+//line sql.y:2779
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
-	case 462:
+	case 528:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2384
+//line sql.y:2784
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
 		}
-	case 463:
+	case 529:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2389
+//line sql.y:2789
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
-	case 464:
+	case 530:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2394
+//line sql.y:2794
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
 		}
-	case 465:
+	case 531:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2399
+//line sql.y:2799
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
 		}
-	case 468:
+	case 534:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2413
+//line sql.y:2813
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 469:
+	case 535:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2417
+//line sql.y:2817
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 470:
+	case 536:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2421
+//line sql.y:2821
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 471:
+	case 537:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2825
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
+		}
+	case 538:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2831
+		{
+			yyVAL.windowSpec = nil
+		}
+	case 539:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2835
+		{
+			yyVAL.windowSpec = yyDollar[3].windowSpec
+		}
+	case 540:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2839
+		{
+			yyVAL.windowSpec = &WindowSpecification{Name: yyDollar[2].colIdent}
+		}
+	case 541:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2425
+//line sql.y:2845
+		{
+			yyVAL.windowSpec = &WindowSpecification{Name: yyDollar[1].colIdent, PartitionBy: yyDollar[2].exprs, OrderBy: yyDollar[3].orderBy, Frame: yyDollar[4].windowFrame}
+		}
+	case 542:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2852
+		{
+			yyVAL.colIdent = ColIdent{}
+		}
+	case 543:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2856
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 544:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2861
+		{
+			yyVAL.namedWindows = nil
+		}
+	case 545:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2865
+		{
+			yyVAL.namedWindows = yyDollar[2].namedWindows
+		}
+	case 546:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2871
+		{
+			yyVAL.namedWindows = NamedWindows{yyDollar[1].namedWindow}
+		}
+	case 547:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2875
+		{
+			yyVAL.namedWindows = append(yyDollar[1].namedWindows, yyDollar[3].namedWindow)
+		}
+	case 548:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2881
+		{
+			yyVAL.namedWindow = &NamedWindow{Name: yyDollar[1].colIdent, Spec: yyDollar[4].windowSpec}
+		}
+	case 549:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2886
+		{
+			yyVAL.exprs = nil
+		}
+	case 550:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2890
+		{
+			yyVAL.exprs = yyDollar[3].exprs
+		}
+	case 551:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2895
+		{
+			yyVAL.windowFrame = nil
+		}
+	case 552:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2899
+		{
+			yyVAL.windowFrame = &WindowFrame{Unit: yyDollar[1].str, Start: yyDollar[2].frameBound}
+		}
+	case 553:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2903
+		{
+			yyVAL.windowFrame = &WindowFrame{Unit: yyDollar[1].str, Start: yyDollar[3].frameBound, End: yyDollar[5].frameBound}
+		}
+	case 554:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2909
+		{
+			yyVAL.str = RowsStr
+		}
+	case 555:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2913
+		{
+			yyVAL.str = RangeStr
+		}
+	case 556:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2919
+		{
+			yyVAL.frameBound = &FrameBound{Type: UnboundedPrecedingStr}
+		}
+	case 557:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2923
+		{
+			yyVAL.frameBound = &FrameBound{Type: UnboundedFollowingStr}
+		}
+	case 558:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2927
+		{
+			yyVAL.frameBound = &FrameBound{Type: CurrentRowStr}
+		}
+	case 559:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2931
+		{
+			yyVAL.frameBound = &FrameBound{Type: PrecedingStr, Expr: yyDollar[1].expr}
+		}
+	case 560:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2935
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.frameBound = &FrameBound{Type: FollowingStr, Expr: yyDollar[1].expr}
 		}
-	case 472:
+	case 561:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2431
+//line sql.y:2941
 		{
 			yyVAL.str = ""
 		}
-	case 473:
+	case 562:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2435
+//line sql.y:2945
 		{
 			yyVAL.str = BooleanModeStr
 		}
-	case 474:
+	case 563:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2439
+//line sql.y:2949
 		{
 			yyVAL.str = NaturalLanguageModeStr
 		}
-	case 475:
+	case 564:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2443
+//line sql.y:2953
 		{
 			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
 		}
-	case 476:
+	case 565:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2447
+//line sql.y:2957
 		{
 			yyVAL.str = QueryExpansionStr
 		}
-	case 477:
+	case 566:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2453
+//line sql.y:2963
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 478:
+	case 567:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2457
+//line sql.y:2967
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 479:
+	case 568:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2463
+//line sql.y:2973
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 480:
+	case 569:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2467
+//line sql.y:2977
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Operator: CharacterSetStr}
 		}
-	case 481:
+	case 570:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2471
+//line sql.y:2981
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: string(yyDollar[3].bytes)}
 		}
-	case 482:
+	case 571:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2475
+//line sql.y:2985
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 483:
+	case 572:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2479
+//line sql.y:2989
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 484:
+	case 573:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2483
+//line sql.y:2993
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 485:
+	case 574:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2489
+//line sql.y:2999
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 486:
+	case 575:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2495
+//line sql.y:3005
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 487:
+	case 576:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2501
+//line sql.y:3011
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 488:
+	case 577:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2505
+//line sql.y:3015
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 489:
+	case 578:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2509
+//line sql.y:3019
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 490:
+	case 579:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2513
+//line sql.y:3023
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 491:
+	case 580:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2517
+//line sql.y:3027
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 492:
+	case 581:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2521
+//line sql.y:3031
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 493:
+	case 582:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2525
+//line sql.y:3035
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 494:
+	case 583:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2529
+//line sql.y:3039
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 495:
+	case 584:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2533
+//line sql.y:3043
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 496:
+	case 585:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2537
+//line sql.y:3047
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 497:
+	case 586:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2541
+//line sql.y:3051
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 498:
+	case 587:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2545
+//line sql.y:3055
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 499:
+	case 588:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2549
+//line sql.y:3059
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 500:
+	case 589:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2553
+//line sql.y:3063
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 501:
+	case 590:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3067
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+		}
+	case 591:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3071
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 592:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3075
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 594:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3086
+		{
+			yyVAL.convertType = &ConvertType{Type: ArrayStr, Complex: &ComplexType{Value: yyDollar[4].convertType}}
+		}
+	case 595:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:3090
+		{
+			yyVAL.convertType = &ConvertType{Type: MapStr, Complex: &ComplexType{Key: yyDollar[4].convertType, Value: yyDollar[6].convertType}}
+		}
+	case 596:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3094
+		{
+			yyVAL.convertType = &ConvertType{Type: StructStr, Complex: &ComplexType{Fields: yyDollar[4].structFields}}
+		}
+	case 597:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3099
+		{
+			openType(yylex)
+		}
+	case 598:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3105
+		{
+			yyVAL.structFields = []*StructField{yyDollar[1].structField}
+		}
+	case 599:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3109
+		{
+			yyVAL.structFields = append(yyDollar[1].structFields, yyDollar[3].structField)
+		}
+	case 600:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3115
+		{
+			yyVAL.structField = &StructField{Name: yyDollar[1].colIdent, Type: yyDollar[3].convertType}
+		}
+	case 601:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2558
+//line sql.y:3120
 		{
 			yyVAL.expr = nil
 		}
-	case 502:
+	case 602:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2562
+//line sql.y:3124
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 503:
+	case 603:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2567
+//line sql.y:3129
 		{
 			yyVAL.str = string("")
 		}
-	case 504:
+	case 604:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2571
+//line sql.y:3133
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
-	case 505:
+	case 605:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2577
+//line sql.y:3139
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 506:
+	case 606:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2581
+//line sql.y:3143
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 507:
+	case 607:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2587
+//line sql.y:3149
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 508:
+	case 608:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2592
+//line sql.y:3154
 		{
 			yyVAL.expr = nil
 		}
-	case 509:
+	case 609:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2596
+//line sql.y:3158
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 510:
+	case 610:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2602
+//line sql.y:3164
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 511:
+	case 611:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2606
+//line sql.y:3168
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 512:
+	case 612:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2610
+//line sql.y:3172
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 513:
+	case 613:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:3181
+		{
+			yyVAL.expr = &FieldAccessExpr{Expr: &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}, Field: yyDollar[7].colIdent}
+		}
+	case 614:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3185
+		{
+			yyVAL.expr = &FieldAccessExpr{Expr: &BracketExpr{Expr: yyDollar[1].expr, Index: yyDollar[3].expr}, Field: yyDollar[6].colIdent}
+		}
+	case 615:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3189
+		{
+			yyVAL.expr = &FieldAccessExpr{Expr: yyDollar[1].expr, Field: yyDollar[3].colIdent}
+		}
+	case 616:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2616
+//line sql.y:3195
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 514:
+	case 617:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2620
+//line sql.y:3199
 		{
 			yyVAL.expr = NewHexVal(yyDollar[1].bytes)
 		}
-	case 515:
+	case 618:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2624
+//line sql.y:3203
 		{
 			yyVAL.expr = NewBitVal(yyDollar[1].bytes)
 		}
-	case 516:
+	case 619:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2628
+//line sql.y:3207
+		{
+			yyVAL.expr = NewTemplateVar(yyDollar[1].bytes)
+		}
+	case 620:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3211
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 517:
+	case 621:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2632
+//line sql.y:3215
 		{
 			yyVAL.expr = NewFloatVal(yyDollar[1].bytes)
 		}
-	case 518:
+	case 622:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2636
+//line sql.y:3219
 		{
 			yyVAL.expr = NewHexNum(yyDollar[1].bytes)
 		}
-	case 519:
+	case 623:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2640
+//line sql.y:3223
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 520:
+	case 624:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2644
+//line sql.y:3227
 		{
 			yyVAL.expr = &NullVal{}
 		}
-	case 521:
+	case 625:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2650
+//line sql.y:3233
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -5750,239 +7164,239 @@ yydefault:
 			}
 			yyVAL.expr = NewIntVal([]byte("1"))
 		}
-	case 522:
+	case 626:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2659
+//line sql.y:3242
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 523:
+	case 627:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2663
+//line sql.y:3246
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 524:
+	case 628:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2668
+//line sql.y:3251
 		{
 			yyVAL.exprs = nil
 		}
-	case 525:
+	case 629:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2672
+//line sql.y:3255
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 526:
+	case 630:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2677
+//line sql.y:3260
 		{
 			yyVAL.expr = nil
 		}
-	case 527:
+	case 631:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2681
+//line sql.y:3264
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 528:
+	case 632:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2686
+//line sql.y:3269
 		{
 			yyVAL.orderBy = nil
 		}
-	case 529:
+	case 633:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2690
+//line sql.y:3273
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 530:
+	case 634:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2696
+//line sql.y:3279
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 531:
+	case 635:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2700
+//line sql.y:3283
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 532:
+	case 636:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2706
+//line sql.y:3289
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
 		}
-	case 533:
+	case 637:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2711
+//line sql.y:3294
 		{
 			yyVAL.str = AscScr
 		}
-	case 534:
+	case 638:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2715
+//line sql.y:3298
 		{
 			yyVAL.str = AscScr
 		}
-	case 535:
+	case 639:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2719
+//line sql.y:3302
 		{
 			yyVAL.str = DescScr
 		}
-	case 536:
+	case 640:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2724
+//line sql.y:3307
 		{
 			yyVAL.limit = nil
 		}
-	case 537:
+	case 641:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2728
+//line sql.y:3311
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 538:
+	case 642:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2732
+//line sql.y:3315
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 539:
+	case 643:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2736
+//line sql.y:3319
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 540:
+	case 644:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2741
+//line sql.y:3324
 		{
 			yyVAL.str = ""
 		}
-	case 541:
+	case 645:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2745
+//line sql.y:3328
 		{
 			yyVAL.str = ForUpdateStr
 		}
-	case 542:
+	case 646:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2749
+//line sql.y:3332
 		{
 			yyVAL.str = ShareModeStr
 		}
-	case 543:
+	case 647:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2762
+//line sql.y:3345
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
-	case 544:
+	case 648:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2766
+//line sql.y:3349
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 545:
+	case 649:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2770
+//line sql.y:3353
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Rows: yyDollar[2].selStmt}
 		}
-	case 546:
+	case 650:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2775
+//line sql.y:3358
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
-	case 547:
+	case 651:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2779
+//line sql.y:3362
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 548:
+	case 652:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2783
+//line sql.y:3366
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].selStmt}
 		}
-	case 549:
+	case 653:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2790
+//line sql.y:3373
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 550:
+	case 654:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2794
+//line sql.y:3377
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 551:
+	case 655:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2798
+//line sql.y:3381
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 552:
+	case 656:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2802
+//line sql.y:3385
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 553:
+	case 657:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2807
+//line sql.y:3390
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 554:
+	case 658:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2811
+//line sql.y:3394
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 555:
+	case 659:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2817
+//line sql.y:3400
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 556:
+	case 660:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2821
+//line sql.y:3404
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 557:
+	case 661:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2827
+//line sql.y:3410
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 558:
+	case 662:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2831
+//line sql.y:3414
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 559:
+	case 663:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2837
+//line sql.y:3420
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 560:
+	case 664:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2843
+//line sql.y:3426
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = &ParenExpr{yyDollar[1].valTuple[0]}
@@ -5990,306 +7404,324 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 561:
+	case 665:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2853
+//line sql.y:3436
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 562:
+	case 666:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2857
+//line sql.y:3440
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 563:
+	case 667:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2863
+//line sql.y:3446
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 564:
+	case 668:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2869
+//line sql.y:3452
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 565:
+	case 669:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2873
+//line sql.y:3456
 		{
 			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
 		}
-	case 566:
+	case 670:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2879
+//line sql.y:3462
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("on"))}
 		}
-	case 567:
+	case 671:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2883
+//line sql.y:3466
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: yyDollar[3].expr}
 		}
-	case 568:
+	case 672:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2887
+//line sql.y:3470
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Expr: yyDollar[2].expr}
 		}
-	case 570:
+	case 674:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2894
+//line sql.y:3477
 		{
 			yyVAL.bytes = []byte("charset")
 		}
-	case 572:
+	case 676:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2901
+//line sql.y:3484
 		{
 			yyVAL.expr = NewStrVal([]byte(yyDollar[1].colIdent.String()))
 		}
-	case 573:
+	case 677:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2905
+//line sql.y:3488
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 574:
+	case 678:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2909
+//line sql.y:3492
 		{
 			yyVAL.expr = &Default{}
 		}
-	case 577:
+	case 681:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2918
+//line sql.y:3501
 		{
 			yyVAL.byt = 0
 		}
-	case 578:
+	case 682:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2920
+//line sql.y:3503
 		{
 			yyVAL.byt = 1
 		}
-	case 579:
+	case 683:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2923
+//line sql.y:3506
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 580:
+	case 684:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2925
+//line sql.y:3508
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 581:
+	case 685:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2928
+//line sql.y:3511
 		{
 			yyVAL.str = ""
 		}
-	case 582:
+	case 686:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2930
+//line sql.y:3513
 		{
 			yyVAL.str = IgnoreStr
 		}
-	case 583:
+	case 687:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2934
+//line sql.y:3517
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 584:
+	case 688:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2936
+//line sql.y:3519
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 585:
+	case 689:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2938
+//line sql.y:3521
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 586:
+	case 690:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2940
+//line sql.y:3523
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 587:
+	case 691:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2942
+//line sql.y:3525
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 588:
+	case 692:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2944
+//line sql.y:3527
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 589:
+	case 693:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2946
+//line sql.y:3529
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 590:
+	case 694:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2948
+//line sql.y:3531
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 591:
+	case 695:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2950
+//line sql.y:3533
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 592:
+	case 696:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2952
+//line sql.y:3535
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 593:
+	case 697:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2955
+//line sql.y:3538
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 594:
+	case 698:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2957
+//line sql.y:3540
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 595:
+	case 699:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2959
+//line sql.y:3542
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 596:
+	case 700:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2963
+//line sql.y:3546
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 597:
+	case 701:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2965
+//line sql.y:3548
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 598:
+	case 702:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2968
+//line sql.y:3551
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 599:
+	case 703:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2970
+//line sql.y:3553
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 600:
+	case 704:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2972
+//line sql.y:3555
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 601:
+	case 705:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2975
+//line sql.y:3558
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 602:
+	case 706:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2977
+//line sql.y:3560
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 603:
+	case 707:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2981
+//line sql.y:3564
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 604:
+	case 708:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2985
+//line sql.y:3568
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 606:
+	case 709:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2992
+//line sql.y:3572
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 607:
+	case 711:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3579
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 712:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2998
+//line sql.y:3585
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 608:
+	case 713:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3002
+//line sql.y:3589
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 610:
+	case 714:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3593
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 715:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3597
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 717:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3009
+//line sql.y:3604
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 801:
+	case 942:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3225
+//line sql.y:3862
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 802:
+	case 943:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3234
+//line sql.y:3871
 		{
 			decNesting(yylex)
 		}
-	case 803:
+	case 944:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3239
+//line sql.y:3876
 		{
 			forceEOF(yylex)
 		}
-	case 804:
+	case 945:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3244
+//line sql.y:3881
 		{
 			forceEOF(yylex)
 		}
-	case 805:
+	case 946:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3248
+//line sql.y:3885
 		{
 			forceEOF(yylex)
 		}
-	case 806:
+	case 947:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3252
+//line sql.y:3889
 		{
 			forceEOF(yylex)
 		}
@@ -41,6 +41,12 @@ func decNesting(yylex interface{}) {
   yylex.(*Tokenizer).nesting--
 }
 
+// openType tells the lexer that the next '<' opens the parameters of
+// a complex type.
+func openType(yylex interface{}) {
+  yylex.(*Tokenizer).openingType = true
+}
+
 // forceEOF forces the lexer to end prematurely. Not all SQL statements
 // are supported by the Parser, thus calling forceEOF will make the lexer
 // return EOF early.
@@ -112,10 +118,32 @@ func forceEOF(yylex interface{}) {
   vindexParam   VindexParam
   vindexParams  []VindexParam
   showFilter    *ShowFilter
+  windowSpec    *WindowSpecification
+  windowFrame   *WindowFrame
+  frameBound    *FrameBound
+  namedWindow   *NamedWindow
+  namedWindows  NamedWindows
+  lateralView   *LateralView
+  lateralViews  LateralViews
+  partVal       *PartitionValue
+  partVals      PartitionValues
+  columnDefinitions []*ColumnDefinition
+  clusteredBy   *ClusteredBy
+  rowFormat     *RowFormat
+  storedAs      *StoredAs
+  tableProps    TableProperties
+  tableProp     *TableProperty
+  structField   *StructField
+  structFields  []*StructField
 }
 
 %token LEX_ERROR
 %left <bytes> UNION
+// WINDOW and LATERAL are non-reserved, so FROM t WINDOW could also alias
+// t as window. Their precedence under the empty table aliases makes the
+// parser read the WINDOW clause or the LATERAL VIEW, and over the empty
+// select alias read SELECT a WINDOW as an alias.
+%nonassoc <bytes> WINDOW LATERAL
 %token <bytes> SELECT STREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR
 %token <bytes> ALL DISTINCT AS EXISTS ASC DESC INTO DUPLICATE KEY DEFAULT SET LOCK KEYS
 %token <bytes> VALUES LAST_INSERT_ID
@@ -125,6 +153,7 @@ func forceEOF(yylex interface{}) {
 %left <bytes> ON USING
 %token <empty> '(' ',' ')'
 %token <bytes> ID HEX STRING STRINGKW INTEGRAL FLOAT HEXNUM VALUE_ARG LIST_ARG COMMENT COMMENT_KEYWORD BIT_LITERAL
+%token <bytes> TEMPLATE_VAR
 %token <bytes> NULL TRUE FALSE
 
 // Precedence dictated by mysql. But the vitess grammar is simplified.
@@ -191,6 +220,24 @@ func forceEOF(yylex interface{}) {
 %token <bytes> SUBSTR SUBSTRING
 %token <bytes> GROUP_CONCAT SEPARATOR
 
+// Window functions
+%token <bytes> ROWS RANGE ROW CURRENT
+
+// OVER is non-reserved, so f() OVER could also be a call aliased over.
+// Its precedence over the empty over_opt makes the parser read a window.
+%nonassoc <bytes> OVER
+
+// UNBOUNDED and the frame directions are non-reserved, so UNBOUNDED
+// PRECEDING could also be a column named unbounded followed by PRECEDING.
+// Their precedence makes the parser read it as the frame bound.
+%nonassoc <bytes> UNBOUNDED
+%nonassoc <bytes> PRECEDING FOLLOWING
+
+// Hive
+%token <bytes> OVERWRITE PARTITIONED CLUSTERED SORTED BUCKETS STORED LOCATION TBLPROPERTIES INPUTFORMAT OUTPUTFORMAT
+%token <bytes> FORMAT DELIMITED FIELDS TERMINATED ESCAPED COLLECTION ITEMS MAP LINES DEFINED SERDE SERDEPROPERTIES
+%token <bytes> ARRAY STRUCT
+
 // Match
 %token <bytes> MATCH AGAINST BOOLEAN LANGUAGE WITH QUERY EXPANSION
 
@@ -218,6 +265,12 @@ func forceEOF(yylex interface{}) {
 %type <str> inner_join outer_join straight_join natural_join
 %type <tableName> table_name into_table_name
 %type <aliasedTableName> aliased_table_name
+%type <lateralViews> lateral_view_list_opt lateral_view_list
+%type <lateralView> lateral_view
+%type <columns> lateral_view_columns_opt
+%type <boolVal> outer_opt if_not_exists_opt
+%type <partVals> partition_values_opt partition_value_list
+%type <partVal> partition_value
 %type <indexHints> index_hint_list
 %type <expr> where_expression_opt
 %type <expr> condition
@@ -237,7 +290,14 @@ func forceEOF(yylex interface{}) {
 %type <whens> when_expression_list
 %type <when> when_expression
 %type <expr> expression_opt else_expression_opt
-%type <exprs> group_by_opt
+%type <exprs> group_by_opt partition_by_opt
+%type <windowSpec> over_opt window_spec
+%type <namedWindows> window_opt named_window_list
+%type <namedWindow> named_window
+%type <colIdent> window_name_opt
+%type <windowFrame> frame_opt
+%type <frameBound> frame_bound
+%type <str> frame_units
 %type <expr> having_opt
 %type <orderBy> order_by_opt order_list
 %type <order> order
@@ -260,15 +320,17 @@ func forceEOF(yylex interface{}) {
 %type <showFilter> like_or_where_opt
 %type <byt> exists_opt
 %type <empty> not_exists_opt non_add_drop_or_rename_operation to_opt index_opt constraint_opt
-%type <bytes> reserved_keyword non_reserved_keyword
+%type <bytes> reserved_keyword non_reserved_keyword hive_keyword
 %type <colIdent> sql_id reserved_sql_id col_alias as_ci_opt using_opt
-%type <expr> charset_value
-%type <tableIdent> table_id reserved_table_id table_alias as_opt_id
-%type <empty> as_opt
+%type <expr> charset_value field_access_expression
+%type <tableIdent> table_id reserved_table_id table_alias as_opt_id subquery_alias
+%type <empty> type_params_open
 %type <empty> force_eof ddl_force_eof
 %type <str> charset
 %type <str> set_session_or_global show_session_or_global
-%type <convertType> convert_type
+%type <convertType> convert_type complex_type
+%type <structFields> struct_field_list
+%type <structField> struct_field
 %type <columnType> column_type
 %type <columnType> int_type decimal_type numeric_type time_type char_type spatial_type
 %type <optVal> length_opt column_default_opt column_comment_opt on_update_opt
@@ -282,7 +344,16 @@ func forceEOF(yylex interface{}) {
 %type <indexDefinition> index_definition
 %type <str> index_or_key
 %type <str> equal_opt
-%type <TableSpec> table_spec table_column_list
+%type <TableSpec> table_spec table_column_list hive_table_options
+%type <columnDefinitions> hive_column_list
+%type <columnDefinition> hive_column_definition
+%type <clusteredBy> clustered_by
+%type <orderBy> sorted_by_opt
+%type <rowFormat> row_format row_format_delimiters
+%type <optVal> escaped_by_opt
+%type <storedAs> stored_as
+%type <tableProps> table_property_list serde_properties_opt
+%type <tableProp> table_property
 %type <str> table_option_list table_option table_opt_value
 %type <indexInfo> index_info
 %type <indexColumn> index_column
@@ -370,9 +441,9 @@ stream_statement:
 
 // base_select is an unparenthesized SELECT with no order by clause or beyond.
 base_select:
-  SELECT comment_opt cache_opt distinct_opt straight_join_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
+  SELECT comment_opt cache_opt distinct_opt straight_join_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt window_opt
   {
-    $$ = &Select{Comments: Comments($2), Cache: $3, Distinct: $4, Hints: $5, SelectExprs: $6, From: $7, Where: NewWhere(WhereStr, $8), GroupBy: GroupBy($9), Having: NewWhere(HavingStr, $10)}
+    $$ = &Select{Comments: Comments($2), Cache: $3, Distinct: $4, Hints: $5, SelectExprs: $6, From: $7, Where: NewWhere(WhereStr, $8), GroupBy: GroupBy($9), Having: NewWhere(HavingStr, $10), Windows: $11}
   }
 
 union_lhs:
@@ -444,6 +515,26 @@ insert_statement:
     }
     $$ = &Insert{Action: $1, Comments: Comments($2), Ignore: $3, Table: $4, Partitions: $5, Columns: cols, Rows: Values{vals}, OnDup: OnDup($8)}
   }
+| insert_or_replace comment_opt ignore_opt INTO TABLE table_name partition_values_opt insert_data
+  {
+    ins := $8
+    ins.Action = $1
+    ins.Comments = $2
+    ins.Ignore = $3
+    ins.Table = $6
+    ins.PartitionValues = $7
+    $$ = ins
+  }
+| insert_or_replace comment_opt ignore_opt OVERWRITE TABLE table_name partition_values_opt if_not_exists_opt select_statement
+  {
+    // ignore_opt lets INSERT OVERWRITE also start an insert into a table
+    // named overwrite, until TABLE tells them apart.
+    if $1 != InsertStr || $3 != "" {
+      yylex.Error("syntax error")
+      return 1
+    }
+    $$ = &Insert{Action: $1, Comments: Comments($2), Overwrite: true, Table: $6, PartitionValues: $7, IfNotExists: bool($8), Rows: $9}
+  }
 
 insert_or_replace:
   INSERT
@@ -489,6 +580,44 @@ table_name_list:
     $$ = append($$, $3)
   }
 
+partition_values_opt:
+  {
+    $$ = nil
+  }
+| PARTITION openb partition_value_list closeb
+  {
+    $$ = $3
+  }
+
+partition_value_list:
+  partition_value
+  {
+    $$ = PartitionValues{$1}
+  }
+| partition_value_list ',' partition_value
+  {
+    $$ = append($1, $3)
+  }
+
+partition_value:
+  sql_id
+  {
+    $$ = &PartitionValue{Name: $1}
+  }
+| sql_id '=' value_expression
+  {
+    $$ = &PartitionValue{Name: $1, Value: $3}
+  }
+
+if_not_exists_opt:
+  {
+    $$ = BoolVal(false)
+  }
+| IF NOT EXISTS
+  {
+    $$ = BoolVal(true)
+  }
+
 opt_partition_clause:
   {
     $$ = nil
@@ -574,6 +703,17 @@ create_statement:
     $1.TableSpec = $2
     $$ = $1
   }
+| create_table_prefix AS select_statement
+  {
+    $1.Select = $3
+    $$ = $1
+  }
+| create_table_prefix hive_table_options AS select_statement
+  {
+    $1.TableSpec = $2
+    $1.Select = $4
+    $$ = $1
+  }
 | CREATE constraint_opt INDEX ID using_opt ON table_name ddl_force_eof
   {
     // Change this to an alter statement
@@ -659,6 +799,185 @@ table_spec:
     $$ = $2
     $$.Options = $4
   }
+| '(' table_column_list ')' hive_table_options
+  {
+    $$ = $4
+    $$.Columns = $2.Columns
+    $$.Indexes = $2.Indexes
+  }
+
+// Hive table clauses are accepted in any order, as Spark does.
+// A leading COMMENT 'string' conflicts with the MySQL table option of
+// the same form and is resolved in favor of the Hive clause.
+hive_table_options:
+  COMMENT_KEYWORD STRING
+  {
+    $$ = &TableSpec{Comment: NewStrVal($2)}
+  }
+| PARTITIONED BY '(' hive_column_list ')'
+  {
+    $$ = &TableSpec{PartitionedBy: $4}
+  }
+| clustered_by
+  {
+    $$ = &TableSpec{ClusteredBy: $1}
+  }
+| row_format
+  {
+    $$ = &TableSpec{RowFormat: $1}
+  }
+| stored_as
+  {
+    $$ = &TableSpec{StoredAs: $1}
+  }
+| LOCATION STRING
+  {
+    $$ = &TableSpec{Location: NewStrVal($2)}
+  }
+| TBLPROPERTIES '(' table_property_list ')'
+  {
+    $$ = &TableSpec{TblProperties: $3}
+  }
+| hive_table_options COMMENT_KEYWORD STRING
+  {
+    $$.Comment = NewStrVal($3)
+  }
+| hive_table_options PARTITIONED BY '(' hive_column_list ')'
+  {
+    $$.PartitionedBy = $5
+  }
+| hive_table_options clustered_by
+  {
+    $$.ClusteredBy = $2
+  }
+| hive_table_options row_format
+  {
+    $$.RowFormat = $2
+  }
+| hive_table_options stored_as
+  {
+    $$.StoredAs = $2
+  }
+| hive_table_options LOCATION STRING
+  {
+    $$.Location = NewStrVal($3)
+  }
+| hive_table_options TBLPROPERTIES '(' table_property_list ')'
+  {
+    $$.TblProperties = $4
+  }
+
+hive_column_list:
+  hive_column_definition
+  {
+    $$ = []*ColumnDefinition{$1}
+  }
+| hive_column_list ',' hive_column_definition
+  {
+    $$ = append($1, $3)
+  }
+
+hive_column_definition:
+  sql_id column_type column_comment_opt
+  {
+    $2.Comment = $3
+    $$ = &ColumnDefinition{Name: $1, Type: $2}
+  }
+
+clustered_by:
+  CLUSTERED BY '(' column_list ')' sorted_by_opt INTO INTEGRAL BUCKETS
+  {
+    $$ = &ClusteredBy{Columns: $4, SortedBy: $6, Buckets: NewIntVal($8)}
+  }
+
+sorted_by_opt:
+  {
+    $$ = nil
+  }
+| SORTED BY '(' order_list ')'
+  {
+    $$ = $4
+  }
+
+row_format:
+  ROW FORMAT SERDE STRING serde_properties_opt
+  {
+    $$ = &RowFormat{Serde: NewStrVal($4), SerdeProperties: $5}
+  }
+| ROW FORMAT DELIMITED row_format_delimiters
+  {
+    $$ = $4
+  }
+
+serde_properties_opt:
+  {
+    $$ = nil
+  }
+| WITH SERDEPROPERTIES '(' table_property_list ')'
+  {
+    $$ = $4
+  }
+
+row_format_delimiters:
+  {
+    $$ = &RowFormat{}
+  }
+| row_format_delimiters FIELDS TERMINATED BY STRING escaped_by_opt
+  {
+    $$.FieldsTerminatedBy = NewStrVal($5)
+    $$.EscapedBy = $6
+  }
+| row_format_delimiters COLLECTION ITEMS TERMINATED BY STRING
+  {
+    $$.CollectionItemsTerminatedBy = NewStrVal($6)
+  }
+| row_format_delimiters MAP KEYS TERMINATED BY STRING
+  {
+    $$.MapKeysTerminatedBy = NewStrVal($6)
+  }
+| row_format_delimiters LINES TERMINATED BY STRING
+  {
+    $$.LinesTerminatedBy = NewStrVal($5)
+  }
+| row_format_delimiters NULL DEFINED AS STRING
+  {
+    $$.NullDefinedAs = NewStrVal($5)
+  }
+
+escaped_by_opt:
+  {
+    $$ = nil
+  }
+| ESCAPED BY STRING
+  {
+    $$ = NewStrVal($3)
+  }
+
+stored_as:
+  STORED AS sql_id
+  {
+    $$ = &StoredAs{FileFormat: $3}
+  }
+| STORED AS INPUTFORMAT STRING OUTPUTFORMAT STRING
+  {
+    $$ = &StoredAs{InputFormat: NewStrVal($4), OutputFormat: NewStrVal($6)}
+  }
+
+table_property_list:
+  table_property
+  {
+    $$ = TableProperties{$1}
+  }
+| table_property_list ',' table_property
+  {
+    $$ = append($1, $3)
+  }
+
+table_property:
+  STRING '=' STRING
+  {
+    $$ = &TableProperty{Key: NewStrVal($1), Value: NewStrVal($3)}
+  }
 
 table_column_list:
   column_definition
@@ -676,7 +995,7 @@ table_column_list:
   }
 
 column_definition:
-  ID column_type null_opt column_default_opt on_update_opt auto_increment_opt column_key_opt column_comment_opt
+  sql_id column_type null_opt column_default_opt on_update_opt auto_increment_opt column_key_opt column_comment_opt
   {
     $2.NotNull = $3
     $2.Default = $4
@@ -684,7 +1003,7 @@ column_definition:
     $2.Autoincrement = $6
     $2.KeyOpt = $7
     $2.Comment = $8
-    $$ = &ColumnDefinition{Name: NewColIdent(string($1)), Type: $2}
+    $$ = &ColumnDefinition{Name: $1, Type: $2}
   }
 column_type:
   numeric_type unsigned_opt zero_fill_opt
@@ -696,6 +1015,14 @@ column_type:
 | char_type
 | time_type
 | spatial_type
+| BOOLEAN
+  {
+    $$ = ColumnType{Type: string($1)}
+  }
+| complex_type
+  {
+    $$ = ColumnType{Type: $1.Type, Complex: $1.Complex}
+  }
 
 numeric_type:
   int_type length_opt
@@ -825,6 +1152,10 @@ char_type:
   {
     $$ = ColumnType{Type: string($1), Charset: $2, Collate: $3}
   }
+| STRINGKW
+  {
+    $$ = ColumnType{Type: string($1)}
+  }
 | BLOB
   {
     $$ = ColumnType{Type: string($1)}
@@ -1192,10 +1523,20 @@ table_option:
     $$ = $1 + "=" + $3
   }
 
+// Hive keywords are left out so that the Hive table clauses
+// can follow the column list without conflicts.
 table_opt_value:
-  reserved_sql_id
+  ID
+  {
+    $$ = string($1)
+  }
+| non_reserved_keyword
+  {
+    $$ = string($1)
+  }
+| reserved_keyword
   {
-    $$ = $1.String()
+    $$ = string($1)
   }
 | STRING
   {
@@ -1687,6 +2028,7 @@ select_expression:
   }
 
 as_ci_opt:
+%prec UNION
   {
     $$ = ColIdent{}
   }
@@ -1705,6 +2047,10 @@ col_alias:
   {
     $$ = NewColIdent(string($1))
   }
+| TEMPLATE_VAR
+  {
+    $$ = NewColIdent(string($1))
+  }
 
 from_opt:
   {
@@ -1730,15 +2076,16 @@ table_reference:
 | join_table
 
 table_factor:
-  aliased_table_name
+  aliased_table_name lateral_view_list_opt
   {
+    $1.LateralViews = $2
     $$ = $1
   }
-| subquery as_opt table_id
+| subquery subquery_alias lateral_view_list_opt
   {
-    $$ = &AliasedTableExpr{Expr:$1, As: $3}
+    $$ = &AliasedTableExpr{Expr:$1, As: $2, LateralViews: $3}
   }
-| subquery
+| subquery %prec JOIN
   {
     $$ = &AliasedTableExpr{Expr:$1}
   }
@@ -1757,6 +2104,52 @@ table_name as_opt_id index_hint_list
     $$ = &AliasedTableExpr{Expr:$1, Partitions: $4, As: $6, Hints: $7}
   }
 
+lateral_view_list_opt:
+  {
+    $$ = nil
+  }
+| lateral_view_list
+  {
+    $$ = $1
+  }
+
+lateral_view_list:
+  lateral_view
+  {
+    $$ = LateralViews{$1}
+  }
+| lateral_view_list lateral_view
+  {
+    $$ = append($1, $2)
+  }
+
+lateral_view:
+  LATERAL VIEW outer_opt sql_id openb select_expression_list_opt closeb table_id lateral_view_columns_opt
+  {
+    $$ = &LateralView{Outer: bool($3), Func: &FuncExpr{Name: $4, Exprs: $6}, As: $8, Columns: $9}
+  }
+
+outer_opt:
+  {
+    $$ = BoolVal(false)
+  }
+| OUTER
+  {
+    $$ = BoolVal(true)
+  }
+
+// The column alias list is greedy, like in Hive: a ',' following it
+// continues the list rather than starting a new table reference. This
+// causes a shift/reduce conflict that yacc resolves as desired.
+lateral_view_columns_opt:
+  {
+    $$ = nil
+  }
+| AS column_list
+  {
+    $$ = $2
+  }
+
 column_list:
   sql_id
   {
@@ -1820,12 +2213,15 @@ on_expression_opt:
 | ON expression
   { $$ = JoinCondition{On: $2} }
 
-as_opt:
-  { $$ = struct{}{} }
-| AS
-  { $$ = struct{}{} }
+subquery_alias:
+  table_id
+| AS table_id
+  {
+    $$ = $2
+  }
 
 as_opt_id:
+%prec JOIN
   {
     $$ = NewTableIdent("")
   }
@@ -2155,6 +2551,10 @@ value_expression:
   {
     $$ = &BracketExpr{Expr: $1, Index: $3}
   }
+| field_access_expression
+  {
+    $$ = $1
+  }
 | tuple_expression
   {
     $$ = $1
@@ -2279,13 +2679,13 @@ value_expression:
   introduce side effects due to being a simple identifier
 */
 function_call_generic:
-  sql_id openb select_expression_list_opt closeb
+  sql_id openb select_expression_list_opt closeb over_opt
   {
-    $$ = &FuncExpr{Name: $1, Exprs: $3}
+    $$ = &FuncExpr{Name: $1, Exprs: $3, Over: $5}
   }
-| sql_id openb DISTINCT select_expression_list closeb
+| sql_id openb DISTINCT select_expression_list closeb over_opt
   {
-    $$ = &FuncExpr{Name: $1, Distinct: true, Exprs: $4}
+    $$ = &FuncExpr{Name: $1, Distinct: true, Exprs: $4, Over: $6}
   }
 | table_id '.' reserved_sql_id openb select_expression_list_opt closeb
   {
@@ -2426,6 +2826,116 @@ function_call_conflict:
     $$ = &FuncExpr{Name: NewColIdent("replace"), Exprs: $3}
   }
 
+over_opt:
+%prec JOIN
+  {
+    $$ = nil
+  }
+| OVER openb window_spec closeb
+  {
+    $$ = $3
+  }
+| OVER sql_id
+  {
+    $$ = &WindowSpecification{Name: $2}
+  }
+
+window_spec:
+  window_name_opt partition_by_opt order_by_opt frame_opt
+  {
+    $$ = &WindowSpecification{Name: $1, PartitionBy: $2, OrderBy: $3, Frame: $4}
+  }
+
+// window_name_opt only accepts plain identifiers: allowing non-reserved
+// keywords would conflict with PARTITION BY.
+window_name_opt:
+  {
+    $$ = ColIdent{}
+  }
+| ID
+  {
+    $$ = NewColIdent(string($1))
+  }
+
+window_opt:
+  {
+    $$ = nil
+  }
+| WINDOW named_window_list
+  {
+    $$ = $2
+  }
+
+named_window_list:
+  named_window
+  {
+    $$ = NamedWindows{$1}
+  }
+| named_window_list ',' named_window
+  {
+    $$ = append($1, $3)
+  }
+
+named_window:
+  sql_id AS openb window_spec closeb
+  {
+    $$ = &NamedWindow{Name: $1, Spec: $4}
+  }
+
+partition_by_opt:
+  {
+    $$ = nil
+  }
+| PARTITION BY expression_list
+  {
+    $$ = $3
+  }
+
+frame_opt:
+  {
+    $$ = nil
+  }
+| frame_units frame_bound
+  {
+    $$ = &WindowFrame{Unit: $1, Start: $2}
+  }
+| frame_units BETWEEN frame_bound AND frame_bound
+  {
+    $$ = &WindowFrame{Unit: $1, Start: $3, End: $5}
+  }
+
+frame_units:
+  ROWS
+  {
+    $$ = RowsStr
+  }
+| RANGE
+  {
+    $$ = RangeStr
+  }
+
+frame_bound:
+  UNBOUNDED PRECEDING
+  {
+    $$ = &FrameBound{Type: UnboundedPrecedingStr}
+  }
+| UNBOUNDED FOLLOWING
+  {
+    $$ = &FrameBound{Type: UnboundedFollowingStr}
+  }
+| CURRENT ROW
+  {
+    $$ = &FrameBound{Type: CurrentRowStr}
+  }
+| value_expression PRECEDING
+  {
+    $$ = &FrameBound{Type: PrecedingStr, Expr: $1}
+  }
+| value_expression FOLLOWING
+  {
+    $$ = &FrameBound{Type: FollowingStr, Expr: $1}
+  }
+
 match_option:
 /*empty*/
   {
@@ -2553,6 +3063,58 @@ convert_type:
   {
     $$ = &ConvertType{Type: string($1)}
   }
+| VARCHAR length_opt
+  {
+    $$ = &ConvertType{Type: string($1), Length: $2}
+  }
+| TIMESTAMP
+  {
+    $$ = &ConvertType{Type: string($1)}
+  }
+| BOOLEAN
+  {
+    $$ = &ConvertType{Type: string($1)}
+  }
+| complex_type
+
+// The tokenizer never combines the '>' closing a complex type with
+// the next character once type_params_open has told it that a type is
+// opened, see Tokenizer.Scan. ARRAY, MAP and STRUCT are identifiers
+// anywhere else, so map >> 1 still scans as SHIFT_RIGHT.
+complex_type:
+  ARRAY type_params_open '<' convert_type '>'
+  {
+    $$ = &ConvertType{Type: ArrayStr, Complex: &ComplexType{Value: $4}}
+  }
+| MAP type_params_open '<' convert_type ',' convert_type '>'
+  {
+    $$ = &ConvertType{Type: MapStr, Complex: &ComplexType{Key: $4, Value: $6}}
+  }
+| STRUCT type_params_open '<' struct_field_list '>'
+  {
+    $$ = &ConvertType{Type: StructStr, Complex: &ComplexType{Fields: $4}}
+  }
+
+type_params_open:
+  {
+    openType(yylex)
+  }
+
+struct_field_list:
+  struct_field
+  {
+    $$ = []*StructField{$1}
+  }
+| struct_field_list ',' struct_field
+  {
+    $$ = append($1, $3)
+  }
+
+struct_field:
+  sql_id ':' convert_type
+  {
+    $$ = &StructField{Name: $1, Type: $3}
+  }
 
 expression_opt:
   {
@@ -2611,6 +3173,23 @@ column_name:
     $$ = &ColName{Qualifier: TableName{Qualifier: $1, Name: $3}, Name: $5}
   }
 
+// Names of up to three parts are parsed as a column_name, which
+// ResolveFieldAccess turns into field accesses depending on the
+// tables in scope.
+field_access_expression:
+  table_id '.' reserved_table_id '.' reserved_sql_id '.' reserved_sql_id
+  {
+    $$ = &FieldAccessExpr{Expr: &ColName{Qualifier: TableName{Qualifier: $1, Name: $3}, Name: $5}, Field: $7}
+  }
+| value_expression '[' value_expression ']' '.' reserved_sql_id
+  {
+    $$ = &FieldAccessExpr{Expr: &BracketExpr{Expr: $1, Index: $3}, Field: $6}
+  }
+| field_access_expression '.' reserved_sql_id
+  {
+    $$ = &FieldAccessExpr{Expr: $1, Field: $3}
+  }
+
 value:
   STRING
   {
@@ -2624,6 +3203,10 @@ value:
   {
     $$ = NewBitVal($1)
   }
+| TEMPLATE_VAR
+  {
+    $$ = NewTemplateVar($1)
+  }
 | INTEGRAL
   {
     $$ = NewIntVal($1)
@@ -2985,6 +3568,10 @@ sql_id:
   {
     $$ = NewColIdent(string($1))
   }
+| hive_keyword
+  {
+    $$ = NewColIdent(string($1))
+  }
 
 reserved_sql_id:
   sql_id
@@ -2998,10 +3585,18 @@ table_id:
   {
     $$ = NewTableIdent(string($1))
   }
+| TEMPLATE_VAR
+  {
+    $$ = NewTableIdent(string($1))
+  }
 | non_reserved_keyword
   {
     $$ = NewTableIdent(string($1))
   }
+| hive_keyword
+  {
+    $$ = NewTableIdent(string($1))
+  }
 
 reserved_table_id:
   table_id
@@ -3133,6 +3728,7 @@ non_reserved_keyword:
 | COMMENT_KEYWORD
 | COMMIT
 | COMMITTED
+| CURRENT
 | DATE
 | DATETIME
 | DECIMAL
@@ -3141,6 +3737,7 @@ non_reserved_keyword:
 | ENUM
 | EXPANSION
 | FLOAT_TYPE
+| FOLLOWING
 | FOREIGN
 | FULLTEXT
 | GEOMETRY
@@ -3154,6 +3751,7 @@ non_reserved_keyword:
 | KEYS
 | LANGUAGE
 | LAST_INSERT_ID
+| LATERAL
 | LESS
 | LEVEL
 | LINESTRING
@@ -3172,18 +3770,24 @@ non_reserved_keyword:
 | OFFSET
 | ONLY
 | OPTIMIZE
+| OVER
+| OVERWRITE
 | PARTITION
 | POINT
 | POLYGON
+| PRECEDING
 | PRIMARY
 | PROCEDURE
 | QUERY
+| RANGE
 | READ
 | REAL
 | REORGANIZE
 | REPAIR
 | REPEATABLE
 | ROLLBACK
+| ROW
+| ROWS
 | SESSION
 | SERIALIZABLE
 | SHARE
@@ -3203,6 +3807,7 @@ non_reserved_keyword:
 | TRANSACTION
 | TRIGGER
 | TRUNCATE
+| UNBOUNDED
 | UNCOMMITTED
 | UNSIGNED
 | UNUSED
@@ -3216,10 +3821,42 @@ non_reserved_keyword:
 | VITESS_SHARDS
 | VITESS_TABLETS
 | VSCHEMA_TABLES
+| WINDOW
 | WRITE
 | YEAR
 | ZEROFILL
 
+/*
+  These are the Hive keywords. They are non-reserved like the ones above,
+  but can't appear in the free-form MySQL table options.
+
+  Sorted alphabetically
+*/
+hive_keyword:
+  ARRAY
+| BUCKETS
+| CLUSTERED
+| COLLECTION
+| DEFINED
+| DELIMITED
+| ESCAPED
+| FIELDS
+| FORMAT
+| INPUTFORMAT
+| ITEMS
+| LINES
+| LOCATION
+| MAP
+| OUTPUTFORMAT
+| PARTITIONED
+| SERDE
+| SERDEPROPERTIES
+| SORTED
+| STORED
+| STRUCT
+| TBLPROPERTIES
+| TERMINATED
+
 openb:
   '('
   {
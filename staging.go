@@ -0,0 +1,123 @@
+package sqlparser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LoaderManifest describes the output of a label to the graph loader.
+type LoaderManifest struct {
+	Label string `json:"label"`
+	// Kind is the name of the graph mapping, e.g. point or edge.
+	Kind string `json:"kind"`
+	// IDColumns are the output columns that identify an element.
+	IDColumns   []string `json:"id_columns"`
+	LabelColumn string   `json:"label_column"`
+	// Properties lists the other output columns.
+	Properties []*CatalogColumn `json:"properties"`
+}
+
+// defaultStagingTable names the staging tables when
+// RewriteOptions.StagingTable isn't set.
+const defaultStagingTable = "{label}_staging"
+
+// describeOutput sets the output columns, the label type, the staging
+// table and the loader manifest of the rewritten statement of a label.
+// Columns are typed by the TypeMap, or else by the inferred type, which
+// the statements of the label must agree on. Columns whose type can't be
+// inferred are typed as strings.
+func describeOutput(def *SqlDef, stmt *Select, results []*rewriteResult, label string, options *RewriteOptions) error {
+	types := make(map[string]string)
+	typedBy := make(map[string]StatementSource)
+	for _, res := range results {
+		resolver := newProjectionResolver(options.Catalog)
+		for column, typ := range resolver.statementTypes(res.statement.(SelectStatement)) {
+			if _, ok := mappedType(options.TypeMap[label], column); ok {
+				continue
+			}
+			known, ok := types[column]
+			if !ok {
+				types[column] = typ
+				typedBy[column] = res.source
+			} else if known != typ {
+				return sourcedRewriteError(res.source, newRewriteError(ErrTypeConflict, column,
+					"cast the column to the same type in every statement of the label, or set its type in the TypeMap",
+					"column %s is %s here but %s in statement %d", column, typ, known, typedBy[column].Index+1))
+			}
+		}
+	}
+
+	mapping := results[0].mapping
+	labelColumn := mapping.labelOutput()
+	ids := make(map[string]bool)
+	manifest := &LoaderManifest{
+		Label:       label,
+		Kind:        mapping.Name,
+		LabelColumn: labelColumn,
+	}
+	for _, col := range mapping.Columns {
+		if col.Required && !strings.EqualFold(col.Output, labelColumn) {
+			manifest.IDColumns = append(manifest.IDColumns, col.Output)
+			ids[strings.ToLower(col.Output)] = true
+		}
+	}
+
+	tableSpec := &TableSpec{}
+	def.Columns = nil
+	for _, expr := range stmt.SelectExprs {
+		aliased, ok := expr.(*AliasedExpr)
+		if !ok {
+			return fmt.Errorf("cannot describe output column %s", String(expr, false))
+		}
+		name := aliasOrColumnName(aliased)
+		typ, ok := mappedType(options.TypeMap[label], name)
+		if !ok {
+			typ, ok = types[strings.ToLower(name)]
+		}
+		if !ok {
+			typ = "string"
+		}
+		columnType, err := parseColumnType(typ)
+		if err != nil {
+			return newRewriteError(ErrInvalidType, "", "", "column %s: %w", name, err)
+		}
+		// Spell the type as the staging table does, e.g. decimal(10,2).
+		typ = String(&columnType, false)
+		column := &CatalogColumn{Name: name, Type: typ}
+		def.Columns = append(def.Columns, column)
+		tableSpec.Columns = append(tableSpec.Columns, &ColumnDefinition{Name: NewColIdent(name), Type: columnType})
+		if strings.EqualFold(name, labelColumn) {
+			def.LabelType = typ
+		} else if !ids[strings.ToLower(name)] {
+			manifest.Properties = append(manifest.Properties, column)
+		}
+	}
+	def.Manifest = manifest
+
+	tableName := options.StagingTable
+	if tableName == "" {
+		tableName = defaultStagingTable
+	}
+	tableName = strings.Replace(tableName, "{label}", label, -1)
+	name := TableName{Name: NewTableIdent(tableName)}
+	if i := strings.IndexByte(tableName, '.'); i >= 0 {
+		name = TableName{Qualifier: NewTableIdent(tableName[:i]), Name: NewTableIdent(tableName[i+1:])}
+	}
+	def.StagingTable = &DDL{Action: CreateStr, NewName: name, TableSpec: tableSpec}
+	def.StagingTableSql = StringWithQuoteMode(def.StagingTable, options.Pretty, options.QuoteMode) + ";"
+	return nil
+}
+
+// parseColumnType parses the type of a column definition, e.g.
+// map<string,double>.
+func parseColumnType(typ string) (ColumnType, error) {
+	stmt, err := Parse("create table t (c " + typ + ")")
+	if err != nil {
+		return ColumnType{}, fmt.Errorf("invalid type %q: %v", typ, err)
+	}
+	ddl, ok := stmt.(*DDL)
+	if !ok || ddl.TableSpec == nil || len(ddl.TableSpec.Columns) != 1 {
+		return ColumnType{}, fmt.Errorf("invalid type %q", typ)
+	}
+	return ddl.TableSpec.Columns[0].Type, nil
+}
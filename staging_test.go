@@ -0,0 +1,78 @@
+package sqlparser
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDescribeOutput(t *testing.T) {
+	catalog := NewCatalog()
+	catalog.AddTable("db.shop", &CatalogColumn{Name: "id", Type: "bigint"}, &CatalogColumn{Name: "score", Type: "decimal(10,2)"})
+	sql := `select id as point_id, 'shop' as point_type, score, 'x' as tag from db.shop`
+	results, err := RewriteSqlsOrdered(sql, WithCatalog(catalog), WithStagingTable("tmp.{label}_graph"))
+	if err != nil {
+		t.Fatalf("RewriteSqlsOrdered err: %v", err)
+	}
+	def := results[0].SqlDef
+	wantColumns := []*CatalogColumn{
+		{Name: "label", Type: "string"},
+		{Name: "id", Type: "string"},
+		{Name: "score", Type: "decimal(10,2)"},
+		{Name: "tag", Type: "string"},
+	}
+	if !reflect.DeepEqual(def.Columns, wantColumns) {
+		t.Errorf("Columns: %v, want %v", def.Columns, wantColumns)
+	}
+	if def.LabelType != "string" {
+		t.Errorf("LabelType: %s, want string", def.LabelType)
+	}
+	wantDDL := "create table tmp.shop_graph (\n\tlabel string,\n\tid string,\n\tscore decimal(10,2),\n\ttag string\n);"
+	if def.StagingTableSql != wantDDL {
+		t.Errorf("StagingTableSql:\n%s, want\n%s", def.StagingTableSql, wantDDL)
+	}
+	if def.StagingTable == nil || StringWithQuoteMode(def.StagingTable, false, DoubleQuote)+";" != wantDDL {
+		t.Errorf("StagingTable: %v, want %s", def.StagingTable, wantDDL)
+	}
+	wantManifest := &LoaderManifest{
+		Label:       "shop",
+		Kind:        "point",
+		IDColumns:   []string{"id"},
+		LabelColumn: "label",
+		Properties:  wantColumns[2:],
+	}
+	if !reflect.DeepEqual(def.Manifest, wantManifest) {
+		t.Errorf("Manifest: %+v, want %+v", def.Manifest, wantManifest)
+	}
+}
+
+func TestLabelType(t *testing.T) {
+	sql := `select id as point_id, point_type from (select id, cast('7' as bigint) as kind, 'shop' as point_type from t) a`
+	results, err := RewriteSqlsOrdered(sql, WithTypeMap(map[string]map[string]string{"shop": {"label": "varchar(16)"}}))
+	if err != nil {
+		t.Fatalf("RewriteSqlsOrdered err: %v", err)
+	}
+	if got := results[0].LabelType; got != "varchar(16)" {
+		t.Errorf("LabelType: %s, want varchar(16)", got)
+	}
+}
+
+func TestDescribeOutputTypeConflict(t *testing.T) {
+	catalog := NewCatalog()
+	catalog.AddTable("db.shop", &CatalogColumn{Name: "id", Type: "bigint"}, &CatalogColumn{Name: "score", Type: "decimal(10,2)"})
+	sql := `select id as point_id, 'shop' as point_type, score from db.shop;
+select id as point_id, 'shop' as point_type, cast(score as double) as score from db.shop`
+	_, err := RewriteSqlsOrdered(sql, WithCatalog(catalog))
+	var rewriteErr *RewriteError
+	if !errors.As(err, &rewriteErr) || rewriteErr.Code != ErrTypeConflict {
+		t.Errorf("RewriteSqlsOrdered err: %v, want %s", err, ErrTypeConflict)
+	}
+
+	results, err := RewriteSqlsOrdered(sql, WithCatalog(catalog), WithTypeMap(map[string]map[string]string{"shop": {"Score": "double"}}))
+	if err != nil {
+		t.Fatalf("RewriteSqlsOrdered with a TypeMap err: %v", err)
+	}
+	if got := results[0].Columns[2]; got.Name != "score" || got.Type != "double" {
+		t.Errorf("Columns[2]: %v, want score double", got)
+	}
+}
@@ -0,0 +1,54 @@
+package sqlparser
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var templateVarPattern = regexp.MustCompile(`\$\{([^}\n]*)\}`)
+
+// isTemplateVar returns true if s is exactly one ${name} template variable.
+func isTemplateVar(s string) bool {
+	return strings.HasPrefix(s, "${") && strings.IndexByte(s, '}') == len(s)-1
+}
+
+// TemplateVars returns the names of the template variables used in node,
+// in the order they first appear. Variables may appear as values, like
+// date = ${date}, inside identifiers, like ${db}.t or t_${env}, or
+// inside string literals, like '${date}'.
+func TemplateVars(node SQLNode) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, match := range templateVarPattern.FindAllStringSubmatch(String(node, false), -1) {
+		if !seen[match[1]] {
+			seen[match[1]] = true
+			names = append(names, match[1])
+		}
+	}
+	return names
+}
+
+// SubstituteTemplateVars replaces every ${name} template variable in sql
+// with values[name]. It can be applied to the input script before it's
+// parsed or to the rewritten statements afterwards, since template
+// variables round-trip unchanged. It returns an error naming the first
+// variable that has no value.
+func SubstituteTemplateVars(sql string, values map[string]string) (string, error) {
+	var err error
+	out := templateVarPattern.ReplaceAllStringFunc(sql, func(match string) string {
+		name := match[2 : len(match)-1]
+		value, ok := values[name]
+		if !ok {
+			if err == nil {
+				err = fmt.Errorf("undefined template variable ${%s}", name)
+			}
+			return match
+		}
+		return value
+	})
+	if err != nil {
+		return "", err
+	}
+	return out, nil
+}
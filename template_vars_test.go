@@ -0,0 +1,117 @@
+package sqlparser
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTemplateVars(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out []string
+	}{{
+		in:  "select a from t",
+		out: nil,
+	}, {
+		in:  "select * from ${db}.t where `date` = ${date} limit ${n}",
+		out: []string{"db", "date", "n"},
+	}, {
+		in:  "select ${hivevar:x}, a from ${db}_tmp.t_${env} where b = '${date}' and c in (${a}, ${date})",
+		out: []string{"hivevar:x", "db", "env", "date", "a"},
+	}, {
+		in:  "insert overwrite table t partition (dt = ${dt}) select * from s where dt = '${dt}'",
+		out: []string{"dt"},
+	}}
+	for _, tcase := range testcases {
+		tree, err := Parse(tcase.in)
+		if err != nil {
+			t.Errorf("Parse(%s) err: %v", tcase.in, err)
+			continue
+		}
+		if got := TemplateVars(tree); !reflect.DeepEqual(got, tcase.out) {
+			t.Errorf("TemplateVars(%s): %v, want %v", tcase.in, got, tcase.out)
+		}
+	}
+}
+
+func TestTemplateVarNode(t *testing.T) {
+	tree, err := Parse("select a from t where dt = ${hivevar:date}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmp := tree.(*Select).Where.Expr.(*ComparisonExpr)
+	tv, ok := cmp.Right.(*TemplateVar)
+	if !ok {
+		t.Fatalf("Right: %T, want *TemplateVar", cmp.Right)
+	}
+	if tv.Name != "hivevar:date" {
+		t.Errorf("Name: %s, want hivevar:date", tv.Name)
+	}
+}
+
+func TestSubstituteTemplateVars(t *testing.T) {
+	values := map[string]string{
+		"db":        "dm",
+		"date":      "'20240101'",
+		"n":         "10",
+		"hivevar:x": "1",
+	}
+	testcases := []struct {
+		in  string
+		out string
+		err string
+	}{{
+		in:  "select a from t",
+		out: "select a from t",
+	}, {
+		in:  "select ${hivevar:x} from ${db}.t_${db} where dt = ${date} limit ${n}",
+		out: "select 1 from dm.t_dm where dt = '20240101' limit 10",
+	}, {
+		in:  "select a from t where dt = ${date} and hour = ${hour}",
+		err: "undefined template variable ${hour}",
+	}}
+	for _, tcase := range testcases {
+		got, err := SubstituteTemplateVars(tcase.in, values)
+		if tcase.err != "" {
+			if err == nil || err.Error() != tcase.err {
+				t.Errorf("SubstituteTemplateVars(%s) err: %v, want %s", tcase.in, err, tcase.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("SubstituteTemplateVars(%s) err: %v", tcase.in, err)
+			continue
+		}
+		if got != tcase.out {
+			t.Errorf("SubstituteTemplateVars(%s): %s, want %s", tcase.in, got, tcase.out)
+		}
+	}
+}
+
+func TestRewriteSqlsTemplateVars(t *testing.T) {
+	rewritten, err := RewriteSqls(`SELECT  s.src AS point1_id,
+        s.tgt AS point2_id,
+        'shop' AS point1_type,
+        'sim' AS point2_type,
+        'shop_sim' AS edge_type
+FROM    ${db}.shop_sim_di s
+WHERE   date = ${date}`)
+	if err != nil {
+		t.Fatalf("RewriteSqls error: %v", err)
+	}
+	def, ok := rewritten["shop_sim"]
+	if !ok {
+		t.Fatalf("expected rewritten sql for shop_sim edge type")
+	}
+	if !strings.Contains(def.Sql, "from ${db}.shop_sim_di as s where `date` = ${date}") {
+		t.Fatalf("expected template variables to be kept, got %s", def.Sql)
+	}
+	sql, err := SubstituteTemplateVars(def.Sql, map[string]string{"db": "dm", "date": "'20240101'"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Parse(sql); err != nil {
+		t.Errorf("Parse(%s) err: %v", sql, err)
+	}
+}
@@ -47,6 +47,11 @@ type Tokenizer struct {
 	nesting        int
 	multi          bool
 	specialComment *Tokenizer
+	// openingType is set by the parser once it has read ARRAY, MAP or
+	// STRUCT as a type, and typeNesting counts the '<' of the types
+	// being read. See Scan.
+	openingType bool
+	typeNesting int
 
 	buf     []byte
 	bufPos  int
@@ -90,6 +95,7 @@ var keywords = map[string]int{
 	"alter":               ALTER,
 	"analyze":             ANALYZE,
 	"and":                 AND,
+	"array":               ARRAY,
 	"as":                  AS,
 	"asc":                 ASC,
 	"asensitive":          UNUSED,
@@ -105,6 +111,7 @@ var keywords = map[string]int{
 	"bool":                BOOL,
 	"boolean":             BOOLEAN,
 	"both":                UNUSED,
+	"buckets":             BUCKETS,
 	"by":                  BY,
 	"call":                UNUSED,
 	"cascade":             UNUSED,
@@ -115,7 +122,9 @@ var keywords = map[string]int{
 	"character":           CHARACTER,
 	"charset":             CHARSET,
 	"check":               UNUSED,
+	"clustered":           CLUSTERED,
 	"collate":             COLLATE,
+	"collection":          COLLECTION,
 	"column":              COLUMN,
 	"comment":             COMMENT_KEYWORD,
 	"committed":           COMMITTED,
@@ -128,6 +137,7 @@ var keywords = map[string]int{
 	"substring":           SUBSTRING,
 	"create":              CREATE,
 	"cross":               CROSS,
+	"current":             CURRENT,
 	"current_date":        CURRENT_DATE,
 	"current_time":        CURRENT_TIME,
 	"current_timestamp":   CURRENT_TIMESTAMP,
@@ -145,8 +155,10 @@ var keywords = map[string]int{
 	"decimal":             DECIMAL,
 	"declare":             UNUSED,
 	"default":             DEFAULT,
+	"defined":             DEFINED,
 	"delayed":             UNUSED,
 	"delete":              DELETE,
+	"delimited":           DELIMITED,
 	"desc":                DESC,
 	"describe":            DESCRIBE,
 	"deterministic":       UNUSED,
@@ -163,7 +175,7 @@ var keywords = map[string]int{
 	"end":                 END,
 	"enum":                ENUM,
 	"escape":              ESCAPE,
-	"escaped":             UNUSED,
+	"escaped":             ESCAPED,
 	"exists":              EXISTS,
 	"exit":                UNUSED,
 	"explain":             EXPLAIN,
@@ -171,12 +183,15 @@ var keywords = map[string]int{
 	"extended":            EXTENDED,
 	"false":               FALSE,
 	"fetch":               UNUSED,
+	"fields":              FIELDS,
 	"float":               FLOAT_TYPE,
 	"float4":              UNUSED,
 	"float8":              UNUSED,
+	"following":           FOLLOWING,
 	"for":                 FOR,
 	"force":               FORCE,
 	"foreign":             FOREIGN,
+	"format":              FORMAT,
 	"from":                FROM,
 	"full":                FULL,
 	"fulltext":            FULLTEXT,
@@ -200,6 +215,7 @@ var keywords = map[string]int{
 	"infile":              UNUSED,
 	"inout":               UNUSED,
 	"inner":               INNER,
+	"inputformat":         INPUTFORMAT,
 	"insensitive":         UNUSED,
 	"insert":              INSERT,
 	"int":                 INT,
@@ -214,6 +230,7 @@ var keywords = map[string]int{
 	"io_after_gtids":      UNUSED,
 	"is":                  IS,
 	"isolation":           ISOLATION,
+	"items":               ITEMS,
 	"iterate":             UNUSED,
 	"join":                JOIN,
 	"json":                JSON,
@@ -223,6 +240,7 @@ var keywords = map[string]int{
 	"kill":                UNUSED,
 	"language":            LANGUAGE,
 	"last_insert_id":      LAST_INSERT_ID,
+	"lateral":             LATERAL,
 	"leading":             UNUSED,
 	"leave":               UNUSED,
 	"left":                LEFT,
@@ -231,17 +249,19 @@ var keywords = map[string]int{
 	"like":                LIKE,
 	"limit":               LIMIT,
 	"linear":              UNUSED,
-	"lines":               UNUSED,
+	"lines":               LINES,
 	"linestring":          LINESTRING,
 	"load":                UNUSED,
 	"localtime":           LOCALTIME,
 	"localtimestamp":      LOCALTIMESTAMP,
+	"location":            LOCATION,
 	"lock":                LOCK,
 	"long":                UNUSED,
 	"longblob":            LONGBLOB,
 	"longtext":            LONGTEXT,
 	"loop":                UNUSED,
 	"low_priority":        UNUSED,
+	"map":                 MAP,
 	"master_bind":         UNUSED,
 	"match":               MATCH,
 	"maxvalue":            MAXVALUE,
@@ -277,15 +297,20 @@ var keywords = map[string]int{
 	"out":                 UNUSED,
 	"outer":               OUTER,
 	"outfile":             UNUSED,
+	"outputformat":        OUTPUTFORMAT,
+	"over":                OVER,
+	"overwrite":           OVERWRITE,
 	"partition":           PARTITION,
+	"partitioned":         PARTITIONED,
 	"point":               POINT,
 	"polygon":             POLYGON,
+	"preceding":           PRECEDING,
 	"precision":           UNUSED,
 	"primary":             PRIMARY,
 	"processlist":         PROCESSLIST,
 	"procedure":           PROCEDURE,
 	"query":               QUERY,
-	"range":               UNUSED,
+	"range":               RANGE,
 	"read":                READ,
 	"reads":               UNUSED,
 	"read_write":          UNUSED,
@@ -307,12 +332,16 @@ var keywords = map[string]int{
 	"right":               RIGHT,
 	"rlike":               REGEXP,
 	"rollback":            ROLLBACK,
+	"row":                 ROW,
+	"rows":                ROWS,
 	"schema":              SCHEMA,
 	"schemas":             UNUSED,
 	"second_microsecond":  UNUSED,
 	"select":              SELECT,
 	"sensitive":           UNUSED,
 	"separator":           SEPARATOR,
+	"serde":               SERDE,
+	"serdeproperties":     SERDEPROPERTIES,
 	"serializable":        SERIALIZABLE,
 	"session":             SESSION,
 	"set":                 SET,
@@ -321,6 +350,7 @@ var keywords = map[string]int{
 	"signal":              UNUSED,
 	"signed":              SIGNED,
 	"smallint":            SMALLINT,
+	"sorted":              SORTED,
 	"spatial":             SPATIAL,
 	"specific":            UNUSED,
 	"sql":                 UNUSED,
@@ -337,12 +367,14 @@ var keywords = map[string]int{
 	"starting":            UNUSED,
 	"status":              STATUS,
 	"string":              STRINGKW,
-	"stored":              UNUSED,
+	"stored":              STORED,
 	"straight_join":       STRAIGHT_JOIN,
 	"stream":              STREAM,
+	"struct":              STRUCT,
 	"table":               TABLE,
 	"tables":              TABLES,
-	"terminated":          UNUSED,
+	"tblproperties":       TBLPROPERTIES,
+	"terminated":          TERMINATED,
 	"text":                TEXT,
 	"than":                THAN,
 	"then":                THEN,
@@ -357,6 +389,7 @@ var keywords = map[string]int{
 	"trigger":             TRIGGER,
 	"true":                TRUE,
 	"truncate":            TRUNCATE,
+	"unbounded":           UNBOUNDED,
 	"uncommitted":         UNCOMMITTED,
 	"undo":                UNUSED,
 	"union":               UNION,
@@ -387,6 +420,7 @@ var keywords = map[string]int{
 	"when":                WHEN,
 	"where":               WHERE,
 	"while":               UNUSED,
+	"window":              WINDOW,
 	"with":                WITH,
 	"write":               WRITE,
 	"xor":                 UNUSED,
@@ -494,6 +528,12 @@ func (tkn *Tokenizer) Scan() (int, []byte) {
 		return tkn.scanIdentifier(byte(ch), isDbSystemVariable)
 	case isDigit(ch):
 		return tkn.scanNumber(false)
+	case ch == '$':
+		return tkn.scanTemplateVar()
+	case ch == ':' && tkn.typeNesting > 0:
+		// struct<name:type>
+		tkn.next()
+		return int(ch), nil
 	case ch == ':':
 		return tkn.scanBindVar()
 	case ch == ';' && tkn.multi:
@@ -560,6 +600,14 @@ func (tkn *Tokenizer) Scan() (int, []byte) {
 			}
 			return int(ch), nil
 		case '<':
+			if tkn.openingType {
+				// Opening the parameters of a complex type. Inside them, a
+				// closing '>' is never combined with the next character, so
+				// that map<int,array<int>> doesn't scan as SHIFT_RIGHT.
+				tkn.openingType = false
+				tkn.typeNesting++
+				return int(ch), nil
+			}
 			switch tkn.lastChar {
 			case '>':
 				tkn.next()
@@ -580,6 +628,10 @@ func (tkn *Tokenizer) Scan() (int, []byte) {
 				return int(ch), nil
 			}
 		case '>':
+			if tkn.typeNesting > 0 {
+				tkn.typeNesting--
+				return int(ch), nil
+			}
 			switch tkn.lastChar {
 			case '=':
 				tkn.next()
@@ -626,10 +678,19 @@ func (tkn *Tokenizer) skipBlank() {
 func (tkn *Tokenizer) scanIdentifier(firstByte byte, isDbSystemVariable bool) (int, []byte) {
 	buffer := &bytes2.Buffer{}
 	buffer.WriteByte(firstByte)
-	for isLetter(tkn.lastChar) || isDigit(tkn.lastChar) || (isDbSystemVariable && isCarat(tkn.lastChar)) {
+	for isLetter(tkn.lastChar) || isDigit(tkn.lastChar) || (isDbSystemVariable && isCarat(tkn.lastChar)) || tkn.lastChar == '$' {
+		if tkn.lastChar == '$' {
+			if !tkn.scanTemplate(buffer) {
+				return LEX_ERROR, buffer.Bytes()
+			}
+			continue
+		}
 		buffer.WriteByte(byte(tkn.lastChar))
 		tkn.next()
 	}
+	if bytes.Contains(buffer.Bytes(), []byte("${")) {
+		return ID, buffer.Bytes()
+	}
 	lowered := bytes.ToLower(buffer.Bytes())
 	loweredStr := string(lowered)
 	if keywordID, found := keywords[loweredStr]; found {
@@ -642,6 +703,49 @@ func (tkn *Tokenizer) scanIdentifier(firstByte byte, isDbSystemVariable bool) (i
 	return ID, buffer.Bytes()
 }
 
+// scanTemplateVar scans a ${name} template variable. A variable
+// followed by identifier characters, as in ${db}_tmp, is scanned
+// as an identifier.
+func (tkn *Tokenizer) scanTemplateVar() (int, []byte) {
+	buffer := &bytes2.Buffer{}
+	if !tkn.scanTemplate(buffer) {
+		return LEX_ERROR, buffer.Bytes()
+	}
+	for isLetter(tkn.lastChar) || isDigit(tkn.lastChar) || tkn.lastChar == '$' {
+		if tkn.lastChar == '$' {
+			if !tkn.scanTemplate(buffer) {
+				return LEX_ERROR, buffer.Bytes()
+			}
+			continue
+		}
+		buffer.WriteByte(byte(tkn.lastChar))
+		tkn.next()
+	}
+	if isTemplateVar(buffer.String()) {
+		return TEMPLATE_VAR, buffer.Bytes()
+	}
+	return ID, buffer.Bytes()
+}
+
+// scanTemplate appends the ${...} at the current position to buffer.
+func (tkn *Tokenizer) scanTemplate(buffer *bytes2.Buffer) bool {
+	buffer.WriteByte('$')
+	tkn.next()
+	if tkn.lastChar != '{' {
+		return false
+	}
+	for tkn.lastChar != '}' {
+		if tkn.lastChar == eofChar || tkn.lastChar == '\n' {
+			return false
+		}
+		buffer.WriteByte(byte(tkn.lastChar))
+		tkn.next()
+	}
+	buffer.WriteByte('}')
+	tkn.next()
+	return true
+}
+
 func (tkn *Tokenizer) scanHex() (int, []byte) {
 	buffer := &bytes2.Buffer{}
 	tkn.scanMantissa(16, buffer)
@@ -923,6 +1027,8 @@ func (tkn *Tokenizer) reset() {
 	tkn.specialComment = nil
 	tkn.posVarIndex = 0
 	tkn.nesting = 0
+	tkn.openingType = false
+	tkn.typeNesting = 0
 	tkn.ForceEOF = false
 }
 
@@ -18,7 +18,10 @@ package sqlparser
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
+
+	"github.com/xwb1989/sqlparser/dependency/sqltypes"
 )
 
 // NodeFormatter defines the signature of a custom node formatter
@@ -36,8 +39,26 @@ type TrackedBuffer struct {
 	*bytes.Buffer
 	bindLocations []bindLocation
 	nodeFormatter NodeFormatter
+	quoteMode     QuoteMode
 }
 
+// QuoteMode selects how string literals are quoted.
+type QuoteMode int
+
+// QuoteMode values.
+const (
+	// SingleQuote quotes the MySQL way, escaping quotes and control
+	// characters with a backslash: 'it\'s'. It's the default.
+	SingleQuote QuoteMode = iota
+	// DoubleQuote quotes the way Hive and Spark SQL scripts are usually
+	// written, leaving single quotes unescaped: "it's".
+	DoubleQuote
+	// JSONQuote double quotes and escapes like a JSON string, with \uXXXX
+	// for control characters, so that the literal can be embedded in or
+	// extracted from JSON as is. Hive and Spark SQL read it back unchanged.
+	JSONQuote
+)
+
 // NewTrackedBuffer creates a new TrackedBuffer.
 func NewTrackedBuffer(nodeFormatter NodeFormatter) *TrackedBuffer {
 	return &TrackedBuffer{
@@ -46,6 +67,40 @@ func NewTrackedBuffer(nodeFormatter NodeFormatter) *TrackedBuffer {
 	}
 }
 
+// SetQuoteMode sets the quoting of the string literals written to buf.
+func (buf *TrackedBuffer) SetQuoteMode(mode QuoteMode) *TrackedBuffer {
+	buf.quoteMode = mode
+	return buf
+}
+
+// WriteQuotedString writes val as a string literal quoted according to
+// the quote mode of buf.
+func (buf *TrackedBuffer) WriteQuotedString(val []byte) {
+	switch buf.quoteMode {
+	case DoubleQuote:
+		buf.WriteByte('"')
+		for _, ch := range val {
+			if ch == '\'' {
+				buf.WriteByte(ch)
+			} else if encodedChar := sqltypes.SQLEncodeMap[ch]; encodedChar == sqltypes.DontEscape {
+				buf.WriteByte(ch)
+			} else {
+				buf.WriteByte('\\')
+				buf.WriteByte(encodedChar)
+			}
+		}
+		buf.WriteByte('"')
+	case JSONQuote:
+		encoder := json.NewEncoder(buf)
+		encoder.SetEscapeHTML(false)
+		_ = encoder.Encode(string(val))
+		// Encode terminates the value with a newline.
+		buf.Truncate(buf.Len() - 1)
+	default:
+		sqltypes.MakeTrusted(sqltypes.VarBinary, val).EncodeSQL(buf)
+	}
+}
+
 // WriteNode function, initiates the writing of a single SQLNode tree by passing
 // through to Myprintf with a default format string
 func (buf *TrackedBuffer) WriteNode(node SQLNode) *TrackedBuffer {
@@ -0,0 +1,320 @@
+package sqlparser
+
+import (
+	"sort"
+	"strings"
+)
+
+// TypeConflict reports a column of a label whose type is inferred
+// differently by the statements of the label.
+type TypeConflict struct {
+	Label  string         `json:"label"`
+	Column string         `json:"column"`
+	Types  []InferredType `json:"types"`
+}
+
+// InferredType is the type a statement infers for a column.
+type InferredType struct {
+	// Statement is the index of the statement in the script.
+	Statement int    `json:"statement"`
+	Type      string `json:"type"`
+}
+
+// functionTypes lists the return types of known functions.
+var functionTypes = map[string]string{
+	"avg":               "double",
+	"concat":            "string",
+	"concat_ws":         "string",
+	"count":             "bigint",
+	"current_date":      "date",
+	"current_timestamp": "timestamp",
+	"date_format":       "string",
+	"from_unixtime":     "string",
+	"get_json_object":   "string",
+	"lcase":             "string",
+	"length":            "int",
+	"lower":             "string",
+	"ltrim":             "string",
+	"md5":               "string",
+	"rand":              "double",
+	"regexp_extract":    "string",
+	"regexp_replace":    "string",
+	"rtrim":             "string",
+	"size":              "int",
+	"substr":            "string",
+	"substring":         "string",
+	"to_date":           "string",
+	"trim":              "string",
+	"ucase":             "string",
+	"unix_timestamp":    "bigint",
+	"upper":             "string",
+}
+
+// argumentTypedFunctions lists the functions that return the type of
+// their arguments, by the index of the first argument that tells it.
+var argumentTypedFunctions = map[string]int{
+	"abs":      0,
+	"coalesce": 0,
+	"if":       1,
+	"max":      0,
+	"min":      0,
+	"nvl":      0,
+}
+
+// InferTypeMap infers the types of the columns of each label from the
+// statements of sql: explicit casts, literals, known functions and the
+// column types of the catalog. The result can be reviewed and passed to
+// WithTypeMap. The label, id and other key columns of the mappings are
+// left out since they're always strings. Columns whose type can't be
+// inferred are left out too, as are the columns the statements of a
+// label disagree on; those are reported as conflicts.
+func InferTypeMap(sql string, opts ...RewriteOption) (map[string]map[string]string, []TypeConflict, error) {
+	options, err := newRewriteOptions(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	keys, grouped, failures, err := rewriteStatements(sql, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	typeMap := make(map[string]map[string]string, len(keys))
+	var conflicts []TypeConflict
+	for _, key := range keys {
+		var (
+			columns  []string
+			inferred = make(map[string][]InferredType)
+		)
+		for _, res := range grouped[key] {
+			resolver := newProjectionResolver(options.Catalog)
+			keys := res.mapping.keyColumns()
+			for column, typ := range resolver.statementTypes(res.statement.(SelectStatement)) {
+				if keys[column] {
+					continue
+				}
+				if _, ok := inferred[column]; !ok {
+					columns = append(columns, column)
+				}
+				inferred[column] = append(inferred[column], InferredType{Statement: res.source.Index, Type: typ})
+			}
+		}
+		sort.Strings(columns)
+
+		types := make(map[string]string)
+		for _, column := range columns {
+			if conflicting(inferred[column]) {
+				conflicts = append(conflicts, TypeConflict{Label: key, Column: column, Types: inferred[column]})
+				continue
+			}
+			types[column] = inferred[column][0].Type
+		}
+		typeMap[key] = types
+	}
+
+	if len(failures) != 0 {
+		return typeMap, conflicts, failures
+	}
+	return typeMap, conflicts, nil
+}
+
+func conflicting(types []InferredType) bool {
+	for _, typ := range types[1:] {
+		if typ.Type != types[0].Type {
+			return true
+		}
+	}
+	return false
+}
+
+// statementTypes returns the inferred types of the output columns of the
+// selects of stmt, keyed by lowercased name. Branches of a UNION that
+// disagree on a column leave it out.
+func (r *projectionResolver) statementTypes(stmt SelectStatement) map[string]string {
+	switch stmt := stmt.(type) {
+	case *Select:
+		types := make(map[string]string)
+		for _, expr := range stmt.SelectExprs {
+			aliased, ok := expr.(*AliasedExpr)
+			if !ok {
+				continue
+			}
+			if typ := r.exprType(aliased.Expr, stmt.From); typ != "" {
+				types[strings.ToLower(aliasOrColumnName(aliased))] = typ
+			}
+		}
+		return types
+	case *Union:
+		types := r.statementTypes(stmt.Left)
+		for column, typ := range r.statementTypes(stmt.Right) {
+			if known, ok := types[column]; ok && known != typ {
+				delete(types, column)
+			}
+		}
+		return types
+	case *ParenSelect:
+		return r.statementTypes(stmt.Select)
+	case *With:
+		return r.withCTEs(stmt.CTEs).statementTypes(stmt.Stmt)
+	}
+	return nil
+}
+
+// exprType returns the inferred type of expr selected from the given
+// tables, or an empty string if it's unknown.
+func (r *projectionResolver) exprType(expr Expr, from TableExprs) string {
+	switch expr := expr.(type) {
+	case *ConvertExpr:
+		if expr.Cast && expr.Type != nil {
+			return normalizeType(String(expr.Type, false))
+		}
+	case *SQLVal:
+		switch expr.Type {
+		case StrVal:
+			return "string"
+		case IntVal:
+			return "int"
+		case FloatVal:
+			return "double"
+		}
+	case BoolVal:
+		return "boolean"
+	case *OrExpr:
+		if !isBoolExpr(expr) {
+			// || concatenates strings.
+			return "string"
+		}
+		return "boolean"
+	case *AndExpr, *NotExpr, *ComparisonExpr, *RangeCond, *IsExpr, *ExistsExpr:
+		return "boolean"
+	case *ParenExpr:
+		return r.exprType(expr.Expr, from)
+	case *GroupConcatExpr, *SubstrExpr:
+		return "string"
+	case *BinaryExpr:
+		if expr.Operator == DivStr {
+			return "double"
+		}
+		if left := r.exprType(expr.Left, from); left == r.exprType(expr.Right, from) {
+			return left
+		}
+	case *CaseExpr:
+		for _, when := range expr.Whens {
+			if typ := r.exprType(when.Val, from); typ != "" {
+				return typ
+			}
+		}
+		if expr.Else != nil {
+			return r.exprType(expr.Else, from)
+		}
+	case *FuncExpr:
+		name := expr.Name.Lowered()
+		if typ, ok := functionTypes[name]; ok {
+			return typ
+		}
+		if name == "named_struct" {
+			return r.structType(expr.Exprs, from)
+		}
+		if i, ok := argumentTypedFunctions[name]; ok {
+			for ; i < len(expr.Exprs); i++ {
+				if arg, ok := expr.Exprs[i].(*AliasedExpr); ok {
+					if typ := r.exprType(arg.Expr, from); typ != "" {
+						return typ
+					}
+				}
+			}
+		}
+	case *ColName:
+		return r.columnType(expr, from)
+	}
+	return ""
+}
+
+// structType returns the type of named_struct(args), or an empty string
+// if the type of a field is unknown.
+func (r *projectionResolver) structType(args SelectExprs, from TableExprs) string {
+	if len(args) == 0 || len(args)%2 != 0 {
+		return ""
+	}
+	fields := make([]string, 0, len(args)/2)
+	for i := 0; i < len(args); i += 2 {
+		name, ok := args[i].(*AliasedExpr)
+		if !ok {
+			return ""
+		}
+		field, err := extractStringLiteral(name.Expr)
+		if err != nil {
+			return ""
+		}
+		value, ok := args[i+1].(*AliasedExpr)
+		if !ok {
+			return ""
+		}
+		typ := r.exprType(value.Expr, from)
+		if typ == "" {
+			return ""
+		}
+		fields = append(fields, field+":"+typ)
+	}
+	return normalizeType("struct<" + strings.Join(fields, ",") + ">")
+}
+
+// columnType returns the type of col in the given tables, or an empty
+// string if it's unknown.
+func (r *projectionResolver) columnType(col *ColName, from TableExprs) string {
+	for _, expr := range from {
+		switch expr := expr.(type) {
+		case *AliasedTableExpr:
+			if !col.Qualifier.IsEmpty() && !tableMatches(expr, col.Qualifier) {
+				continue
+			}
+			switch source := expr.Expr.(type) {
+			case TableName:
+				if cte, ok := r.ctes[strings.ToLower(source.Name.String())]; ok && source.Qualifier.IsEmpty() {
+					if cte.Subquery != nil && len(cte.Columns) == 0 {
+						if typ, ok := r.statementTypes(cte.Subquery.Select)[col.Name.Lowered()]; ok {
+							return typ
+						}
+					}
+					continue
+				}
+				columns, _ := r.catalog.Table(source)
+				for _, column := range columns {
+					if col.Name.EqualString(column.Name) && column.Type != "" {
+						return normalizeType(column.Type)
+					}
+				}
+			case *Subquery:
+				if typ, ok := r.statementTypes(source.Select)[col.Name.Lowered()]; ok {
+					return typ
+				}
+			}
+		case *ParenTableExpr:
+			if typ := r.columnType(col, expr.Exprs); typ != "" {
+				return typ
+			}
+		case *JoinTableExpr:
+			if typ := r.columnType(col, TableExprs{expr.LeftExpr, expr.RightExpr}); typ != "" {
+				return typ
+			}
+		}
+	}
+	return ""
+}
+
+// tableMatches reports whether qualifier names the table of expr.
+func tableMatches(expr *AliasedTableExpr, qualifier TableName) bool {
+	if !expr.As.IsEmpty() {
+		return strings.EqualFold(expr.As.String(), qualifier.Name.String())
+	}
+	name, ok := expr.Expr.(TableName)
+	return ok && strings.EqualFold(name.Name.String(), qualifier.Name.String())
+}
+
+// normalizeType formats typ the way casts are formatted, so that the
+// same type written differently compares equal.
+func normalizeType(typ string) string {
+	if convertType, err := ParseConvertType(typ); err == nil {
+		return String(convertType, false)
+	}
+	return strings.ToLower(typ)
+}
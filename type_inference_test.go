@@ -0,0 +1,63 @@
+package sqlparser
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestInferTypeMap(t *testing.T) {
+	catalog := NewCatalog()
+	if err := catalog.AddDDL("create table db.shop (id bigint, name string, score decimal(10, 2))"); err != nil {
+		t.Fatal(err)
+	}
+	sql := `select s.id as point_id, 'shop' as point_type, s.name, unix_timestamp() as ts, cast(score as double) as score,
+	1 as flag from db.shop s;
+select id as point_id, 'shop' as point_type, concat(name, '!') as name, count(*) as ts, score, 'y' as flag
+from (select id, name, score from db.shop) t;
+with sims as (select id, score / 2 as weight from db.shop)
+select id as point1_id, id as point2_id, 'shop' as point1_type, 'shop' as point2_type, 'shop_sim' as edge_type,
+	coalesce(weight, 0) as weight, upper(x) is null as missing
+from sims`
+	typeMap, conflicts, err := InferTypeMap(sql, WithCatalog(catalog))
+	if err != nil {
+		t.Fatalf("InferTypeMap err: %v", err)
+	}
+	wantTypeMap := map[string]map[string]string{
+		"shop": {
+			"name": "string",
+			"ts":   "bigint",
+		},
+		"shop_sim": {
+			"missing": "boolean",
+			"weight":  "double",
+		},
+	}
+	if !reflect.DeepEqual(typeMap, wantTypeMap) {
+		t.Errorf("InferTypeMap: %v, want %v", typeMap, wantTypeMap)
+	}
+	wantConflicts := []TypeConflict{{
+		Label:  "shop",
+		Column: "flag",
+		Types:  []InferredType{{Statement: 0, Type: "int"}, {Statement: 1, Type: "string"}},
+	}, {
+		Label:  "shop",
+		Column: "score",
+		Types:  []InferredType{{Statement: 0, Type: "double"}, {Statement: 1, Type: "decimal(10, 2)"}},
+	}}
+	if !reflect.DeepEqual(conflicts, wantConflicts) {
+		t.Errorf("InferTypeMap conflicts: %+v, want %+v", conflicts, wantConflicts)
+	}
+
+	// The inferred types can be passed back in once the conflicts are
+	// resolved.
+	typeMap["shop"]["flag"] = "string"
+	typeMap["shop"]["score"] = "double"
+	rewritten, err := RewriteSqls(sql, WithCatalog(catalog), WithTypeMap(typeMap), WithTypeMapValidation(TypeMapStrict))
+	if err != nil {
+		t.Fatalf("RewriteSqls with the inferred types err: %v", err)
+	}
+	if got := rewritten["shop_sim"].Sql; strings.Contains(got, "as struct<") {
+		t.Errorf("RewriteSqls with the inferred types cast a key column: %s", got)
+	}
+}
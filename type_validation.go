@@ -0,0 +1,194 @@
+package sqlparser
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// TypeMapValidation tells how RewriteSqlsOrdered checks the entries of
+// RewriteOptions.TypeMap.
+type TypeMapValidation string
+
+// TypeMapValidation values.
+const (
+	// TypeMapUnchecked casts the columns whose entry matches and ignores
+	// the other entries.
+	TypeMapUnchecked TypeMapValidation = ""
+	// TypeMapStrict fails with TypeMapIssues if an entry doesn't apply.
+	TypeMapStrict TypeMapValidation = "strict"
+	// TypeMapLenient skips the entries that don't apply and returns them
+	// as TypeMapIssues along with the rewritten labels.
+	TypeMapLenient TypeMapValidation = "lenient"
+)
+
+// Validate checks that validation is a known mode.
+func (validation TypeMapValidation) Validate() error {
+	switch validation {
+	case TypeMapUnchecked, TypeMapStrict, TypeMapLenient:
+		return nil
+	}
+	return fmt.Errorf("type map validation: unknown mode %q", string(validation))
+}
+
+// DefaultTypeNames returns the Hive types a TypeMap may cast columns to
+// when RewriteOptions.TypeNames is empty.
+func DefaultTypeNames() []string {
+	return []string{
+		"tinyint", "smallint", "int", "integer", "bigint", "float", "double", "decimal",
+		"string", "varchar", "char", "boolean", "binary", "date", "timestamp",
+		ArrayStr, MapStr, StructStr,
+	}
+}
+
+// TypeMapIssue is an entry of RewriteOptions.TypeMap that doesn't apply
+// to the rewritten statements.
+type TypeMapIssue struct {
+	Code   RewriteErrorCode `json:"code"`
+	Label  string           `json:"label"`
+	Column string           `json:"column,omitempty"`
+	Type   string           `json:"type,omitempty"`
+	// Message tells what is wrong with the entry.
+	Message string `json:"message"`
+}
+
+// Error implements the error interface.
+func (issue *TypeMapIssue) Error() string {
+	if issue.Column == "" {
+		return fmt.Sprintf("type map label %s: %s", issue.Label, issue.Message)
+	}
+	return fmt.Sprintf("type map label %s column %s: %s", issue.Label, issue.Column, issue.Message)
+}
+
+// TypeMapIssues is returned when RewriteOptions.TypeMapValidation is set
+// and entries of the TypeMap don't apply. The issues are only returned
+// if every statement could be rewritten.
+type TypeMapIssues []*TypeMapIssue
+
+// Error implements the error interface.
+func (issues TypeMapIssues) Error() string {
+	messages := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		messages = append(messages, issue.Error())
+	}
+	return fmt.Sprintf("%d type map entries don't apply:\n%s", len(issues), strings.Join(messages, "\n"))
+}
+
+// checkTypeMap checks the types of options.TypeMap against the type
+// names and the key columns of the schema, which must stay strings. It
+// replaces options.TypeMap with a copy that lacks the entries at fault.
+func (options *RewriteOptions) checkTypeMap() TypeMapIssues {
+	if options.TypeMapValidation == TypeMapUnchecked || options.TypeMap == nil {
+		return nil
+	}
+	typeNames := options.TypeNames
+	if len(typeNames) == 0 {
+		typeNames = DefaultTypeNames()
+	}
+	known := make(map[string]bool, len(typeNames))
+	for _, name := range typeNames {
+		known[strings.ToLower(name)] = true
+	}
+	keys := make(map[string]bool)
+	for _, mapping := range options.Schema.Mappings {
+		for column := range mapping.keyColumns() {
+			keys[column] = true
+		}
+	}
+
+	var issues TypeMapIssues
+	typeMap := make(map[string]map[string]string, len(options.TypeMap))
+	for _, label := range sortedLabels(options.TypeMap) {
+		types := make(map[string]string, len(options.TypeMap[label]))
+		for _, column := range sortedColumns(options.TypeMap[label]) {
+			typ := options.TypeMap[label][column]
+			issue := &TypeMapIssue{Label: label, Column: column, Type: typ}
+			convertType, err := ParseConvertType(typ)
+			switch {
+			case err != nil || !knownType(convertType, known):
+				issue.Code, issue.Message = ErrInvalidType, fmt.Sprintf("unknown type %s", typ)
+			case keys[strings.ToLower(column)] && !strings.EqualFold(convertType.Type, "string"):
+				issue.Code, issue.Message = ErrKeyColumnType, fmt.Sprintf("%s is an id or label column and must stay a string", column)
+			default:
+				types[column] = typ
+				continue
+			}
+			issues = append(issues, issue)
+		}
+		typeMap[label] = types
+	}
+	options.TypeMap = typeMap
+	return issues
+}
+
+// knownType reports whether typ and the element types of a complex typ
+// are all in known.
+func knownType(typ *ConvertType, known map[string]bool) bool {
+	if !known[strings.ToLower(typ.Type)] {
+		return false
+	}
+	if typ.Complex == nil {
+		return true
+	}
+	for _, elem := range []*ConvertType{typ.Complex.Key, typ.Complex.Value} {
+		if elem != nil && !knownType(elem, known) {
+			return false
+		}
+	}
+	for _, field := range typ.Complex.Fields {
+		if !knownType(field.Type, known) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkTypeMapLabels checks that every label of typeMap was rewritten
+// and that the columns it types are selected by the rewritten sql.
+func checkTypeMapLabels(typeMap map[string]map[string]string, labels []string, rewritten []*RewrittenSql) TypeMapIssues {
+	var issues TypeMapIssues
+	seen := make(map[string]bool, len(labels))
+	for _, label := range labels {
+		seen[label] = true
+	}
+	for _, label := range sortedLabels(typeMap) {
+		if !seen[label] {
+			issues = append(issues, &TypeMapIssue{Code: ErrUnknownLabel, Label: label,
+				Message: "no statement selects the label"})
+		}
+	}
+	for _, result := range rewritten {
+		selected := make(map[string]bool, len(result.Columns))
+		for _, col := range result.Columns {
+			selected[strings.ToLower(col.Name)] = true
+		}
+		types := typeMap[result.Label]
+		for _, column := range sortedColumns(types) {
+			if !selected[strings.ToLower(column)] {
+				issues = append(issues, &TypeMapIssue{Code: ErrUnknownColumn, Label: result.Label, Column: column, Type: types[column],
+					Message: "the column isn't selected by the rewritten sql"})
+			}
+		}
+	}
+	return issues
+}
+
+// sortedLabels returns the labels of typeMap in order.
+func sortedLabels(typeMap map[string]map[string]string) []string {
+	labels := make([]string, 0, len(typeMap))
+	for label := range typeMap {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+	return labels
+}
+
+// sortedColumns returns the columns of types in order.
+func sortedColumns(types map[string]string) []string {
+	columns := make([]string, 0, len(types))
+	for column := range types {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+	return columns
+}
@@ -0,0 +1,74 @@
+package sqlparser
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTypeMapValidation(t *testing.T) {
+	sql := "select id as point_id, 'shop' as point_type, price, name from t"
+	testcases := []struct {
+		typeMap map[string]map[string]string
+		issues  TypeMapIssues
+	}{{
+		typeMap: map[string]map[string]string{"shop": {"price": "double", "name": "array<struct<a:string>>", "id": "string"}},
+	}, {
+		typeMap: map[string]map[string]string{"shop": {"price": "dubble", "name": "signed"}},
+		issues: TypeMapIssues{
+			{Code: ErrInvalidType, Label: "shop", Column: "name", Type: "signed"},
+			{Code: ErrInvalidType, Label: "shop", Column: "price", Type: "dubble"},
+		},
+	}, {
+		typeMap: map[string]map[string]string{"shop": {"id": "bigint", "label": "string", "prices": "double", "Name": "string"}},
+		issues: TypeMapIssues{
+			{Code: ErrKeyColumnType, Label: "shop", Column: "id", Type: "bigint"},
+			{Code: ErrUnknownColumn, Label: "shop", Column: "prices", Type: "double"},
+		},
+	}, {
+		typeMap: map[string]map[string]string{"shop": {"price": "map<string,array<dubble>>"}, "shops": {}},
+		issues: TypeMapIssues{
+			{Code: ErrInvalidType, Label: "shop", Column: "price", Type: "map<string,array<dubble>>"},
+			{Code: ErrUnknownLabel, Label: "shops"},
+		},
+	}}
+	for _, tcase := range testcases {
+		_, err := RewriteSqlsOrdered(sql, WithTypeMap(tcase.typeMap), WithTypeMapValidation(TypeMapStrict))
+		if tcase.issues == nil {
+			if err != nil {
+				t.Errorf("RewriteSqlsOrdered(%v) strict err: %v", tcase.typeMap, err)
+			}
+			continue
+		}
+		issues, ok := err.(TypeMapIssues)
+		if !ok {
+			t.Errorf("RewriteSqlsOrdered(%v) strict err: %v, want TypeMapIssues", tcase.typeMap, err)
+			continue
+		}
+		for _, issue := range issues {
+			issue.Message = ""
+		}
+		if !reflect.DeepEqual(issues, tcase.issues) {
+			t.Errorf("RewriteSqlsOrdered(%v) strict:\n%v, want\n%v", tcase.typeMap, issues, tcase.issues)
+		}
+
+		results, err := RewriteSqlsOrdered(sql, WithTypeMap(tcase.typeMap), WithTypeMapValidation(TypeMapLenient), WithQuoteMode(SingleQuote))
+		if issues, ok := err.(TypeMapIssues); !ok || len(issues) != len(tcase.issues) {
+			t.Errorf("RewriteSqlsOrdered(%v) lenient err: %v, want %d issues", tcase.typeMap, err, len(tcase.issues))
+		}
+		if len(results) != 1 || strings.Contains(results[0].Sql, "cast(price") || strings.Contains(results[0].Sql, "cast(id as bigint)") {
+			t.Errorf("RewriteSqlsOrdered(%v) lenient: %+v, want the entries at fault skipped", tcase.typeMap, results)
+		}
+	}
+
+	typeMap := map[string]map[string]string{"shop": {"price": "double", "prices": "double"}}
+	if _, err := RewriteSqlsOrdered(sql, WithTypeMap(typeMap), WithTypeMapValidation(TypeMapStrict), WithTypeNames("string", "bigint")); err == nil {
+		t.Errorf("RewriteSqlsOrdered with type names string, bigint: want an error")
+	}
+	if _, err := RewriteSqlsOrdered(sql, WithTypeMap(typeMap)); err != nil {
+		t.Errorf("RewriteSqlsOrdered unchecked err: %v", err)
+	}
+	if _, err := RewriteSqlsOrdered(sql, WithTypeMapValidation("loose")); err == nil {
+		t.Errorf("RewriteSqlsOrdered with an unknown validation mode: want an error")
+	}
+}
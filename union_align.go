@@ -0,0 +1,158 @@
+package sqlparser
+
+import (
+	"strings"
+)
+
+// ProjectionPadding reports a column that a statement doesn't select and
+// that was added as NULL to align the UNION ALL of its label.
+type ProjectionPadding struct {
+	// Statement is the index of the statement in the script.
+	Statement int    `json:"statement"`
+	Column    string `json:"column"`
+	// Type is the type the NULL was cast to, if known.
+	Type string `json:"type,omitempty"`
+}
+
+// unionBranch is a select combined into the UNION ALL of a label.
+type unionBranch struct {
+	sel      *Select
+	source   StatementSource
+	resolver *projectionResolver
+}
+
+// alignUnionBranches aligns the projections of the selects combined
+// into the UNION ALL of a label: every select projects the union of all
+// the column names in the order they're first seen. Columns a select
+// lacks are padded with NULL, cast to their type in typeMap or to the
+// type other selects cast them to. Two selects whose types for a column
+// differ, explicitly cast or inferred, is an error unless typeMap sets
+// the type of the column. Column names match case-insensitively and
+// padding keeps the case of the first select that projects the column.
+func alignUnionBranches(results []*rewriteResult, typeMap map[string]string, catalog *Catalog) ([]ProjectionPadding, error) {
+	var branches []unionBranch
+	for _, res := range results {
+		resolver := newProjectionResolver(catalog)
+		if with, ok := res.statement.(*With); ok {
+			resolver = resolver.withCTEs(with.CTEs)
+		}
+		for _, sel := range selectBranches(res.statement) {
+			branches = append(branches, unionBranch{sel: sel, source: res.source, resolver: resolver})
+		}
+	}
+
+	var (
+		names    []string
+		columns  = make(map[string]string)
+		types    = make(map[string]string)
+		inferred = make(map[string]string)
+		typedBy  = make(map[string]StatementSource)
+		indexes  = make([]map[string]int, len(branches))
+	)
+	for i, branch := range branches {
+		indexes[i] = make(map[string]int)
+		for j, expr := range branch.sel.SelectExprs {
+			aliased, ok := expr.(*AliasedExpr)
+			if !ok {
+				return nil, sourcedRewriteError(branch.source, newRewriteError(ErrUnresolvedProjection, "", "",
+					"cannot align projection %s", String(expr, false)))
+			}
+			name := strings.ToLower(aliasOrColumnName(aliased))
+			if _, ok := indexes[i][name]; ok {
+				return nil, sourcedRewriteError(branch.source, newRewriteError(ErrDuplicateColumn, name, "alias the columns with distinct names",
+					"column %s is selected twice", aliasOrColumnName(aliased)))
+			}
+			indexes[i][name] = j
+			if _, ok := types[name]; !ok {
+				names = append(names, name)
+				columns[name] = aliasOrColumnName(aliased)
+				types[name] = ""
+			}
+			if types[name] == "" {
+				types[name] = castType(aliased.Expr)
+			}
+			if _, ok := mappedType(typeMap, name); ok {
+				continue
+			}
+			typ := branch.resolver.exprType(aliased.Expr, branch.sel.From)
+			if typ == "" {
+				continue
+			}
+			if inferred[name] == "" {
+				inferred[name] = typ
+				typedBy[name] = branch.source
+			} else if !strings.EqualFold(inferred[name], typ) {
+				return nil, sourcedRewriteError(branch.source, newRewriteError(ErrTypeConflict, aliasOrColumnName(aliased),
+					"cast the column to the same type in every statement of the label, or set its type in the TypeMap",
+					"column %s is %s here but %s in statement %d", aliasOrColumnName(aliased), typ, inferred[name], typedBy[name].Index+1))
+			}
+		}
+	}
+
+	var paddings []ProjectionPadding
+	for i, branch := range branches {
+		aligned := make(SelectExprs, 0, len(names))
+		for _, name := range names {
+			if j, ok := indexes[i][name]; ok {
+				aligned = append(aligned, branch.sel.SelectExprs[j])
+				continue
+			}
+			typ := types[name]
+			if mapped, ok := mappedType(typeMap, name); ok {
+				typ = mapped
+			}
+			padding, err := nullColumn(columns[name], typ)
+			if err != nil {
+				return nil, sourcedRewriteError(branch.source, newRewriteError(ErrInvalidType, "", "",
+					"column %s: %w", columns[name], err))
+			}
+			aligned = append(aligned, padding)
+			paddings = append(paddings, ProjectionPadding{
+				Statement: branch.source.Index,
+				Column:    aliasOrColumnName(padding),
+				Type:      typ,
+			})
+		}
+		branch.sel.SelectExprs = aligned
+	}
+	return paddings, nil
+}
+
+// nullColumn returns NULL AS name, cast to typ if it's not empty.
+func nullColumn(name, typ string) (*AliasedExpr, error) {
+	if typ == "" {
+		return &AliasedExpr{Expr: &NullVal{}, As: NewColIdent(name)}, nil
+	}
+	convertType, err := ParseConvertType(typ)
+	if err != nil {
+		return nil, err
+	}
+	return &AliasedExpr{
+		Expr: &ConvertExpr{Expr: &NullVal{}, Type: convertType, Cast: true},
+		As:   NewColIdent(name),
+	}, nil
+}
+
+// castType returns the type expr is cast to, or an empty string.
+func castType(expr Expr) string {
+	convert, ok := expr.(*ConvertExpr)
+	if !ok || !convert.Cast || convert.Type == nil {
+		return ""
+	}
+	return String(convert.Type, false)
+}
+
+// selectBranches returns the selects of stmt combined by UNION.
+func selectBranches(stmt Statement) []*Select {
+	switch stmt := stmt.(type) {
+	case *Select:
+		return []*Select{stmt}
+	case *Union:
+		return append(selectBranches(stmt.Left), selectBranches(stmt.Right)...)
+	case *ParenSelect:
+		return selectBranches(stmt.Select)
+	case *With:
+		return selectBranches(stmt.Stmt)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package sqlparser
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestUnionAlignment(t *testing.T) {
+	sql := `select a as point_id, 'shop' as point_type, x, y from t1;
+select b as point_id, z, 'shop' as point_type, cast(x as bigint) as x from t2`
+	results, err := RewriteSqlsOrdered(sql, WithQuoteMode(SingleQuote), WithDedupPolicy(&DedupPolicy{Strategy: DedupNone}),
+		WithTypeMap(map[string]map[string]string{"shop": {"z": "double"}}))
+	if err != nil {
+		t.Fatalf("RewriteSqlsOrdered err: %v", err)
+	}
+	want := "select label, id, x, y, z from (" +
+		"select 'shop' as label, cast(a as string) as id, x, y, cast(null as double) as z from t1 union all " +
+		"select 'shop' as label, cast(b as string) as id, cast(x as bigint) as x, null as y, cast(z as double) from t2);"
+	if got := results[0].Sql; got != want {
+		t.Errorf("RewriteSqlsOrdered:\n%s, want\n%s", got, want)
+	}
+	wantPaddings := []ProjectionPadding{
+		{Statement: 0, Column: "z", Type: "double"},
+		{Statement: 1, Column: "y"},
+	}
+	if !reflect.DeepEqual(results[0].Paddings, wantPaddings) {
+		t.Errorf("Paddings: %+v, want %+v", results[0].Paddings, wantPaddings)
+	}
+}
+
+func TestUnionAlignmentPaddingCase(t *testing.T) {
+	sql := `select a as point_id, 'shop' as point_type from t1;
+select b as point_id, 'shop' as point_type, cast(w as double) as ShopWeight from t2`
+	results, err := RewriteSqlsOrdered(sql, WithQuoteMode(SingleQuote), WithDedupPolicy(&DedupPolicy{Strategy: DedupNone}))
+	if err != nil {
+		t.Fatalf("RewriteSqlsOrdered err: %v", err)
+	}
+	want := "select label, id, ShopWeight from (" +
+		"select 'shop' as label, cast(a as string) as id, cast(null as double) as ShopWeight from t1 union all " +
+		"select 'shop' as label, cast(b as string) as id, cast(w as double) as ShopWeight from t2);"
+	if got := results[0].Sql; got != want {
+		t.Errorf("RewriteSqlsOrdered:\n%s, want\n%s", got, want)
+	}
+	wantPaddings := []ProjectionPadding{{Statement: 0, Column: "ShopWeight", Type: "double"}}
+	if !reflect.DeepEqual(results[0].Paddings, wantPaddings) {
+		t.Errorf("Paddings: %+v, want %+v", results[0].Paddings, wantPaddings)
+	}
+	if got := results[0].Columns[2].Name; got != "ShopWeight" {
+		t.Errorf("Columns[2]: %s, want ShopWeight", got)
+	}
+}
+
+func TestUnionAlignmentTypeMap(t *testing.T) {
+	sql := `select a as point_id, 'shop' as point_type, 'x' as name from t1;
+select b as point_id, 'shop' as point_type, cast(y as bigint) as name, z from t2`
+	results, err := RewriteSqlsOrdered(sql, WithQuoteMode(SingleQuote), WithDedupPolicy(&DedupPolicy{Strategy: DedupNone}),
+		WithTypeMap(map[string]map[string]string{"shop": {"Name": "string", "Z": "double"}}))
+	if err != nil {
+		t.Fatalf("RewriteSqlsOrdered err: %v", err)
+	}
+	want := "select label, id, name, z from (" +
+		"select 'shop' as label, cast(a as string) as id, cast('x' as string) as name, cast(null as double) as z from t1 union all " +
+		"select 'shop' as label, cast(b as string) as id, cast(y as string) as name, cast(z as double) from t2);"
+	if got := results[0].Sql; got != want {
+		t.Errorf("RewriteSqlsOrdered:\n%s, want\n%s", got, want)
+	}
+}
+
+func TestUnionAlignmentErrors(t *testing.T) {
+	testcases := []struct {
+		sql       string
+		code      RewriteErrorCode
+		statement int
+		message   string
+	}{{
+		sql: `select a as point_id, 'shop' as point_type, cast(x as bigint) as x from t1;
+select b as point_id, 'shop' as point_type, cast(x as string) as x from t2`,
+		code:      ErrTypeConflict,
+		statement: 1,
+		message:   "column x is string here but bigint in statement 1",
+	}, {
+		sql: `select a as point_id, 'shop' as point_type, 'x' as name from t1;
+select b as point_id, 'shop' as point_type, cast(y as bigint) as name from t2`,
+		code:      ErrTypeConflict,
+		statement: 1,
+		message:   "column name is bigint here but string in statement 1",
+	}, {
+		sql: `select a as point_id, 'shop' as point_type, x, y as x from t1;
+select b as point_id, 'shop' as point_type from t2`,
+		code:      ErrDuplicateColumn,
+		statement: 0,
+		message:   "column x is selected twice",
+	}}
+	for _, tcase := range testcases {
+		_, err := RewriteSqlsOrdered(tcase.sql)
+		var rewriteErr *RewriteError
+		if !errors.As(err, &rewriteErr) {
+			t.Errorf("RewriteSqlsOrdered(%q) err: %v, want a RewriteError", tcase.sql, err)
+			continue
+		}
+		if rewriteErr.Code != tcase.code || rewriteErr.Statement.Index != tcase.statement || rewriteErr.Message != tcase.message {
+			t.Errorf("RewriteSqlsOrdered(%q) err: %s %d %q, want %s %d %q", tcase.sql,
+				rewriteErr.Code, rewriteErr.Statement.Index, rewriteErr.Message, tcase.code, tcase.statement, tcase.message)
+		}
+		if strings.Contains(tcase.sql[rewriteErr.Start:rewriteErr.End], "\n") {
+			t.Errorf("RewriteSqlsOrdered(%q) err not narrowed: %d-%d", tcase.sql, rewriteErr.Start, rewriteErr.End)
+		}
+	}
+}